@@ -32,6 +32,12 @@ func New(core *client.CoreClient) *AuditClient {
 	}
 }
 
+// NewDefault creates a new instance of AuditClient using the client installed
+// with client.SetDefault. If no default client is set, returns nil.
+func NewDefault() *AuditClient {
+	return New(client.Default())
+}
+
 // newRequest creates a new HTTP request for the audit service.
 // This method is internal and should not be called directly by SDK users.
 func (c *AuditClient) newRequest(ctx context.Context, method, path string, body any) (*http.Request, error) {
@@ -1,12 +1,15 @@
 package audit
 
 import (
+	"bytes"
 	"context"
+	"encoding/gob"
 	"encoding/json"
 	"fmt"
 	"net/http"
 	"net/http/httptest"
 	"net/url"
+	"reflect"
 	"strconv"
 	"testing"
 	"time"
@@ -323,3 +326,48 @@ func TestListEventTypesParamsQuery(t *testing.T) {
 		t.Errorf("expected X-Tenant-ID=tenant123, got %s", query.Get("X-Tenant-ID"))
 	}
 }
+
+func TestEventFilterParams_Serialization(t *testing.T) {
+	t.Parallel()
+
+	eventTime := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	opts := EventFilterParams{
+		ID:          helpers.StrPtr("evt-1"),
+		SourceLike:  helpers.StrPtr("%compute%"),
+		Time:        &eventTime,
+		TypeLike:    helpers.StrPtr("%create%"),
+		ProductLike: helpers.StrPtr("%virtual-machine%"),
+		AuthID:      helpers.StrPtr("user-1"),
+		TenantID:    helpers.StrPtr("tenant-1"),
+		Data:        map[string]string{"status": "ok"},
+	}
+
+	data, err := json.Marshal(opts)
+	if err != nil {
+		t.Fatalf("json.Marshal() error = %v", err)
+	}
+	want := `{"id":"evt-1","source__like":"%compute%","time":"2024-01-02T03:04:05Z","type__like":"%create%","product__like":"%virtual-machine%","authid":"user-1","X-Tenant-ID":"tenant-1","data":{"status":"ok"}}`
+	if string(data) != want {
+		t.Errorf("json.Marshal() = %s, want %s", data, want)
+	}
+
+	var fromJSON EventFilterParams
+	if err := json.Unmarshal(data, &fromJSON); err != nil {
+		t.Fatalf("json.Unmarshal() error = %v", err)
+	}
+	if !reflect.DeepEqual(fromJSON, opts) {
+		t.Errorf("JSON round trip = %+v, want %+v", fromJSON, opts)
+	}
+
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(opts); err != nil {
+		t.Fatalf("gob Encode() error = %v", err)
+	}
+	var fromGob EventFilterParams
+	if err := gob.NewDecoder(&buf).Decode(&fromGob); err != nil {
+		t.Fatalf("gob Decode() error = %v", err)
+	}
+	if !reflect.DeepEqual(fromGob, opts) {
+		t.Errorf("gob round trip = %+v, want %+v", fromGob, opts)
+	}
+}
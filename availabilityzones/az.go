@@ -2,21 +2,31 @@ package availabilityzones
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"net/http"
 	"net/url"
 	"strconv"
+	"strings"
 
+	"github.com/MagaluCloud/mgc-sdk-go/client"
 	mgc_http "github.com/MagaluCloud/mgc-sdk-go/internal/http"
 )
 
+// ErrUnknownRegion is returned by ListZones and ValidateZone when the client's
+// region is not known.
+var ErrUnknownRegion = errors.New("availability zones: region unknown, set it with WithRegion")
+
 // ListOptions contains the options for listing availability zones
 type ListOptions struct {
-	ShowBlocked bool
+	ShowBlocked bool `json:"show_blocked,omitempty"`
 }
 
 // Service defines the interface for availability zone operations
 type Service interface {
 	List(ctx context.Context, opts ListOptions) ([]Region, error)
+	ListZones(ctx context.Context, opts ListOptions) ([]Zone, error)
+	ValidateZone(ctx context.Context, zoneID string) error
 }
 
 // service implements the Service interface.
@@ -49,6 +59,13 @@ type Region struct {
 	AvailabilityZones []AvailabilityZone `json:"availability_zones"`
 }
 
+// Zone is an availability zone together with the region it belongs to.
+type Zone struct {
+	ID        string    `json:"az_id"`
+	RegionID  string    `json:"region_id"`
+	BlockType BlockType `json:"block_type"`
+}
+
 // ListResponse represents the response from listing availability zones.
 // This structure encapsulates the API response format.
 type ListResponse struct {
@@ -77,3 +94,58 @@ func (s *service) List(ctx context.Context, opts ListOptions) ([]Region, error)
 
 	return result.Results, nil
 }
+
+// ListZones returns the availability zones of the client's region as a flat
+// list that carries the region ID. It returns ErrUnknownRegion when the region
+// could not be taken from the base URL and was not set with WithRegion.
+func (s *service) ListZones(ctx context.Context, opts ListOptions) ([]Zone, error) {
+	if s.client.region == "" {
+		return nil, ErrUnknownRegion
+	}
+
+	regions, err := s.List(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	zones := make([]Zone, 0)
+	for _, region := range regions {
+		if region.ID != s.client.region {
+			continue
+		}
+		for _, az := range region.AvailabilityZones {
+			zones = append(zones, Zone{ID: az.ID, RegionID: region.ID, BlockType: az.BlockType})
+		}
+	}
+
+	return zones, nil
+}
+
+// ValidateZone checks that zoneID is an availability zone of the client's
+// region that accepts new resources. It returns a ValidationError listing the
+// usable zones otherwise, so create requests can be checked before sending.
+func (s *service) ValidateZone(ctx context.Context, zoneID string) error {
+	zones, err := s.ListZones(ctx, ListOptions{ShowBlocked: true})
+	if err != nil {
+		return err
+	}
+
+	available := make([]string, 0, len(zones))
+	for _, zone := range zones {
+		if zone.BlockType != BlockTypeNone && zone.BlockType != "" {
+			if zone.ID == zoneID {
+				return &client.ValidationError{Field: "availability_zone", Message: fmt.Sprintf("zone %s is blocked (%s)", zoneID, zone.BlockType)}
+			}
+			continue
+		}
+		if zone.ID == zoneID {
+			return nil
+		}
+		available = append(available, zone.ID)
+	}
+
+	return &client.ValidationError{
+		Field:   "availability_zone",
+		Message: fmt.Sprintf("unknown zone %q, available zones: %s", zoneID, strings.Join(available, ", ")),
+	}
+}
@@ -1,11 +1,15 @@
 package availabilityzones
 
 import (
+	"bytes"
 	"context"
+	"encoding/gob"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
 	"net/http/httptest"
+	"reflect"
 	"testing"
 	"time"
 
@@ -195,3 +199,121 @@ func contains(s, substr string) bool {
 	}
 	return false
 }
+
+func newZonesTestServer(t *testing.T) *httptest.Server {
+	t.Helper()
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		response := ListResponse{
+			Results: []Region{
+				{
+					ID: "br-se1",
+					AvailabilityZones: []AvailabilityZone{
+						{ID: "br-se1-a", BlockType: BlockTypeNone},
+						{ID: "br-se1-b", BlockType: BlockTypeNone},
+						{ID: "br-se1-c", BlockType: BlockTypeTotal},
+					},
+				},
+				{
+					ID: "br-ne1",
+					AvailabilityZones: []AvailabilityZone{
+						{ID: "br-ne1-a", BlockType: BlockTypeNone},
+					},
+				},
+			},
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(response)
+	}))
+}
+
+func TestService_ListZones(t *testing.T) {
+	ts := newZonesTestServer(t)
+	defer ts.Close()
+
+	core := client.NewMgcClient(client.WithAPIKey("test-api-key"), client.WithBaseURL(client.BrSe1))
+	c := New(core, WithGlobalBasePath(client.MgcUrl(ts.URL)))
+	if c.Region() != "br-se1" {
+		t.Fatalf("Region() = %q, want br-se1", c.Region())
+	}
+
+	zones, err := c.AvailabilityZones().ListZones(context.Background(), ListOptions{})
+	if err != nil {
+		t.Fatalf("ListZones() error = %v", err)
+	}
+	if len(zones) != 3 {
+		t.Fatalf("ListZones() returned %d zones, want 3", len(zones))
+	}
+	for _, zone := range zones {
+		if zone.RegionID != "br-se1" {
+			t.Errorf("zone %s has region %s, want br-se1", zone.ID, zone.RegionID)
+		}
+	}
+
+	// A second client built from the same core still sees its region.
+	if again := New(core, WithGlobalBasePath(client.MgcUrl(ts.URL))); again.Region() != "br-se1" {
+		t.Errorf("Region() of a second client = %q, want br-se1", again.Region())
+	}
+	if got := core.GetConfig().BaseURL; got != client.BrSe1 {
+		t.Errorf("core BaseURL = %q after New, want %q", got, client.BrSe1)
+	}
+
+	_, err = New(client.NewMgcClient(), WithGlobalBasePath(client.MgcUrl(ts.URL)), WithRegion("")).
+		AvailabilityZones().ListZones(context.Background(), ListOptions{})
+	if !errors.Is(err, ErrUnknownRegion) {
+		t.Errorf("ListZones() without region error = %v, want ErrUnknownRegion", err)
+	}
+}
+
+func TestService_ValidateZone(t *testing.T) {
+	ts := newZonesTestServer(t)
+	defer ts.Close()
+
+	svc := New(client.NewMgcClient(), WithGlobalBasePath(client.MgcUrl(ts.URL)), WithRegion("br-se1")).AvailabilityZones()
+
+	if err := svc.ValidateZone(context.Background(), "br-se1-a"); err != nil {
+		t.Errorf("ValidateZone(br-se1-a) error = %v", err)
+	}
+
+	for _, zone := range []string{"br-se1-c", "br-ne1-a", "br-se1-z"} {
+		err := svc.ValidateZone(context.Background(), zone)
+		validationErr, ok := err.(*client.ValidationError)
+		if !ok || validationErr.Field != "availability_zone" {
+			t.Errorf("ValidateZone(%s) error = %v, want ValidationError", zone, err)
+		}
+	}
+}
+
+func TestListOptions_Serialization(t *testing.T) {
+	t.Parallel()
+
+	opts := ListOptions{ShowBlocked: true}
+
+	data, err := json.Marshal(opts)
+	if err != nil {
+		t.Fatalf("json.Marshal() error = %v", err)
+	}
+	want := `{"show_blocked":true}`
+	if string(data) != want {
+		t.Errorf("json.Marshal() = %s, want %s", data, want)
+	}
+
+	var fromJSON ListOptions
+	if err := json.Unmarshal(data, &fromJSON); err != nil {
+		t.Fatalf("json.Unmarshal() error = %v", err)
+	}
+	if !reflect.DeepEqual(fromJSON, opts) {
+		t.Errorf("JSON round trip = %+v, want %+v", fromJSON, opts)
+	}
+
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(opts); err != nil {
+		t.Fatalf("gob Encode() error = %v", err)
+	}
+	var fromGob ListOptions
+	if err := gob.NewDecoder(&buf).Decode(&fromGob); err != nil {
+		t.Fatalf("gob Decode() error = %v", err)
+	}
+	if !reflect.DeepEqual(fromGob, opts) {
+		t.Errorf("gob round trip = %+v, want %+v", fromGob, opts)
+	}
+}
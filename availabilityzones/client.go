@@ -20,6 +20,7 @@ const (
 // By default, the service uses the global endpoint.
 type Client struct {
 	*client.CoreClient
+	region string
 }
 
 // ClientOption allows customizing the availability zones client configuration
@@ -37,17 +38,30 @@ func WithGlobalBasePath(basePath client.MgcUrl) ClientOption {
 	}
 }
 
+// WithRegion sets the region used by ListZones and ValidateZone, such as "br-se1".
+// By default it is taken from the core client's base URL.
+func WithRegion(region string) ClientOption {
+	return func(c *Client) {
+		c.region = region
+	}
+}
+
 // New creates a new availability zones client using the provided core client.
 // The availability zones service operates globally and is not region-specific.
 // By default, it uses the global endpoint (api.magalu.cloud).
 //
+// The client works on a copy of the core client's configuration, so core
+// keeps its own base URL. The region of that base URL is remembered for
+// ListZones and ValidateZone; use WithRegion to choose another one.
+//
 // To customize the endpoint, use WithGlobalBasePath option.
 func New(core *client.CoreClient, opts ...ClientOption) *Client {
 	if core == nil {
 		return nil
 	}
 	azClient := &Client{
-		CoreClient: core,
+		CoreClient: core.Clone(),
+		region:     core.GetConfig().BaseURL.Region(),
 	}
 
 	azClient.GetConfig().BaseURL = client.Global
@@ -58,6 +72,12 @@ func New(core *client.CoreClient, opts ...ClientOption) *Client {
 	return azClient
 }
 
+// NewDefault creates a new instance of Client using the client installed
+// with client.SetDefault. If no default client is set, returns nil.
+func NewDefault(opts ...ClientOption) *Client {
+	return New(client.Default(), opts...)
+}
+
 // newRequest creates a new HTTP request for the availability zones service.
 // This method is internal and should not be called directly by SDK users.
 func (c *Client) newRequest(ctx context.Context, method, path string, body any) (*http.Request, error) {
@@ -69,3 +89,8 @@ func (c *Client) newRequest(ctx context.Context, method, path string, body any)
 func (c *Client) AvailabilityZones() Service {
 	return &service{client: c}
 }
+
+// Region returns the region used by ListZones and ValidateZone.
+func (c *Client) Region() string {
+	return c.region
+}
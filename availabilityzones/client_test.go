@@ -23,8 +23,14 @@ func TestNewClient(t *testing.T) {
 		t.Error("expected azClient to not be nil")
 		return
 	}
-	if azClient.CoreClient != core {
-		t.Errorf("expected CoreClient to be %v, got %v", core, azClient.CoreClient)
+	if azClient.GetConfig().APIKey != "test-api-key" {
+		t.Errorf("expected the API key of the core client, got %q", azClient.GetConfig().APIKey)
+	}
+	if azClient.GetConfig().BaseURL != client.Global {
+		t.Errorf("expected BaseURL to be %q, got %q", client.Global, azClient.GetConfig().BaseURL)
+	}
+	if core.GetConfig().BaseURL != "http://test-api.com" {
+		t.Errorf("New() changed the core client BaseURL to %q", core.GetConfig().BaseURL)
 	}
 }
 
@@ -39,6 +39,12 @@ func New(core *client.CoreClient, opts ...ClientOption) *BlockStorageClient {
 	return bsClient
 }
 
+// NewDefault creates a new instance of BlockStorageClient using the client installed
+// with client.SetDefault. If no default client is set, returns nil.
+func NewDefault(opts ...ClientOption) *BlockStorageClient {
+	return New(client.Default(), opts...)
+}
+
 // newRequest creates a new HTTP request for the block storage service.
 // This method is internal and should not be called directly by SDK users.
 func (c *BlockStorageClient) newRequest(ctx context.Context, method, path string, body any) (*http.Request, error) {
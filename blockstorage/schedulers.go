@@ -8,6 +8,7 @@ import (
 	"strconv"
 	"time"
 
+	"github.com/MagaluCloud/mgc-sdk-go/helpers"
 	mgc_http "github.com/MagaluCloud/mgc-sdk-go/internal/http"
 )
 
@@ -68,6 +69,16 @@ const (
 	ExpandSchedulersVolume ExpandSchedulers = "volume"
 )
 
+// String returns the expand value as sent to the API.
+func (e ExpandSchedulers) String() string {
+	return string(e)
+}
+
+// IsValid reports whether the expand value is one of the known values.
+func (e ExpandSchedulers) IsValid() bool {
+	return e == ExpandSchedulersVolume
+}
+
 // SchedulerResponse represents a scheduler.
 // A scheduler automates snapshot creation and retention for volumes.
 type SchedulerResponse struct {
@@ -143,14 +154,11 @@ func (s *schedulerService) List(ctx context.Context, opts SchedulerListOptions)
 	if opts.Sort != nil {
 		query.Set("_sort", *opts.Sort)
 	}
-	if len(opts.Expand) > 0 {
-		expandStrs := make([]string, len(opts.Expand))
-		for i, expand := range opts.Expand {
-			expandStrs[i] = string(expand)
-		}
-		for _, expand := range expandStrs {
-			query.Add("expand", expand)
-		}
+	if _, err := helpers.JoinExpand(opts.Expand); err != nil {
+		return nil, err
+	}
+	for _, expand := range opts.Expand {
+		query.Add("expand", expand.String())
 	}
 
 	result, err := mgc_http.ExecuteSimpleRequestWithRespBody[SchedulerListResponse](
@@ -227,14 +235,11 @@ func (s *schedulerService) Get(ctx context.Context, id string, expand []ExpandSc
 	path := fmt.Sprintf("/v1/schedulers/%s", id)
 	query := make(url.Values)
 
-	if len(expand) > 0 {
-		expandStrs := make([]string, len(expand))
-		for i, expand := range expand {
-			expandStrs[i] = string(expand)
-		}
-		for _, expand := range expandStrs {
-			query.Add("expand", expand)
-		}
+	if _, err := helpers.JoinExpand(expand); err != nil {
+		return nil, err
+	}
+	for _, e := range expand {
+		query.Add("expand", e.String())
 	}
 
 	return mgc_http.ExecuteSimpleRequestWithRespBody[SchedulerResponse](
@@ -6,9 +6,9 @@ import (
 	"net/http"
 	"net/url"
 	"strconv"
-	"strings"
 	"time"
 
+	"github.com/MagaluCloud/mgc-sdk-go/helpers"
 	mgc_http "github.com/MagaluCloud/mgc-sdk-go/internal/http"
 )
 
@@ -17,7 +17,18 @@ const (
 	SnapshotVolumeExpand SnapshotExpand = "volume"
 )
 
-type SnapshotExpand = string
+// SnapshotExpand represents the expand options for snapshot responses.
+type SnapshotExpand string
+
+// String returns the expand value as sent to the API.
+func (e SnapshotExpand) String() string {
+	return string(e)
+}
+
+// IsValid reports whether the expand value is one of the known values.
+func (e SnapshotExpand) IsValid() bool {
+	return e == SnapshotVolumeExpand
+}
 
 // ListSnapshotsResponse represents the response from listing snapshots.
 // This structure encapsulates the API response format for snapshots.
@@ -146,7 +157,11 @@ func (s *snapshotService) List(ctx context.Context, opts SnaphotListOptions) (*L
 		q.Add("_sort", *opts.Sort)
 	}
 	if len(opts.Expand) > 0 {
-		q.Add("expand", strings.Join(opts.Expand, ","))
+		expandStr, err := helpers.JoinExpand(opts.Expand)
+		if err != nil {
+			return nil, err
+		}
+		q.Add("expand", expandStr)
 	}
 
 	path := "/v1/snapshots"
@@ -225,7 +240,11 @@ func (s *snapshotService) Create(ctx context.Context, req CreateSnapshotRequest)
 func (s *snapshotService) Get(ctx context.Context, id string, expand []SnapshotExpand) (*Snapshot, error) {
 	path := fmt.Sprintf("/v1/snapshots/%s", id)
 	if len(expand) > 0 {
-		path += "?expand=" + strings.Join(expand, ",")
+		expandStr, err := helpers.JoinExpand(expand)
+		if err != nil {
+			return nil, err
+		}
+		path += "?expand=" + expandStr
 	}
 
 	return mgc_http.ExecuteSimpleRequestWithRespBody[Snapshot](
@@ -80,7 +80,7 @@ func TestSnapshotService_List(t *testing.T) {
 				if tt.opts.Sort != nil && q.Get("_sort") != *tt.opts.Sort {
 					t.Errorf("sort mismatch: got %s", q.Get("_sort"))
 				}
-				if len(tt.opts.Expand) > 0 && q.Get("expand") != strings.Join(tt.opts.Expand, ",") {
+				if len(tt.opts.Expand) > 0 && q.Get("expand") != expandString(tt.opts.Expand) {
 					t.Errorf("expand mismatch: got %s", q.Get("expand"))
 				}
 
@@ -188,7 +188,7 @@ func TestSnapshotService_Get(t *testing.T) {
 	tests := []struct {
 		name       string
 		id         string
-		expand     []string
+		expand     []SnapshotExpand
 		response   string
 		statusCode int
 		want       *Snapshot
@@ -228,7 +228,7 @@ func TestSnapshotService_Get(t *testing.T) {
 
 				if len(tt.expand) > 0 {
 					gotExpand := r.URL.Query().Get("expand")
-					if gotExpand != strings.Join(tt.expand, ",") {
+					if gotExpand != expandString(tt.expand) {
 						t.Errorf("expand mismatch: got %s", gotExpand)
 					}
 				}
@@ -310,6 +310,8 @@ func TestSnapshotService_Delete(t *testing.T) {
 	}
 }
 
+var _ helpers.Renamer = (SnapshotService)(nil)
+
 func TestSnapshotService_Rename(t *testing.T) {
 	tests := []struct {
 		name       string
@@ -550,7 +552,7 @@ func TestSnapshotService_ListAll_WithExpand(t *testing.T) {
 
 	client := testClientSnaphots(server.URL)
 	snapshots, err := client.ListAll(context.Background(), SnapshotFilterOptions{
-		Expand: []string{SnapshotVolumeExpand},
+		Expand: []SnapshotExpand{SnapshotVolumeExpand},
 	})
 
 	if err != nil {
@@ -660,3 +662,11 @@ func testClientSnaphots(baseURL string) SnapshotService {
 		client.WithHTTPClient(httpClient))
 	return New(core).Snapshots()
 }
+
+func expandString(expand []SnapshotExpand) string {
+	parts := make([]string, len(expand))
+	for i, e := range expand {
+		parts[i] = e.String()
+	}
+	return strings.Join(parts, ",")
+}
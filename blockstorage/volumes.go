@@ -5,10 +5,15 @@ import (
 	"fmt"
 	"net/http"
 	"net/url"
+	"slices"
 	"strconv"
+	"strings"
 	"time"
 
+	"github.com/MagaluCloud/mgc-sdk-go/client"
+	"github.com/MagaluCloud/mgc-sdk-go/helpers"
 	mgc_http "github.com/MagaluCloud/mgc-sdk-go/internal/http"
+	"github.com/MagaluCloud/mgc-sdk-go/internal/utils"
 )
 
 // VolumeTypeExpand is a constant used for expanding volume type information in volume responses.
@@ -17,7 +22,18 @@ const (
 	VolumeAttachExpand VolumeExpand = "attachment"
 )
 
-type VolumeExpand = string
+// VolumeExpand represents the expand options for volume responses.
+type VolumeExpand string
+
+// String returns the expand value as sent to the API.
+func (e VolumeExpand) String() string {
+	return string(e)
+}
+
+// IsValid reports whether the expand value is one of the known values.
+func (e VolumeExpand) IsValid() bool {
+	return e == VolumeTypeExpand || e == VolumeAttachExpand
+}
 
 // ListVolumesResponse represents the response from listing volumes.
 // This structure encapsulates the API response format for volumes.
@@ -96,6 +112,25 @@ type CreateVolumeRequest struct {
 	Encrypted        *bool     `json:"encrypted"`
 }
 
+// Validate checks the required fields of the request without calling the API.
+// All problems found are returned together as client.ValidationErrors.
+func (r CreateVolumeRequest) Validate() error {
+	var errs client.ValidationErrors
+	if r.Name == "" {
+		errs.Add("name", utils.CannotBeEmpty)
+	}
+	if r.Size <= 0 {
+		errs.Add("size", "must be greater than zero")
+	}
+	if r.Type.ID == nil && r.Type.Name == nil {
+		errs.Add("type", "id or name is required")
+	}
+	if r.AvailabilityZone != nil && *r.AvailabilityZone == "" {
+		errs.Add("availability_zone", utils.CannotBeEmpty)
+	}
+	return errs.ErrOrNil()
+}
+
 // ExtendVolumeRequest represents the request to extend a volume.
 type ExtendVolumeRequest struct {
 	Size int `json:"size"`
@@ -121,10 +156,10 @@ type IDOrName struct {
 // ListOptions contains options for listing volumes.
 // All fields are optional and allow controlling pagination and expansion.
 type ListOptions struct {
-	Limit  *int
-	Offset *int
-	Sort   *string
-	Expand []VolumeExpand
+	Limit  *int           `json:"_limit,omitempty"`
+	Offset *int           `json:"_offset,omitempty"`
+	Sort   *string        `json:"_sort,omitempty"`
+	Expand []VolumeExpand `json:"expand,omitempty"`
 }
 
 // VolumeFilterOptions provides filtering options for ListAll (without pagination)
@@ -167,13 +202,17 @@ type VolumeService interface {
 	List(ctx context.Context, opts ListOptions) (*ListVolumesResponse, error)
 	ListAll(ctx context.Context, filterOpts VolumeFilterOptions) ([]Volume, error)
 	Create(ctx context.Context, req CreateVolumeRequest) (string, error)
-	Get(ctx context.Context, id string, expand []SnapshotExpand) (*Volume, error)
+	ValidateCreate(ctx context.Context, req CreateVolumeRequest) error
+	Get(ctx context.Context, id string, expand []VolumeExpand) (*Volume, error)
+	GetMany(ctx context.Context, ids []string, expand []VolumeExpand) (map[string]*Volume, []error)
+	GetOrNil(ctx context.Context, id string, expand []VolumeExpand) (*Volume, error)
 	Delete(ctx context.Context, id string) error
 	Rename(ctx context.Context, id string, newName string) error
 	Extend(ctx context.Context, id string, req ExtendVolumeRequest) error
 	Retype(ctx context.Context, id string, req RetypeVolumeRequest) error
 	Attach(ctx context.Context, volumeID string, instanceID string) error
 	Detach(ctx context.Context, volumeID string) error
+	ListSchedulers(ctx context.Context, volumeID string) ([]SchedulerResponse, error)
 }
 
 // volumeService implements the VolumeService interface.
@@ -198,10 +237,11 @@ func (s *volumeService) List(ctx context.Context, opts ListOptions) (*ListVolume
 	if opts.Sort != nil {
 		query.Set("_sort", *opts.Sort)
 	}
-	if len(opts.Expand) > 0 {
-		for _, expand := range opts.Expand {
-			query.Add("expand", expand)
-		}
+	if _, err := helpers.JoinExpand(opts.Expand); err != nil {
+		return nil, err
+	}
+	for _, expand := range opts.Expand {
+		query.Add("expand", expand.String())
 	}
 
 	result, err := mgc_http.ExecuteSimpleRequestWithRespBody[ListVolumesResponse](
@@ -257,6 +297,10 @@ func (s *volumeService) ListAll(ctx context.Context, filterOpts VolumeFilterOpti
 // This method makes an HTTP request to create a new volume
 // and returns the ID of the created volume.
 func (s *volumeService) Create(ctx context.Context, req CreateVolumeRequest) (string, error) {
+	if err := req.Validate(); err != nil {
+		return "", err
+	}
+
 	result, err := mgc_http.ExecuteSimpleRequestWithRespBody[struct{ ID string }](
 		ctx,
 		s.client.newRequest,
@@ -272,16 +316,62 @@ func (s *volumeService) Create(ctx context.Context, req CreateVolumeRequest) (st
 	return result.ID, nil
 }
 
+// ValidateCreate runs the checks made by Create and also checks that the
+// availability zone is offered by the volume type. The volume type catalog is
+// fetched on every call, so Create leaves this check to callers that ask for it.
+func (s *volumeService) ValidateCreate(ctx context.Context, req CreateVolumeRequest) error {
+	if err := req.Validate(); err != nil {
+		return err
+	}
+
+	if req.AvailabilityZone != nil {
+		return s.validateAvailabilityZone(ctx, req)
+	}
+
+	return nil
+}
+
+// validateAvailabilityZone checks that the requested zone is offered by the
+// selected volume type. Volume types that cannot be found are left for the API to reject.
+func (s *volumeService) validateAvailabilityZone(ctx context.Context, req CreateVolumeRequest) error {
+	zone := *req.AvailabilityZone
+	if req.Type.ID == nil && req.Type.Name == nil {
+		return nil
+	}
+
+	types, err := s.client.VolumeTypes().ListAll(ctx, VolumeTypeFilterOptions{})
+	if err != nil {
+		return err
+	}
+
+	for _, volumeType := range types {
+		if (req.Type.ID != nil && volumeType.ID == *req.Type.ID) ||
+			(req.Type.Name != nil && volumeType.Name == *req.Type.Name) {
+			if len(volumeType.AvailabilityZones) == 0 || slices.Contains(volumeType.AvailabilityZones, zone) {
+				return nil
+			}
+			return &client.ValidationError{
+				Field: "availability_zone",
+				Message: fmt.Sprintf("zone %q is not supported by volume type %s (supported: %s)",
+					zone, volumeType.Name, strings.Join(volumeType.AvailabilityZones, ", ")),
+			}
+		}
+	}
+
+	return nil
+}
+
 // Get retrieves a specific volume.
 // This method makes an HTTP request to get detailed information about a volume
 // and optionally expands related resources.
-func (s *volumeService) Get(ctx context.Context, id string, expand []SnapshotExpand) (*Volume, error) {
+func (s *volumeService) Get(ctx context.Context, id string, expand []VolumeExpand) (*Volume, error) {
 	path := fmt.Sprintf("/v1/volumes/%s", id)
 	query := make(url.Values)
-	if len(expand) > 0 {
-		for _, expand := range expand {
-			query.Add("expand", expand)
-		}
+	if _, err := helpers.JoinExpand(expand); err != nil {
+		return nil, err
+	}
+	for _, e := range expand {
+		query.Add("expand", e.String())
 	}
 
 	return mgc_http.ExecuteSimpleRequestWithRespBody[Volume](
@@ -295,6 +385,21 @@ func (s *volumeService) Get(ctx context.Context, id string, expand []SnapshotExp
 	)
 }
 
+// GetMany retrieves several volumes concurrently.
+// It returns the volumes that were found, keyed by ID, and one error per
+// ID that could not be retrieved. Each error is prefixed with its ID.
+func (s *volumeService) GetMany(ctx context.Context, ids []string, expand []VolumeExpand) (map[string]*Volume, []error) {
+	return utils.GetMany(ctx, ids, utils.DefaultGetManyConcurrency, func(ctx context.Context, id string) (*Volume, error) {
+		return s.Get(ctx, id, expand)
+	})
+}
+
+// GetOrNil retrieves a specific volume, returning (nil, nil) when it does
+// not exist. Errors are reserved for real failures.
+func (s *volumeService) GetOrNil(ctx context.Context, id string, expand []VolumeExpand) (*Volume, error) {
+	return utils.NilIfNotFound(s.Get(ctx, id, expand))
+}
+
 // Delete removes a volume.
 // This method makes an HTTP request to delete a volume permanently.
 // The volume must be detached from any instances before it can be deleted.
@@ -383,3 +488,24 @@ func (s *volumeService) Detach(ctx context.Context, volumeID string) error {
 		nil,
 	)
 }
+
+// ListSchedulers returns the snapshot schedulers a volume is attached to.
+// The API has no per-volume filter, so every scheduler is listed and matched by volume ID.
+func (s *volumeService) ListSchedulers(ctx context.Context, volumeID string) ([]SchedulerResponse, error) {
+	if volumeID == "" {
+		return nil, &client.ValidationError{Field: "volumeID", Message: utils.CannotBeEmpty}
+	}
+
+	schedulers, err := s.client.Schedulers().ListAll(ctx, SchedulerFilterOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]SchedulerResponse, 0)
+	for _, scheduler := range schedulers {
+		if slices.Contains(scheduler.Volumes, volumeID) {
+			result = append(result, scheduler)
+		}
+	}
+	return result, nil
+}
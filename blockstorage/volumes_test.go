@@ -1,11 +1,15 @@
 package blockstorage
 
 import (
+	"bytes"
 	"context"
+	"encoding/gob"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
 	"net/http/httptest"
+	"reflect"
 	"strconv"
 	"strings"
 	"testing"
@@ -78,7 +82,7 @@ func TestVolumeService_List(t *testing.T) {
 		{
 			name: "with expansion",
 			opts: ListOptions{
-				Expand: []string{VolumeTypeExpand, VolumeAttachExpand},
+				Expand: []VolumeExpand{VolumeTypeExpand, VolumeAttachExpand},
 			},
 			response: `{
 				"meta": {"page": {"offset": 0, "limit": 50, "count": 1, "total": 1, "max_limit": 100}},
@@ -135,6 +139,7 @@ func TestVolumeService_Create(t *testing.T) {
 		statusCode int
 		wantID     string
 		wantErr    bool
+		wantField  string
 	}{
 		{
 			name: "successful creation",
@@ -153,16 +158,17 @@ func TestVolumeService_Create(t *testing.T) {
 			request: CreateVolumeRequest{
 				Name: "test-vol",
 				Size: 0,
+				Type: IDOrName{Name: helpers.StrPtr("ssd")},
 			},
-			response:   `{"error": "invalid size"}`,
-			statusCode: http.StatusBadRequest,
-			wantErr:    true,
+			wantErr:   true,
+			wantField: "size",
 		},
 		{
 			name: "quota exceeded",
 			request: CreateVolumeRequest{
 				Name: "test-vol",
 				Size: 1000,
+				Type: IDOrName{Name: helpers.StrPtr("ssd")},
 			},
 			response:   `{"error": "quota exceeded"}`,
 			statusCode: http.StatusForbidden,
@@ -180,9 +186,16 @@ func TestVolumeService_Create(t *testing.T) {
 			}))
 			defer server.Close()
 
-			client := testClient(server.URL)
-			id, err := client.Create(context.Background(), tt.request)
+			svc := testClient(server.URL)
+			id, err := svc.Create(context.Background(), tt.request)
 
+			if tt.wantField != "" {
+				var validationErr *client.ValidationError
+				if !errors.As(err, &validationErr) || validationErr.Field != tt.wantField {
+					t.Errorf("expected ValidationError on %s, got %v", tt.wantField, err)
+				}
+				return
+			}
 			if tt.wantErr {
 				assertError(t, err)
 				assertEqual(t, true, strings.Contains(err.Error(), strconv.Itoa(tt.statusCode)))
@@ -195,11 +208,74 @@ func TestVolumeService_Create(t *testing.T) {
 	}
 }
 
+func TestVolumeService_CreateWithAvailabilityZone(t *testing.T) {
+	tests := []struct {
+		name       string
+		zone       string
+		volumeType string
+		wantErr    bool
+		wantPost   bool
+	}{
+		{name: "supported zone", zone: "br-se1-a", volumeType: "cloud_nvme1k", wantPost: true},
+		{name: "unsupported zone", zone: "br-ne1-a", volumeType: "cloud_nvme1k", wantErr: true},
+		{name: "empty zone", zone: "", volumeType: "cloud_nvme1k", wantErr: true},
+		{name: "unknown type is left to the API", zone: "br-ne1-a", volumeType: "other", wantPost: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			posted, creating := false, false
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				switch r.URL.Path {
+				case "/volume/v1/volume-types":
+					if creating {
+						t.Error("Create() fetched the volume type catalog")
+					}
+					w.Write([]byte(`{"meta":{},"types":[{"id":"t1","name":"cloud_nvme1k","availability_zones":["br-se1-a","br-se1-b"]}]}`))
+				case "/volume/v1/volumes":
+					posted = true
+					var body map[string]any
+					json.NewDecoder(r.Body).Decode(&body)
+					assertEqual(t, tt.zone, body["availability_zone"])
+					w.Write([]byte(`{"id": "vol1"}`))
+				default:
+					t.Errorf("unexpected request %s", r.URL.Path)
+				}
+			}))
+			defer server.Close()
+
+			svc := testClient(server.URL)
+			req := CreateVolumeRequest{
+				Name:             "test-vol",
+				Size:             10,
+				Type:             IDOrName{Name: helpers.StrPtr(tt.volumeType)},
+				AvailabilityZone: helpers.StrPtr(tt.zone),
+			}
+			err := svc.ValidateCreate(context.Background(), req)
+			if err == nil {
+				creating = true
+				_, err = svc.Create(context.Background(), req)
+			}
+
+			if tt.wantErr {
+				var validationErr *client.ValidationError
+				if !errors.As(err, &validationErr) || validationErr.Field != "availability_zone" {
+					t.Errorf("expected ValidationError on availability_zone, got %v", err)
+				}
+			} else {
+				assertNoError(t, err)
+			}
+			assertEqual(t, tt.wantPost, posted)
+		})
+	}
+}
+
 func TestVolumeService_Get(t *testing.T) {
 	tests := []struct {
 		name       string
 		id         string
-		expand     []string
+		expand     []VolumeExpand
 		response   string
 		statusCode int
 		want       *Volume
@@ -233,7 +309,7 @@ func TestVolumeService_Get(t *testing.T) {
 		{
 			name:   "with expansion",
 			id:     "vol1",
-			expand: []string{VolumeTypeExpand},
+			expand: []VolumeExpand{VolumeTypeExpand},
 			response: `{
 				"id": "vol1",
 				"type": {"id": "type1"},
@@ -250,7 +326,7 @@ func TestVolumeService_Get(t *testing.T) {
 		{
 			name:   "with attachment expansion",
 			id:     "vol1",
-			expand: []string{VolumeAttachExpand},
+			expand: []VolumeExpand{VolumeAttachExpand},
 			response: `{
 				"id": "vol1",
 				"attachment": {"serial": "12345"},
@@ -352,6 +428,8 @@ func TestVolumeService_Delete(t *testing.T) {
 	}
 }
 
+var _ helpers.Renamer = (VolumeService)(nil)
+
 func TestVolumeService_Rename(t *testing.T) {
 	tests := []struct {
 		name       string
@@ -808,3 +886,223 @@ func testClient(baseURL string) VolumeService {
 		client.WithHTTPClient(httpClient))
 	return New(core).Volumes()
 }
+
+func TestVolumeService_GetMany(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		id := strings.TrimPrefix(r.URL.Path, "/volume/v1/volumes/")
+		assertEqual(t, "attachment", r.URL.Query().Get("expand"))
+
+		w.Header().Set("Content-Type", "application/json")
+		if id == "missing" {
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"error": "volume not found"}`))
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprintf(w, `{"id": %q, "name": "vol-%s", "size": 10}`, id, id)
+	}))
+	defer server.Close()
+
+	client := testClient(server.URL)
+	volumes, errs := client.GetMany(context.Background(), []string{"vol1", "missing", "vol2"}, []VolumeExpand{VolumeAttachExpand})
+
+	assertEqual(t, 2, len(volumes))
+	assertEqual(t, "vol-vol1", volumes["vol1"].Name)
+	assertEqual(t, "vol-vol2", volumes["vol2"].Name)
+	assertEqual(t, 1, len(errs))
+	assertEqual(t, true, strings.HasPrefix(errs[0].Error(), "missing:"))
+}
+
+func TestVolumeService_GetNotFound(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name         string
+		statusCode   int
+		response     string
+		wantNotFound bool
+	}{
+		{name: "not found", statusCode: http.StatusNotFound, response: `{"message": "not found"}`, wantNotFound: true},
+		{name: "not found without body", statusCode: http.StatusNotFound, wantNotFound: true},
+		{name: "forbidden", statusCode: http.StatusForbidden, response: `{"message": "forbidden"}`},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(tt.statusCode)
+				w.Write([]byte(tt.response))
+			}))
+			defer server.Close()
+
+			_, err := testClient(server.URL).Get(context.Background(), "res-1", nil)
+			if err == nil {
+				t.Fatal("Get() error = nil, want an error")
+			}
+			if got := client.IsNotFound(err); got != tt.wantNotFound {
+				t.Errorf("IsNotFound(%v) = %v, want %v", err, got, tt.wantNotFound)
+			}
+		})
+	}
+}
+
+func TestVolumeService_GetOrNil(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name       string
+		statusCode int
+		response   string
+		wantNil    bool
+		wantErr    bool
+	}{
+		{name: "exists", statusCode: http.StatusOK, response: `{"id": "vol-1"}`},
+		{name: "not found", statusCode: http.StatusNotFound, response: `{"message": "not found"}`, wantNil: true},
+		{name: "forbidden", statusCode: http.StatusForbidden, response: `{"message": "forbidden"}`, wantNil: true, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(tt.statusCode)
+				w.Write([]byte(tt.response))
+			}))
+			defer server.Close()
+
+			got, err := testClient(server.URL).GetOrNil(context.Background(), "vol-1", nil)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("GetOrNil() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if (got == nil) != tt.wantNil {
+				t.Fatalf("GetOrNil() = %v, wantNil %v", got, tt.wantNil)
+			}
+			if got != nil && got.ID != "vol-1" {
+				t.Errorf("GetOrNil() ID = %s, want vol-1", got.ID)
+			}
+		})
+	}
+}
+
+func TestVolumeService_ListSchedulers(t *testing.T) {
+	t.Parallel()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/volume/v1/schedulers" {
+			t.Errorf("unexpected path %s", r.URL.Path)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{
+			"meta": {"page": {"offset": 0, "limit": 50, "count": 3, "total": 3}},
+			"schedulers": [
+				{"id": "sched-1", "name": "daily", "volumes": ["vol-1", "vol-2"]},
+				{"id": "sched-2", "name": "weekly", "volumes": ["vol-2"]},
+				{"id": "sched-3", "name": "empty"}
+			]
+		}`))
+	}))
+	defer server.Close()
+
+	svc := testClient(server.URL)
+
+	schedulers, err := svc.ListSchedulers(context.Background(), "vol-1")
+	if err != nil {
+		t.Fatalf("ListSchedulers() error = %v", err)
+	}
+	if len(schedulers) != 1 || schedulers[0].ID != "sched-1" {
+		t.Errorf("ListSchedulers() = %+v, want only sched-1", schedulers)
+	}
+
+	if _, err := svc.ListSchedulers(context.Background(), ""); err == nil {
+		t.Error("expected error for empty volume ID")
+	}
+}
+
+func TestVolumeExpand_IsValid(t *testing.T) {
+	for _, e := range []VolumeExpand{VolumeTypeExpand, VolumeAttachExpand} {
+		if !e.IsValid() {
+			t.Errorf("%s.IsValid() = false, want true", e)
+		}
+	}
+	if VolumeExpand("snapshots").IsValid() {
+		t.Error("unknown expand value reported as valid")
+	}
+}
+
+func TestVolumeService_InvalidExpand(t *testing.T) {
+	t.Parallel()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Error("request should not be sent with an invalid expand value")
+	}))
+	defer server.Close()
+
+	svc := testClient(server.URL)
+	invalid := []VolumeExpand{"snapshots"}
+
+	_, err := svc.Get(context.Background(), "vol-1", invalid)
+	if _, ok := err.(*client.ValidationError); !ok {
+		t.Errorf("Get() error = %v, want ValidationError", err)
+	}
+
+	_, err = svc.List(context.Background(), ListOptions{Expand: invalid})
+	if _, ok := err.(*client.ValidationError); !ok {
+		t.Errorf("List() error = %v, want ValidationError", err)
+	}
+}
+
+func TestCreateVolumeRequest_Validate(t *testing.T) {
+	err := CreateVolumeRequest{}.Validate()
+	var errs client.ValidationErrors
+	if !errors.As(err, &errs) {
+		t.Fatalf("Validate() error = %T, want client.ValidationErrors", err)
+	}
+	assertEqual(t, 3, len(errs))
+	assertEqual(t, "name", errs[0].Field)
+	assertEqual(t, "size", errs[1].Field)
+	assertEqual(t, "type", errs[2].Field)
+
+	valid := CreateVolumeRequest{Name: "test-vol", Size: 10, Type: IDOrName{ID: helpers.StrPtr("type-1")}}
+	assertNoError(t, valid.Validate())
+}
+
+func TestListOptions_Serialization(t *testing.T) {
+	t.Parallel()
+
+	opts := ListOptions{
+		Limit:  helpers.IntPtr(10),
+		Offset: helpers.IntPtr(20),
+		Sort:   helpers.StrPtr("name:asc"),
+		Expand: []VolumeExpand{VolumeTypeExpand},
+	}
+
+	data, err := json.Marshal(opts)
+	if err != nil {
+		t.Fatalf("json.Marshal() error = %v", err)
+	}
+	want := `{"_limit":10,"_offset":20,"_sort":"name:asc","expand":["volume_type"]}`
+	if string(data) != want {
+		t.Errorf("json.Marshal() = %s, want %s", data, want)
+	}
+
+	var fromJSON ListOptions
+	if err := json.Unmarshal(data, &fromJSON); err != nil {
+		t.Fatalf("json.Unmarshal() error = %v", err)
+	}
+	if !reflect.DeepEqual(fromJSON, opts) {
+		t.Errorf("JSON round trip = %+v, want %+v", fromJSON, opts)
+	}
+
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(opts); err != nil {
+		t.Fatalf("gob Encode() error = %v", err)
+	}
+	var fromGob ListOptions
+	if err := gob.NewDecoder(&buf).Decode(&fromGob); err != nil {
+		t.Fatalf("gob Decode() error = %v", err)
+	}
+	if !reflect.DeepEqual(fromGob, opts) {
+		t.Errorf("gob round trip = %+v, want %+v", fromGob, opts)
+	}
+}
@@ -0,0 +1,136 @@
+package client
+
+import (
+	"errors"
+	"sync"
+	"time"
+)
+
+// Default settings used by WithCircuitBreaker for zero-valued fields.
+const (
+	DefaultCircuitBreakerFailureThreshold = 5
+	DefaultCircuitBreakerCooldown         = 30 * time.Second
+)
+
+// ErrCircuitOpen is returned without sending the request while the circuit breaker is open.
+var ErrCircuitOpen = errors.New("circuit breaker is open")
+
+// CircuitState is the state of a CircuitBreaker.
+type CircuitState int
+
+const (
+	// CircuitClosed lets every request through.
+	CircuitClosed CircuitState = iota
+	// CircuitOpen rejects requests with ErrCircuitOpen until the cooldown elapses.
+	CircuitOpen
+	// CircuitHalfOpen lets a single probe request through to decide whether to close again.
+	CircuitHalfOpen
+)
+
+// String returns the name of the state, for use in logs and metrics.
+func (s CircuitState) String() string {
+	switch s {
+	case CircuitClosed:
+		return "closed"
+	case CircuitOpen:
+		return "open"
+	case CircuitHalfOpen:
+		return "half-open"
+	default:
+		return "unknown"
+	}
+}
+
+// CircuitBreakerSettings configures a CircuitBreaker.
+type CircuitBreakerSettings struct {
+	// FailureThreshold is the number of consecutive failed attempts that opens the circuit.
+	FailureThreshold int
+	// Cooldown is how long the circuit stays open before a probe is allowed.
+	Cooldown time.Duration
+}
+
+// CircuitBreaker stops sending requests after repeated failures, so a sustained
+// API outage fails fast instead of waiting on retries and timeouts for every call.
+// Transport errors and 5xx responses count as failures; any other response
+// counts as a success. It is safe for concurrent use.
+type CircuitBreaker struct {
+	mu       sync.Mutex
+	settings CircuitBreakerSettings
+	state    CircuitState
+	failures int
+	openedAt time.Time
+	probing  bool
+	now      func() time.Time
+}
+
+// NewCircuitBreaker creates a closed CircuitBreaker. Zero-valued settings are
+// replaced by DefaultCircuitBreakerFailureThreshold and DefaultCircuitBreakerCooldown.
+func NewCircuitBreaker(settings CircuitBreakerSettings) *CircuitBreaker {
+	if settings.FailureThreshold <= 0 {
+		settings.FailureThreshold = DefaultCircuitBreakerFailureThreshold
+	}
+	if settings.Cooldown <= 0 {
+		settings.Cooldown = DefaultCircuitBreakerCooldown
+	}
+	return &CircuitBreaker{settings: settings, now: time.Now}
+}
+
+// State returns the current state of the breaker.
+func (b *CircuitBreaker) State() CircuitState {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.state == CircuitOpen && b.cooldownElapsed() {
+		return CircuitHalfOpen
+	}
+	return b.state
+}
+
+// Allow reports whether a request may be sent, returning ErrCircuitOpen if not.
+// Every allowed request must be followed by a call to Record.
+func (b *CircuitBreaker) Allow() error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	switch b.state {
+	case CircuitOpen:
+		if !b.cooldownElapsed() {
+			return ErrCircuitOpen
+		}
+		b.state = CircuitHalfOpen
+		b.probing = true
+		b.openedAt = b.now()
+	case CircuitHalfOpen:
+		// A probe whose outcome was never recorded must not block the breaker forever
+		if b.probing && !b.cooldownElapsed() {
+			return ErrCircuitOpen
+		}
+		b.probing = true
+		b.openedAt = b.now()
+	}
+	return nil
+}
+
+// Record reports the outcome of a request allowed by Allow.
+func (b *CircuitBreaker) Record(success bool) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.probing = false
+	if success {
+		b.state = CircuitClosed
+		b.failures = 0
+		return
+	}
+
+	b.failures++
+	if b.state == CircuitHalfOpen || b.failures >= b.settings.FailureThreshold {
+		b.state = CircuitOpen
+		b.openedAt = b.now()
+	}
+}
+
+// cooldownElapsed reports whether the cooldown has passed since the circuit opened
+func (b *CircuitBreaker) cooldownElapsed() bool {
+	return b.now().Sub(b.openedAt) >= b.settings.Cooldown
+}
@@ -0,0 +1,112 @@
+package client
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func newTestCircuitBreaker(threshold int, cooldown time.Duration) (*CircuitBreaker, *time.Time) {
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	b := NewCircuitBreaker(CircuitBreakerSettings{FailureThreshold: threshold, Cooldown: cooldown})
+	b.now = func() time.Time { return now }
+	return b, &now
+}
+
+func TestNewCircuitBreaker_Defaults(t *testing.T) {
+	b := NewCircuitBreaker(CircuitBreakerSettings{})
+
+	if b.settings.FailureThreshold != DefaultCircuitBreakerFailureThreshold {
+		t.Errorf("FailureThreshold = %d, want %d", b.settings.FailureThreshold, DefaultCircuitBreakerFailureThreshold)
+	}
+	if b.settings.Cooldown != DefaultCircuitBreakerCooldown {
+		t.Errorf("Cooldown = %v, want %v", b.settings.Cooldown, DefaultCircuitBreakerCooldown)
+	}
+	if b.State() != CircuitClosed {
+		t.Errorf("State() = %v, want %v", b.State(), CircuitClosed)
+	}
+}
+
+func TestCircuitBreaker_Lifecycle(t *testing.T) {
+	b, now := newTestCircuitBreaker(3, time.Minute)
+
+	for i := range 3 {
+		if err := b.Allow(); err != nil {
+			t.Fatalf("Allow() before threshold, attempt %d: %v", i+1, err)
+		}
+		b.Record(false)
+	}
+	if b.State() != CircuitOpen {
+		t.Fatalf("State() = %v, want %v", b.State(), CircuitOpen)
+	}
+	if err := b.Allow(); !errors.Is(err, ErrCircuitOpen) {
+		t.Fatalf("Allow() while open = %v, want ErrCircuitOpen", err)
+	}
+
+	*now = now.Add(time.Minute)
+	if b.State() != CircuitHalfOpen {
+		t.Fatalf("State() after cooldown = %v, want %v", b.State(), CircuitHalfOpen)
+	}
+	if err := b.Allow(); err != nil {
+		t.Fatalf("Allow() probe: %v", err)
+	}
+	if err := b.Allow(); !errors.Is(err, ErrCircuitOpen) {
+		t.Fatalf("Allow() during probe = %v, want ErrCircuitOpen", err)
+	}
+
+	b.Record(false)
+	if b.State() != CircuitOpen {
+		t.Fatalf("State() after failed probe = %v, want %v", b.State(), CircuitOpen)
+	}
+
+	*now = now.Add(time.Minute)
+	if err := b.Allow(); err != nil {
+		t.Fatalf("Allow() second probe: %v", err)
+	}
+	b.Record(true)
+	if b.State() != CircuitClosed {
+		t.Fatalf("State() after successful probe = %v, want %v", b.State(), CircuitClosed)
+	}
+}
+
+func TestCircuitBreaker_SuccessResetsFailures(t *testing.T) {
+	b, _ := newTestCircuitBreaker(2, time.Minute)
+
+	b.Record(false)
+	b.Record(true)
+	b.Record(false)
+
+	if b.State() != CircuitClosed {
+		t.Errorf("State() = %v, want %v: failures must be consecutive", b.State(), CircuitClosed)
+	}
+}
+
+func TestCircuitState_String(t *testing.T) {
+	tests := map[CircuitState]string{
+		CircuitClosed:    "closed",
+		CircuitOpen:      "open",
+		CircuitHalfOpen:  "half-open",
+		CircuitState(42): "unknown",
+	}
+	for state, want := range tests {
+		if got := state.String(); got != want {
+			t.Errorf("CircuitState(%d).String() = %q, want %q", state, got, want)
+		}
+	}
+}
+
+func TestWithCircuitBreaker(t *testing.T) {
+	config := &Config{}
+	if config.CircuitBreaker != nil {
+		t.Fatal("expected circuit breaker to be disabled by default")
+	}
+
+	WithCircuitBreaker(CircuitBreakerSettings{FailureThreshold: 2})(config)
+
+	if config.CircuitBreaker == nil {
+		t.Fatal("expected circuit breaker to be set")
+	}
+	if config.CircuitBreaker.settings.FailureThreshold != 2 {
+		t.Errorf("FailureThreshold = %d, want 2", config.CircuitBreaker.settings.FailureThreshold)
+	}
+}
@@ -3,8 +3,11 @@
 package client
 
 import (
+	"context"
 	"log/slog"
+	"maps"
 	"net/http"
+	"sync"
 	"time"
 )
 
@@ -26,6 +29,12 @@ type XRequestID string
 // It encapsulates the configuration and provides methods for making HTTP requests.
 type CoreClient struct {
 	config Config
+	// ownedHTTPClient is the HTTP client built by NewMgcClient, if any. Close
+	// only closes the idle connections of that client.
+	ownedHTTPClient *http.Client
+
+	closeOnce sync.Once
+	closeErr  error
 }
 
 // NewMgcClient creates a new instance of CoreClient with the specified API key and options.
@@ -52,10 +61,21 @@ func NewMgcClient(opts ...Option) *CoreClient {
 		opt(cfg)
 	}
 
+	var owned *http.Client
+	if cfg.Transport != nil {
+		httpClient := http.Client{}
+		if cfg.HTTPClient != nil {
+			httpClient = *cfg.HTTPClient
+		}
+		httpClient.Transport = cfg.Transport
+		cfg.HTTPClient = &httpClient
+		owned = cfg.HTTPClient
+	}
+
 	cfg.Logger.Debug("creating new core client",
 		"baseURL", cfg.BaseURL.String(),
 		"userAgent", cfg.UserAgent)
-	return &CoreClient{config: *cfg}
+	return &CoreClient{config: *cfg, ownedHTTPClient: owned}
 }
 
 // GetConfig returns a pointer to the client's configuration.
@@ -63,3 +83,32 @@ func NewMgcClient(opts ...Option) *CoreClient {
 func (c *CoreClient) GetConfig() *Config {
 	return &c.config
 }
+
+// Clone returns a CoreClient with a copy of c's configuration. Changes made
+// through the copy's GetConfig, such as a different BaseURL or CustomHeaders,
+// do not affect c. The copy shares c's HTTP client and leaves closing it to
+// c. It also shares c's CircuitBreaker on purpose, so failures seen by either
+// client open the circuit for both.
+func (c *CoreClient) Clone() *CoreClient {
+	clone := &CoreClient{config: c.config}
+	clone.config.CustomHeaders = maps.Clone(c.config.CustomHeaders)
+	return clone
+}
+
+// Close flushes metrics buffered by a MetricsFlusher recorder and closes the
+// idle keep-alive connections of the HTTP client built for WithTransport.
+// Clients passed with WithHTTPClient, and the default http.DefaultClient, are
+// shared with the rest of the program and left open. Close is meant as a
+// shutdown hook for long-running services; ctx bounds the flush. Only the
+// first call does any work, later calls return the same result.
+func (c *CoreClient) Close(ctx context.Context) error {
+	c.closeOnce.Do(func() {
+		if flusher, ok := c.config.Metrics.(MetricsFlusher); ok {
+			c.closeErr = flusher.Flush(ctx)
+		}
+		if c.ownedHTTPClient != nil && c.config.HTTPClient == c.ownedHTTPClient {
+			c.ownedHTTPClient.CloseIdleConnections()
+		}
+	})
+	return c.closeErr
+}
@@ -1,6 +1,11 @@
 package client
 
 import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
 	"testing"
 	"time"
 )
@@ -52,6 +57,54 @@ func TestNew(t *testing.T) {
 	}
 }
 
+func TestNew_WithTransport(t *testing.T) {
+	var called bool
+	transport := roundTripperFunc(func(r *http.Request) (*http.Response, error) {
+		called = true
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Body:       io.NopCloser(strings.NewReader(`{"message":"stubbed"}`)),
+			Header:     make(http.Header),
+			Request:    r,
+		}, nil
+	})
+
+	t.Run("default http client is not modified", func(t *testing.T) {
+		client := NewMgcClient(WithTransport(transport))
+
+		if client.config.HTTPClient == http.DefaultClient {
+			t.Fatal("expected a copy of the default HTTP client")
+		}
+		if http.DefaultClient.Transport != nil {
+			t.Error("expected http.DefaultClient transport to be untouched")
+		}
+
+		resp, err := client.config.HTTPClient.Get("https://example.com")
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		resp.Body.Close()
+		if !called {
+			t.Error("expected request to go through the custom transport")
+		}
+	})
+
+	t.Run("applied regardless of option order", func(t *testing.T) {
+		custom := &http.Client{Timeout: 7 * time.Second}
+		client := NewMgcClient(WithTransport(transport), WithHTTPClient(custom))
+
+		if client.config.HTTPClient.Timeout != 7*time.Second {
+			t.Errorf("expected custom client settings to be kept, got timeout %v", client.config.HTTPClient.Timeout)
+		}
+		if client.config.HTTPClient.Transport == nil {
+			t.Error("expected transport to be applied")
+		}
+		if custom.Transport != nil {
+			t.Error("expected caller's HTTP client to be untouched")
+		}
+	})
+}
+
 func TestCoreClient_GetConfig(t *testing.T) {
 	// Arrange
 	expectedAPIKey := "test-api-key"
@@ -94,3 +147,98 @@ func TestCoreClient_GetConfig_WithJWToken(t *testing.T) {
 		t.Errorf("expected Timeout %v, got %v", expectedTimeout, config.Timeout)
 	}
 }
+
+func TestCoreClient_Clone(t *testing.T) {
+	core := NewMgcClient(
+		WithAPIKey("test-api-key"),
+		WithBaseURL(BrSe1),
+		WithCustomHeader("X-Team", "platform"),
+		WithCircuitBreaker(CircuitBreakerSettings{}),
+	)
+
+	clone := core.Clone()
+	clone.GetConfig().BaseURL = Global
+	clone.GetConfig().CustomHeaders["X-Team"] = "billing"
+
+	if clone.GetConfig().APIKey != "test-api-key" {
+		t.Errorf("expected the clone to keep the APIKey, got %q", clone.GetConfig().APIKey)
+	}
+	if core.GetConfig().BaseURL != BrSe1 {
+		t.Errorf("expected the original BaseURL %s, got %s", BrSe1, core.GetConfig().BaseURL)
+	}
+	if got := core.GetConfig().CustomHeaders["X-Team"]; got != "platform" {
+		t.Errorf("expected the original X-Team header platform, got %q", got)
+	}
+	if clone.GetConfig().CircuitBreaker != core.GetConfig().CircuitBreaker {
+		t.Error("expected the clone to share the circuit breaker")
+	}
+}
+
+type flushingRecorder struct {
+	flushes int
+	err     error
+}
+
+func (r *flushingRecorder) ObserveRequest(RequestMetrics) {}
+
+func (r *flushingRecorder) Flush(ctx context.Context) error {
+	r.flushes++
+	return r.err
+}
+
+type idleClosingTransport struct {
+	closed int
+}
+
+func (t *idleClosingTransport) RoundTrip(*http.Request) (*http.Response, error) {
+	return nil, errors.New("not used")
+}
+
+func (t *idleClosingTransport) CloseIdleConnections() {
+	t.closed++
+}
+
+func TestCoreClient_Close(t *testing.T) {
+	recorder := &flushingRecorder{err: errors.New("export failed")}
+	transport := &idleClosingTransport{}
+	c := NewMgcClient(WithMetrics(recorder), WithTransport(transport))
+
+	for range 2 {
+		if err := c.Close(context.Background()); err == nil || err.Error() != "export failed" {
+			t.Errorf("Close() error = %v, want export failed", err)
+		}
+	}
+	if recorder.flushes != 1 {
+		t.Errorf("Flush called %d times, want 1", recorder.flushes)
+	}
+	if transport.closed != 1 {
+		t.Errorf("CloseIdleConnections called %d times, want 1", transport.closed)
+	}
+}
+
+func TestCoreClient_CloseSharedHTTPClient(t *testing.T) {
+	transport := &idleClosingTransport{}
+	shared := &http.Client{Transport: transport}
+
+	if err := NewMgcClient(WithHTTPClient(shared)).Close(context.Background()); err != nil {
+		t.Errorf("Close() error = %v", err)
+	}
+	if transport.closed != 0 {
+		t.Errorf("Close() closed the idle connections of a caller's HTTP client")
+	}
+
+	owner := NewMgcClient(WithTransport(transport))
+	if err := owner.Clone().Close(context.Background()); err != nil {
+		t.Errorf("Close() error = %v", err)
+	}
+	if transport.closed != 0 {
+		t.Errorf("Close() on a clone closed the idle connections of the original client")
+	}
+}
+
+func TestCoreClient_CloseWithoutTelemetry(t *testing.T) {
+	c := NewMgcClient(WithHTTPClient(nil))
+	if err := c.Close(context.Background()); err != nil {
+		t.Errorf("Close() error = %v", err)
+	}
+}
@@ -17,16 +17,22 @@ type RetryConfig struct {
 
 // Config contains all configuration options for the client.
 type Config struct {
-	APIKey        string
-	JWToken       string
-	BaseURL       MgcUrl
-	UserAgent     string
-	Logger        *slog.Logger
-	HTTPClient    *http.Client
-	Timeout       time.Duration
-	RetryConfig   RetryConfig
-	ContentType   string
-	CustomHeaders map[string]string
+	APIKey               string
+	JWToken              string
+	BaseURL              MgcUrl
+	UserAgent            string
+	Logger               *slog.Logger
+	HTTPClient           *http.Client
+	Transport            http.RoundTripper
+	Timeout              time.Duration
+	RetryConfig          RetryConfig
+	RetryPolicy          RetryPolicy
+	RetryJitter          RetryJitter
+	CircuitBreaker       *CircuitBreaker
+	Metrics              MetricsRecorder
+	ContentType          string
+	CustomHeaders        map[string]string
+	OperationLabelHeader string
 }
 
 // Option is a function type that modifies the client configuration.
@@ -34,7 +40,9 @@ type Config struct {
 type Option func(*Config)
 
 // WithAPIKey sets the API key for authentication.
-// This option is required for all API operations.
+// The key is sent in the X-API-Key header of every request. Either an API key
+// or a JWToken is required for API operations; see NewFromEnv to read the key
+// from the environment.
 func WithAPIKey(key string) Option {
 	return func(c *Config) {
 		c.APIKey = key
@@ -42,7 +50,8 @@ func WithAPIKey(key string) Option {
 }
 
 // WithJWToken sets the JWToken for authentication.
-// This option allows specifying a custom JWToken for authentication.
+// The token is sent in the Authorization header, with a "Bearer " prefix
+// added when missing. If an API key is also set, both headers are sent.
 func WithJWToken(token string) Option {
 	return func(c *Config) {
 		if strings.HasPrefix(token, "Bearer ") {
@@ -85,6 +94,15 @@ func WithHTTPClient(client *http.Client) Option {
 	}
 }
 
+// WithTransport sets the round tripper used to send requests.
+// The transport is applied on top of the configured HTTP client, regardless of
+// option order, which makes it easy to stub API responses in unit tests.
+func WithTransport(transport http.RoundTripper) Option {
+	return func(c *Config) {
+		c.Transport = transport
+	}
+}
+
 // WithTimeout sets the timeout for HTTP requests.
 // This option controls how long to wait for responses.
 func WithTimeout(timeout time.Duration) Option {
@@ -106,6 +124,52 @@ func WithRetryConfig(maxAttempts int, initialInterval, maxInterval time.Duration
 	}
 }
 
+// WithRetryPolicy sets the function that decides whether a failed attempt is retried.
+// The number of attempts and the backoff are still controlled by WithRetryConfig.
+// When unset, DefaultRetryPolicy is used, which avoids retrying POST and PATCH
+// requests after ambiguous failures unless they carry an Idempotency-Key header.
+func WithRetryPolicy(policy RetryPolicy) Option {
+	return func(c *Config) {
+		c.RetryPolicy = policy
+	}
+}
+
+// WithRetryJitter randomizes the backoff between retries, so clients that fail
+// together do not retry in lockstep. The backoff computed from WithRetryConfig
+// becomes the upper bound of the delay. Jitter is disabled by default.
+func WithRetryJitter(jitter RetryJitter) Option {
+	return func(c *Config) {
+		c.RetryJitter = jitter
+	}
+}
+
+// WithCircuitBreaker enables a circuit breaker that opens after
+// settings.FailureThreshold consecutive failed attempts and rejects requests
+// with ErrCircuitOpen until settings.Cooldown has passed. Its state can be read
+// from Config.CircuitBreaker. Circuit breaking is disabled by default.
+func WithCircuitBreaker(settings CircuitBreakerSettings) Option {
+	return func(c *Config) {
+		c.CircuitBreaker = NewCircuitBreaker(settings)
+	}
+}
+
+// WithMetrics reports every HTTP attempt to the given recorder, labeled by
+// service, operation and status code. See the prommetrics package for a
+// Prometheus recorder.
+func WithMetrics(recorder MetricsRecorder) Option {
+	return func(c *Config) {
+		c.Metrics = recorder
+	}
+}
+
+// WithOperationLabelHeader sends the label set with WithOperationLabel in the
+// given request header. Without this option the label is only logged.
+func WithOperationLabelHeader(name string) Option {
+	return func(c *Config) {
+		c.OperationLabelHeader = name
+	}
+}
+
 // WithCustomHeader adds a custom HTTP header to all requests.
 // This option allows adding additional headers for specific requirements.
 func WithCustomHeader(key, value string) Option {
@@ -85,6 +85,23 @@ func TestWithHTTPClient(t *testing.T) {
 	}
 }
 
+type roundTripperFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(r *http.Request) (*http.Response, error) {
+	return f(r)
+}
+
+func TestWithTransport(t *testing.T) {
+	config := &Config{}
+	transport := roundTripperFunc(func(*http.Request) (*http.Response, error) { return nil, nil })
+
+	WithTransport(transport)(config)
+
+	if config.Transport == nil {
+		t.Error("Expected Transport to be set")
+	}
+}
+
 func TestWithTimeout(t *testing.T) {
 	config := &Config{}
 	timeout := 30 * time.Second
@@ -117,6 +134,19 @@ func TestWithRetryConfig(t *testing.T) {
 	}
 }
 
+func TestWithRetryJitter(t *testing.T) {
+	config := &Config{}
+	if config.RetryJitter != NoJitter {
+		t.Errorf("Expected jitter to be disabled by default, got %v", config.RetryJitter)
+	}
+
+	WithRetryJitter(FullJitter)(config)
+
+	if config.RetryJitter != FullJitter {
+		t.Errorf("Expected RetryJitter to be %v, got %v", FullJitter, config.RetryJitter)
+	}
+}
+
 func TestMultipleOptions(t *testing.T) {
 	config := &Config{}
 	apiKey := "test-api-key"
@@ -0,0 +1,20 @@
+package client
+
+import "sync/atomic"
+
+// defaultClient holds the client installed with SetDefault.
+var defaultClient atomic.Pointer[CoreClient]
+
+// SetDefault installs c as the shared client returned by Default, so code in
+// many places can reuse one configured client. Passing nil clears it.
+// Constructing clients explicitly with NewMgcClient remains the recommended
+// approach; the default client is opt-in.
+func SetDefault(c *CoreClient) {
+	defaultClient.Store(c)
+}
+
+// Default returns the client installed with SetDefault, or nil if none is set.
+// Each service package offers a NewDefault constructor that uses it.
+func Default() *CoreClient {
+	return defaultClient.Load()
+}
@@ -0,0 +1,22 @@
+package client
+
+import "testing"
+
+func TestDefault(t *testing.T) {
+	t.Cleanup(func() { SetDefault(nil) })
+
+	if Default() != nil {
+		t.Fatal("expected no default client before SetDefault")
+	}
+
+	c := NewMgcClient(WithAPIKey("test-api-key"))
+	SetDefault(c)
+	if got := Default(); got != c {
+		t.Errorf("Default() = %p, want %p", got, c)
+	}
+
+	SetDefault(nil)
+	if Default() != nil {
+		t.Error("expected SetDefault(nil) to clear the default client")
+	}
+}
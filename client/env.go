@@ -0,0 +1,37 @@
+package client
+
+import (
+	"errors"
+	"os"
+)
+
+// Environment variables read by NewFromEnv, in order of precedence.
+const (
+	EnvAPIKey   = "MGC_API_KEY"
+	EnvAPIToken = "MGC_API_TOKEN"
+)
+
+// ErrMissingAPIKey is returned by NewFromEnv when no API key is set in the environment.
+var ErrMissingAPIKey = errors.New("API key not found: set the " + EnvAPIKey + " or " + EnvAPIToken + " environment variable")
+
+// APIKeyFromEnv returns the API key from MGC_API_KEY or, when that is unset or
+// empty, from MGC_API_TOKEN. It returns ErrMissingAPIKey if neither is set.
+func APIKeyFromEnv() (string, error) {
+	for _, name := range []string{EnvAPIKey, EnvAPIToken} {
+		if value := os.Getenv(name); value != "" {
+			return value, nil
+		}
+	}
+	return "", ErrMissingAPIKey
+}
+
+// NewFromEnv creates a CoreClient authenticated with the API key found by
+// APIKeyFromEnv. The key is sent in the X-API-Key header, as with WithAPIKey.
+// Additional options are applied after the key, so they may override it.
+func NewFromEnv(opts ...Option) (*CoreClient, error) {
+	apiKey, err := APIKeyFromEnv()
+	if err != nil {
+		return nil, err
+	}
+	return NewMgcClient(append([]Option{WithAPIKey(apiKey)}, opts...)...), nil
+}
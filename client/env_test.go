@@ -0,0 +1,91 @@
+package client
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestAPIKeyFromEnv(t *testing.T) {
+	tests := []struct {
+		name    string
+		apiKey  string
+		token   string
+		want    string
+		wantErr bool
+	}{
+		{name: "api key only", apiKey: "key", want: "key"},
+		{name: "api token only", token: "token", want: "token"},
+		{name: "api key takes precedence", apiKey: "key", token: "token", want: "key"},
+		{name: "neither set", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv(EnvAPIKey, tt.apiKey)
+			t.Setenv(EnvAPIToken, tt.token)
+
+			got, err := APIKeyFromEnv()
+			if tt.wantErr {
+				if !errors.Is(err, ErrMissingAPIKey) {
+					t.Errorf("APIKeyFromEnv() error = %v, want ErrMissingAPIKey", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("APIKeyFromEnv() unexpected error: %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("APIKeyFromEnv() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestErrMissingAPIKey_NamesBothVariables(t *testing.T) {
+	msg := ErrMissingAPIKey.Error()
+	if !strings.Contains(msg, EnvAPIKey) || !strings.Contains(msg, EnvAPIToken) {
+		t.Errorf("ErrMissingAPIKey = %q, want both %s and %s", msg, EnvAPIKey, EnvAPIToken)
+	}
+}
+
+func TestNewFromEnv(t *testing.T) {
+	t.Run("uses environment key", func(t *testing.T) {
+		t.Setenv(EnvAPIKey, "")
+		t.Setenv(EnvAPIToken, "env-token")
+
+		c, err := NewFromEnv(WithUserAgent("test-agent"))
+		if err != nil {
+			t.Fatalf("NewFromEnv() unexpected error: %v", err)
+		}
+		if c.GetConfig().APIKey != "env-token" {
+			t.Errorf("NewFromEnv() APIKey = %q, want env-token", c.GetConfig().APIKey)
+		}
+		if c.GetConfig().UserAgent != "test-agent" {
+			t.Errorf("NewFromEnv() UserAgent = %q, want test-agent", c.GetConfig().UserAgent)
+		}
+	})
+
+	t.Run("options override environment key", func(t *testing.T) {
+		t.Setenv(EnvAPIKey, "env-key")
+		t.Setenv(EnvAPIToken, "")
+
+		c, err := NewFromEnv(WithAPIKey("explicit"))
+		if err != nil {
+			t.Fatalf("NewFromEnv() unexpected error: %v", err)
+		}
+		if c.GetConfig().APIKey != "explicit" {
+			t.Errorf("NewFromEnv() APIKey = %q, want explicit", c.GetConfig().APIKey)
+		}
+	})
+
+	t.Run("missing key", func(t *testing.T) {
+		t.Setenv(EnvAPIKey, "")
+		t.Setenv(EnvAPIToken, "")
+
+		c, err := NewFromEnv()
+		if c != nil || !errors.Is(err, ErrMissingAPIKey) {
+			t.Errorf("NewFromEnv() = %v, %v; want nil, ErrMissingAPIKey", c, err)
+		}
+	})
+}
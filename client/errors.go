@@ -1,9 +1,11 @@
 package client
 
 import (
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
+	"strings"
 )
 
 // HTTPError represents an error that occurred during an HTTP request.
@@ -33,6 +35,69 @@ func NewHTTPError(resp *http.Response) *HTTPError {
 	}
 }
 
+// ErrNotFound matches, with errors.Is, any HTTPError carrying a 404 status code.
+// Services return HTTPError unchanged, so this works for every Get method.
+var ErrNotFound = errors.New("resource not found")
+
+// ErrNotModified matches, with errors.Is, any HTTPError carrying a 304 status code.
+// Conditional list calls return it when the ETag sent in IfNoneMatch is still current.
+var ErrNotModified = errors.New("resource not modified")
+
+// Is reports whether target is ErrNotFound and the error is a 404 response,
+// or target is ErrNotModified and the error is a 304 response.
+// This lets callers use errors.Is(err, ErrNotFound) on wrapped errors.
+func (e *HTTPError) Is(target error) bool {
+	switch target {
+	case ErrNotFound:
+		return e.StatusCode == http.StatusNotFound
+	case ErrNotModified:
+		return e.StatusCode == http.StatusNotModified
+	}
+	return false
+}
+
+// IsNotFound reports whether err is, or wraps, an HTTP 404 response.
+// This allows reconcilers to tell a deleted resource apart from a transient failure.
+func IsNotFound(err error) bool {
+	return errors.Is(err, ErrNotFound)
+}
+
+// IsNotModified reports whether err is, or wraps, an HTTP 304 response.
+// Callers polling a catalog keep their previous result when it returns true.
+func IsNotModified(err error) bool {
+	return errors.Is(err, ErrNotModified)
+}
+
+// busyMarkers are the phrases with which the APIs explain, in a 409 response,
+// that a resource is still completing a previous operation, as opposed to a
+// lasting conflict such as a duplicate name.
+var busyMarkers = []string{"is busy", "in progress", "not ready", "transitioning"}
+
+// IsResourceBusy reports whether err is, or wraps, an HTTPError saying that a
+// resource is still completing an earlier asynchronous operation (attach,
+// detach, retype, ...) and cannot accept a new one yet: a 423 response, or a
+// 409 whose body says so. The request can be repeated once the resource
+// settles.
+func IsResourceBusy(err error) bool {
+	var httpErr *HTTPError
+	if !errors.As(err, &httpErr) {
+		return false
+	}
+
+	switch httpErr.StatusCode {
+	case http.StatusLocked:
+		return true
+	case http.StatusConflict:
+		body := strings.ToLower(string(httpErr.Body))
+		for _, marker := range busyMarkers {
+			if strings.Contains(body, marker) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
 // ValidationError represents an error that occurred during input validation.
 // This error type includes the field that failed validation and a descriptive message.
 type ValidationError struct {
@@ -46,6 +111,44 @@ func (e *ValidationError) Error() string {
 	return fmt.Sprintf("validation error: %s - %s", e.Field, e.Message)
 }
 
+// ValidationErrors collects every problem found while validating a request,
+// so that all of them can be fixed at once instead of one per call.
+type ValidationErrors []*ValidationError
+
+// Error returns the validation problems joined by "; ".
+// This method implements the error interface.
+func (e ValidationErrors) Error() string {
+	msgs := make([]string, len(e))
+	for i, err := range e {
+		msgs[i] = fmt.Sprintf("%s - %s", err.Field, err.Message)
+	}
+	return "validation errors: " + strings.Join(msgs, "; ")
+}
+
+// Unwrap returns the individual validation errors, so errors.As can extract
+// a single ValidationError.
+func (e ValidationErrors) Unwrap() []error {
+	errs := make([]error, len(e))
+	for i, err := range e {
+		errs[i] = err
+	}
+	return errs
+}
+
+// Add records a problem with the given field.
+func (e *ValidationErrors) Add(field, message string) {
+	*e = append(*e, &ValidationError{Field: field, Message: message})
+}
+
+// ErrOrNil returns nil when no problems were recorded, and the collected
+// errors otherwise.
+func (e ValidationErrors) ErrOrNil() error {
+	if len(e) == 0 {
+		return nil
+	}
+	return e
+}
+
 // RetryError represents an error that occurred after exhausting all retry attempts.
 // This error type includes the last error encountered and the number of retries attempted.
 type RetryError struct {
@@ -2,6 +2,7 @@ package client
 
 import (
 	"bytes"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
@@ -135,6 +136,34 @@ func TestValidationError_Error(t *testing.T) {
 	}
 }
 
+func TestValidationErrors(t *testing.T) {
+	var errs ValidationErrors
+	if err := errs.ErrOrNil(); err != nil {
+		t.Fatalf("ErrOrNil() = %v, want nil", err)
+	}
+
+	errs.Add("name", "cannot be empty")
+	errs.Add("size", "must be greater than zero")
+
+	err := errs.ErrOrNil()
+	if err == nil {
+		t.Fatal("ErrOrNil() = nil, want error")
+	}
+	want := "validation errors: name - cannot be empty; size - must be greater than zero"
+	if err.Error() != want {
+		t.Errorf("Error() = %q, want %q", err.Error(), want)
+	}
+
+	var single *ValidationError
+	if !errors.As(err, &single) || single.Field != "name" {
+		t.Errorf("errors.As() = %v, want first ValidationError", single)
+	}
+	var all ValidationErrors
+	if !errors.As(err, &all) || len(all) != 2 {
+		t.Errorf("errors.As() = %v, want both problems", all)
+	}
+}
+
 func TestRetryError_Error(t *testing.T) {
 	tests := []struct {
 		name      string
@@ -174,3 +203,78 @@ func TestRetryError_Error(t *testing.T) {
 		})
 	}
 }
+
+func TestIsNotFound(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{name: "nil", err: nil, want: false},
+		{name: "404 HTTP error", err: &HTTPError{StatusCode: http.StatusNotFound}, want: true},
+		{name: "wrapped 404", err: fmt.Errorf("get instance: %w", &HTTPError{StatusCode: http.StatusNotFound}), want: true},
+		{name: "500 HTTP error", err: &HTTPError{StatusCode: http.StatusInternalServerError}, want: false},
+		{name: "validation error", err: &ValidationError{Field: "id", Message: "cannot be empty"}, want: false},
+		{name: "plain error", err: fmt.Errorf("not found"), want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := IsNotFound(tt.err); got != tt.want {
+				t.Errorf("IsNotFound() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestIsNotModified(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{name: "nil", err: nil, want: false},
+		{name: "304 HTTP error", err: &HTTPError{StatusCode: http.StatusNotModified}, want: true},
+		{name: "wrapped 304", err: fmt.Errorf("list images: %w", &HTTPError{StatusCode: http.StatusNotModified}), want: true},
+		{name: "404 HTTP error", err: &HTTPError{StatusCode: http.StatusNotFound}, want: false},
+		{name: "plain error", err: fmt.Errorf("not modified"), want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := IsNotModified(tt.err); got != tt.want {
+				t.Errorf("IsNotModified() = %v, want %v", got, tt.want)
+			}
+			if tt.want && IsNotFound(tt.err) {
+				t.Error("IsNotFound() = true for a 304 response")
+			}
+		})
+	}
+}
+
+func TestIsResourceBusy(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{name: "nil", err: nil, want: false},
+		{name: "423 locked", err: &HTTPError{StatusCode: http.StatusLocked}, want: true},
+		{name: "409 busy", err: &HTTPError{StatusCode: http.StatusConflict, Body: []byte(`{"message": "Volume is busy"}`)}, want: true},
+		{name: "409 operation in progress", err: &HTTPError{StatusCode: http.StatusConflict, Body: []byte(`{"message": "Another operation is in progress"}`)}, want: true},
+		{name: "wrapped 423", err: fmt.Errorf("attach volume: %w", &HTTPError{StatusCode: http.StatusLocked}), want: true},
+		{name: "409 duplicate name", err: &HTTPError{StatusCode: http.StatusConflict, Body: []byte(`{"message": "name already exists"}`)}, want: false},
+		{name: "409 pending approval", err: &HTTPError{StatusCode: http.StatusConflict, Body: []byte(`{"message": "request pending approval"}`)}, want: false},
+		{name: "409 locked by policy", err: &HTTPError{StatusCode: http.StatusConflict, Body: []byte(`{"message": "object is locked by retention policy"}`)}, want: false},
+		{name: "400 mentioning busy", err: &HTTPError{StatusCode: http.StatusBadRequest, Body: []byte("volume is busy")}, want: false},
+		{name: "plain error", err: errors.New("volume is busy"), want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := IsResourceBusy(tt.err); got != tt.want {
+				t.Errorf("IsResourceBusy() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
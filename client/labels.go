@@ -0,0 +1,21 @@
+package client
+
+import "context"
+
+// operationLabelKey is the context key under which WithOperationLabel stores the label.
+type operationLabelKey struct{}
+
+// WithOperationLabel returns a context carrying a caller-supplied label that
+// identifies the business operation behind a request, such as "nightly-backup".
+// Requests made with the context include the label in their log entries and,
+// when WithOperationLabelHeader is configured, in a request header.
+func WithOperationLabel(ctx context.Context, label string) context.Context {
+	return context.WithValue(ctx, operationLabelKey{}, label)
+}
+
+// OperationLabelFromContext returns the label set by WithOperationLabel, or an
+// empty string when none is set.
+func OperationLabelFromContext(ctx context.Context) string {
+	label, _ := ctx.Value(operationLabelKey{}).(string)
+	return label
+}
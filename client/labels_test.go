@@ -0,0 +1,26 @@
+package client
+
+import (
+	"context"
+	"testing"
+)
+
+func TestOperationLabel(t *testing.T) {
+	if got := OperationLabelFromContext(context.Background()); got != "" {
+		t.Errorf("OperationLabelFromContext() on empty context = %q, want empty", got)
+	}
+
+	ctx := WithOperationLabel(context.Background(), "nightly-backup")
+	if got := OperationLabelFromContext(ctx); got != "nightly-backup" {
+		t.Errorf("OperationLabelFromContext() = %q, want %q", got, "nightly-backup")
+	}
+}
+
+func TestWithOperationLabelHeader(t *testing.T) {
+	config := &Config{}
+	WithOperationLabelHeader("X-Operation")(config)
+
+	if config.OperationLabelHeader != "X-Operation" {
+		t.Errorf("OperationLabelHeader = %q, want %q", config.OperationLabelHeader, "X-Operation")
+	}
+}
@@ -0,0 +1,35 @@
+package client
+
+import (
+	"context"
+	"time"
+)
+
+// RequestMetrics describes a single HTTP attempt made by the client, including
+// each retry.
+type RequestMetrics struct {
+	// Service is the product addressed by the request, such as "compute" or "network".
+	Service string
+	// Operation is the HTTP method. Labels set with WithOperationLabel are
+	// free-form, so they go to the logs only and never become metric labels.
+	Operation string
+	// StatusCode is the response status, or zero when no response was received.
+	StatusCode int
+	// Duration is the time spent waiting for the response headers.
+	Duration time.Duration
+	// Err is the transport error or the error built from a non-2xx response.
+	Err error
+}
+
+// MetricsRecorder receives metrics for every HTTP attempt made by the client.
+// Implementations must be safe for concurrent use. The prommetrics package
+// provides a Prometheus implementation.
+type MetricsRecorder interface {
+	ObserveRequest(m RequestMetrics)
+}
+
+// MetricsFlusher is implemented by recorders that buffer metrics before
+// exporting them. CoreClient.Close calls Flush so nothing is lost on shutdown.
+type MetricsFlusher interface {
+	Flush(ctx context.Context) error
+}
@@ -0,0 +1,103 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+)
+
+// PingPath is the endpoint requested by Ping. It is a small authenticated
+// listing available in every region, so a successful response confirms both
+// connectivity and valid credentials.
+const PingPath = "/compute/v1/instance-types?_limit=1"
+
+// AuthenticationError is returned by Ping when the API is reachable but
+// rejects the configured credentials.
+type AuthenticationError struct {
+	StatusCode int
+	Err        *HTTPError
+}
+
+// Error returns a string representation of the authentication error.
+// This method implements the error interface.
+func (e *AuthenticationError) Error() string {
+	return fmt.Sprintf("authentication failed (status %d): check the API key or token", e.StatusCode)
+}
+
+// Unwrap returns the underlying HTTP error.
+func (e *AuthenticationError) Unwrap() error {
+	return e.Err
+}
+
+// ConnectivityError is returned by Ping when the API cannot be reached or is
+// not able to serve requests.
+type ConnectivityError struct {
+	URL string
+	Err error
+}
+
+// Error returns a string representation of the connectivity error.
+// This method implements the error interface.
+func (e *ConnectivityError) Error() string {
+	return fmt.Sprintf("network unreachable: %s: %v", e.URL, e.Err)
+}
+
+// Unwrap returns the underlying transport or HTTP error.
+func (e *ConnectivityError) Unwrap() error {
+	return e.Err
+}
+
+// Ping checks connectivity and credentials with a single lightweight request,
+// without retries. It returns an AuthenticationError for 401 and 403 responses,
+// a ConnectivityError for transport failures and 5xx responses, and an
+// HTTPError for any other unexpected status.
+func (c *CoreClient) Ping(ctx context.Context) error {
+	cfg := &c.config
+	url := cfg.BaseURL.String() + PingPath
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return fmt.Errorf("error creating request: %w", err)
+	}
+
+	if cfg.JWToken != "" {
+		req.Header.Set("Authorization", cfg.JWToken)
+	}
+	if cfg.APIKey != "" {
+		req.Header.Set("X-API-Key", cfg.APIKey)
+	}
+	req.Header.Set("User-Agent", cfg.UserAgent)
+	for k, v := range cfg.CustomHeaders {
+		req.Header.Set(k, v)
+	}
+	if label := OperationLabelFromContext(ctx); label != "" && cfg.OperationLabelHeader != "" {
+		req.Header.Set(cfg.OperationLabelHeader, label)
+	}
+
+	httpClient := cfg.HTTPClient
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		return &ConnectivityError{URL: url, Err: err}
+	}
+	defer resp.Body.Close()
+
+	switch {
+	case resp.StatusCode >= 200 && resp.StatusCode < 300:
+		_, _ = io.Copy(io.Discard, resp.Body)
+		return nil
+	case resp.StatusCode == http.StatusUnauthorized || resp.StatusCode == http.StatusForbidden:
+		return &AuthenticationError{StatusCode: resp.StatusCode, Err: NewHTTPError(resp)}
+	case resp.StatusCode >= 500:
+		return &ConnectivityError{URL: url, Err: NewHTTPError(resp)}
+	default:
+		return NewHTTPError(resp)
+	}
+}
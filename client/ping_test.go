@@ -0,0 +1,96 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestCoreClient_Ping(t *testing.T) {
+	tests := []struct {
+		name       string
+		statusCode int
+		wantErr    bool
+		wantAuth   bool
+		wantConn   bool
+	}{
+		{name: "success", statusCode: http.StatusOK},
+		{name: "unauthorized", statusCode: http.StatusUnauthorized, wantErr: true, wantAuth: true},
+		{name: "forbidden", statusCode: http.StatusForbidden, wantErr: true, wantAuth: true},
+		{name: "server error", statusCode: http.StatusBadGateway, wantErr: true, wantConn: true},
+		{name: "unexpected status", statusCode: http.StatusNotFound, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			calls := 0
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				calls++
+				if r.Method != http.MethodGet {
+					t.Errorf("expected GET, got %s", r.Method)
+				}
+				if r.URL.Path != "/compute/v1/instance-types" {
+					t.Errorf("unexpected path %s", r.URL.Path)
+				}
+				if got := r.Header.Get("X-API-Key"); got != "test-key" {
+					t.Errorf("X-API-Key = %q, want %q", got, "test-key")
+				}
+				w.WriteHeader(tt.statusCode)
+				w.Write([]byte(`{}`))
+			}))
+			defer server.Close()
+
+			c := NewMgcClient(WithAPIKey("test-key"), WithBaseURL(MgcUrl(server.URL)))
+			err := c.Ping(context.Background())
+
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Ping() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if calls != 1 {
+				t.Errorf("expected a single request, got %d", calls)
+			}
+
+			var authErr *AuthenticationError
+			if errors.As(err, &authErr) != tt.wantAuth {
+				t.Errorf("AuthenticationError = %v, want %v (err: %v)", !tt.wantAuth, tt.wantAuth, err)
+			}
+			var connErr *ConnectivityError
+			if errors.As(err, &connErr) != tt.wantConn {
+				t.Errorf("ConnectivityError = %v, want %v (err: %v)", !tt.wantConn, tt.wantConn, err)
+			}
+			var httpErr *HTTPError
+			if tt.wantErr && (!errors.As(err, &httpErr) || httpErr.StatusCode != tt.statusCode) {
+				t.Errorf("expected wrapped HTTPError with status %d, got %v", tt.statusCode, err)
+			}
+		})
+	}
+}
+
+func TestCoreClient_PingUnreachable(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	url := server.URL
+	server.Close()
+
+	c := NewMgcClient(WithAPIKey("test-key"), WithBaseURL(MgcUrl(url)))
+	err := c.Ping(context.Background())
+
+	var connErr *ConnectivityError
+	if !errors.As(err, &connErr) {
+		t.Fatalf("expected ConnectivityError, got %v", err)
+	}
+}
+
+func TestCoreClient_PingCanceled(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	defer server.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	c := NewMgcClient(WithBaseURL(MgcUrl(server.URL)))
+	if err := c.Ping(ctx); !errors.Is(err, context.Canceled) {
+		t.Fatalf("expected context.Canceled, got %v", err)
+	}
+}
@@ -1,5 +1,10 @@
 package client
 
+import (
+	"net/url"
+	"strings"
+)
+
 // MgcUrl represents a MagaluCloud API URL.
 // This type is used to ensure type safety when working with API endpoints.
 type MgcUrl string
@@ -21,3 +26,18 @@ const (
 func (m MgcUrl) String() string {
 	return string(m)
 }
+
+// Region returns the region identifier of a regional URL, such as "br-se1"
+// for BrSe1. It returns an empty string for Global and for URLs without a
+// region path segment.
+func (m MgcUrl) Region() string {
+	u, err := url.Parse(string(m))
+	if err != nil {
+		return ""
+	}
+	path := strings.Trim(u.Path, "/")
+	if path == "" {
+		return ""
+	}
+	return path[strings.LastIndex(path, "/")+1:]
+}
@@ -53,3 +53,26 @@ func TestRegionConstants(t *testing.T) {
 		t.Errorf("BrSe1 constant has unexpected value: %s", BrSe1)
 	}
 }
+
+func TestMgcUrl_Region(t *testing.T) {
+	tests := []struct {
+		m    MgcUrl
+		want string
+	}{
+		{m: BrNe1, want: "br-ne1"},
+		{m: BrSe1, want: "br-se1"},
+		{m: BrMgl1, want: "br-se-1"},
+		{m: Global, want: ""},
+		{m: "https://api.magalu.cloud/br-se1/", want: "br-se1"},
+		{m: "http://127.0.0.1:8080", want: ""},
+		{m: "", want: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.m), func(t *testing.T) {
+			if got := tt.m.Region(); got != tt.want {
+				t.Errorf("MgcUrl.Region() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,120 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net"
+	"net/http"
+	"syscall"
+
+	"github.com/MagaluCloud/mgc-sdk-go/internal/retry"
+)
+
+// IdempotencyKeyHeader is the request header that marks a request as safe to
+// retry. Requests carrying it are retried like idempotent methods by
+// DefaultRetryPolicy.
+const IdempotencyKeyHeader = "Idempotency-Key"
+
+// RetryJitter selects how the delay between retries is randomized.
+type RetryJitter int
+
+const (
+	// NoJitter waits exactly the computed backoff.
+	NoJitter RetryJitter = iota
+	// FullJitter waits a random delay between zero and the computed backoff.
+	FullJitter
+	// EqualJitter waits half the computed backoff plus a random delay up to the other half.
+	EqualJitter
+)
+
+// RetryPolicy decides whether a failed attempt should be retried.
+// It receives the request that was sent and either the response, for
+// non-2xx statuses, or the transport error. Exactly one of resp and err is non-nil.
+type RetryPolicy func(req *http.Request, resp *http.Response, err error) bool
+
+// DefaultRetryPolicy is the retry policy used when none is configured.
+//
+// Idempotent methods (GET, HEAD, OPTIONS, TRACE, PUT, DELETE) and requests
+// with an Idempotency-Key header are retried on any transport error and on
+// 429 and 5xx responses.
+//
+// Other methods, such as POST and PATCH, may already have been applied by the
+// server when an ambiguous failure occurs, so they are only retried when the
+// connection could not be established, or when the server answers 429 or 503
+// with a Retry-After header, signalling that the request was not processed.
+func DefaultRetryPolicy(req *http.Request, resp *http.Response, err error) bool {
+	if err != nil && (errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded)) {
+		return false
+	}
+
+	if isIdempotent(req) {
+		if err != nil {
+			return true
+		}
+		return retry.ShouldRetry(resp.StatusCode)
+	}
+
+	if err != nil {
+		var opErr *net.OpError
+		return errors.As(err, &opErr) && opErr.Op == "dial"
+	}
+
+	if resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode == http.StatusServiceUnavailable {
+		return resp.Header.Get("Retry-After") != ""
+	}
+	return false
+}
+
+// IsRetryable reports whether err is worth retrying, using the classification
+// of DefaultRetryPolicy: transport failures such as a reset connection or a
+// timeout, 429 and 5xx responses, busy resources and an open circuit breaker
+// are transient, while other HTTP errors (400, 403, 404, ...), validation
+// errors and context cancellation are terminal. A RetryError is classified by
+// its last error.
+//
+// Unlike DefaultRetryPolicy, IsRetryable does not know the request method, so
+// callers retrying non-idempotent operations must still decide whether a
+// repeat is safe.
+func IsRetryable(err error) bool {
+	if err == nil || errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return false
+	}
+
+	var retryErr *RetryError
+	if errors.As(err, &retryErr) {
+		return IsRetryable(retryErr.LastError)
+	}
+
+	if IsResourceBusy(err) {
+		return true
+	}
+
+	var httpErr *HTTPError
+	if errors.As(err, &httpErr) {
+		return retry.ShouldRetry(httpErr.StatusCode)
+	}
+
+	if errors.Is(err, ErrCircuitOpen) {
+		return true
+	}
+
+	var netErr net.Error
+	return errors.As(err, &netErr) ||
+		errors.Is(err, syscall.ECONNRESET) ||
+		errors.Is(err, syscall.ECONNREFUSED) ||
+		errors.Is(err, io.ErrUnexpectedEOF)
+}
+
+// isIdempotent reports whether repeating req has the same effect as sending it once
+func isIdempotent(req *http.Request) bool {
+	if req.Header.Get(IdempotencyKeyHeader) != "" {
+		return true
+	}
+
+	switch req.Method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodTrace, http.MethodPut, http.MethodDelete:
+		return true
+	}
+	return false
+}
@@ -0,0 +1,124 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"syscall"
+	"testing"
+)
+
+func TestDefaultRetryPolicy(t *testing.T) {
+	dialErr := &url.Error{Op: "Post", URL: "http://api", Err: &net.OpError{Op: "dial", Net: "tcp", Err: errors.New("connection refused")}}
+	readErr := &url.Error{Op: "Post", URL: "http://api", Err: &net.OpError{Op: "read", Net: "tcp", Err: errors.New("connection reset by peer")}}
+
+	response := func(status int, retryAfter string) *http.Response {
+		resp := &http.Response{StatusCode: status, Header: http.Header{}}
+		if retryAfter != "" {
+			resp.Header.Set("Retry-After", retryAfter)
+		}
+		return resp
+	}
+
+	tests := []struct {
+		name       string
+		method     string
+		idempotent bool
+		resp       *http.Response
+		err        error
+		want       bool
+	}{
+		{name: "GET 500", method: http.MethodGet, resp: response(500, ""), want: true},
+		{name: "GET 429", method: http.MethodGet, resp: response(429, ""), want: true},
+		{name: "GET 404", method: http.MethodGet, resp: response(404, ""), want: false},
+		{name: "GET read error", method: http.MethodGet, err: readErr, want: true},
+		{name: "PUT 503", method: http.MethodPut, resp: response(503, ""), want: true},
+		{name: "DELETE 500", method: http.MethodDelete, resp: response(500, ""), want: true},
+		{name: "POST 500", method: http.MethodPost, resp: response(500, ""), want: false},
+		{name: "POST 503", method: http.MethodPost, resp: response(503, ""), want: false},
+		{name: "POST 503 Retry-After", method: http.MethodPost, resp: response(503, "2"), want: true},
+		{name: "POST 429 Retry-After", method: http.MethodPost, resp: response(429, "2"), want: true},
+		{name: "POST 502 Retry-After", method: http.MethodPost, resp: response(502, "2"), want: false},
+		{name: "POST dial error", method: http.MethodPost, err: dialErr, want: true},
+		{name: "POST read error", method: http.MethodPost, err: readErr, want: false},
+		{name: "PATCH 500", method: http.MethodPatch, resp: response(500, ""), want: false},
+		{name: "POST idempotency key 500", method: http.MethodPost, idempotent: true, resp: response(500, ""), want: true},
+		{name: "POST idempotency key read error", method: http.MethodPost, idempotent: true, err: readErr, want: true},
+		{name: "GET context canceled", method: http.MethodGet, err: fmt.Errorf("wrapped: %w", context.Canceled), want: false},
+		{name: "GET deadline exceeded", method: http.MethodGet, err: context.DeadlineExceeded, want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req, _ := http.NewRequest(tt.method, "http://api/test", nil)
+			if tt.idempotent {
+				req.Header.Set(IdempotencyKeyHeader, "key")
+			}
+			if got := DefaultRetryPolicy(req, tt.resp, tt.err); got != tt.want {
+				t.Errorf("DefaultRetryPolicy() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestWithRetryPolicy(t *testing.T) {
+	called := false
+	policy := func(req *http.Request, resp *http.Response, err error) bool {
+		called = true
+		return false
+	}
+
+	cfg := NewMgcClient(WithRetryPolicy(policy)).GetConfig()
+	if cfg.RetryPolicy == nil {
+		t.Fatal("expected RetryPolicy to be set")
+	}
+	cfg.RetryPolicy(nil, nil, nil)
+	if !called {
+		t.Error("expected configured policy to be used")
+	}
+
+	if NewMgcClient().GetConfig().RetryPolicy != nil {
+		t.Error("expected RetryPolicy to be nil by default")
+	}
+}
+
+func TestIsRetryable(t *testing.T) {
+	reset := &url.Error{Op: "Get", URL: "https://api.magalu.cloud", Err: &net.OpError{Op: "read", Net: "tcp", Err: syscall.ECONNRESET}}
+
+	tests := []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{name: "nil", err: nil, want: false},
+		{name: "connection reset", err: reset, want: true},
+		{name: "connection refused", err: fmt.Errorf("dial: %w", syscall.ECONNREFUSED), want: true},
+		{name: "unexpected EOF", err: io.ErrUnexpectedEOF, want: true},
+		{name: "429", err: &HTTPError{StatusCode: http.StatusTooManyRequests}, want: true},
+		{name: "503", err: &HTTPError{StatusCode: http.StatusServiceUnavailable}, want: true},
+		{name: "wrapped 500", err: fmt.Errorf("delete volume: %w", &HTTPError{StatusCode: http.StatusInternalServerError}), want: true},
+		{name: "circuit open", err: ErrCircuitOpen, want: true},
+		{name: "resource busy", err: &HTTPError{StatusCode: http.StatusLocked}, want: true},
+		{name: "exhausted retries on 503", err: &RetryError{LastError: &HTTPError{StatusCode: http.StatusServiceUnavailable}, Retries: 3}, want: true},
+		{name: "400", err: &HTTPError{StatusCode: http.StatusBadRequest}, want: false},
+		{name: "403", err: &HTTPError{StatusCode: http.StatusForbidden}, want: false},
+		{name: "404", err: &HTTPError{StatusCode: http.StatusNotFound}, want: false},
+		{name: "409", err: &HTTPError{StatusCode: http.StatusConflict}, want: false},
+		{name: "validation error", err: &ValidationError{Field: "id", Message: "cannot be empty"}, want: false},
+		{name: "context canceled", err: context.Canceled, want: false},
+		{name: "deadline exceeded in transport", err: &url.Error{Op: "Get", URL: "https://api.magalu.cloud", Err: context.DeadlineExceeded}, want: false},
+		{name: "plain error", err: errors.New("boom"), want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := IsRetryable(tt.err); got != tt.want {
+				t.Errorf("IsRetryable(%v) = %v, want %v", tt.err, got, tt.want)
+			}
+		})
+	}
+}
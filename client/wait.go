@@ -0,0 +1,147 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// Default values applied by NewWaitOptions.
+const (
+	DefaultWaitPollInterval  = 5 * time.Second
+	DefaultWaitTimeout       = 30 * time.Minute
+	DefaultWaitBackoffFactor = 1.0
+	DefaultWaitMaxInterval   = time.Minute
+)
+
+// WaitOptions configures how the WaitFor methods of the services poll a
+// resource until it reaches the desired state.
+type WaitOptions struct {
+	// PollInterval is the delay before the second check.
+	PollInterval time.Duration
+	// Timeout bounds the whole wait. Zero waits until the context is done.
+	Timeout time.Duration
+	// BackoffFactor multiplies the delay after every check. Values below 1
+	// keep the delay constant.
+	BackoffFactor float64
+	// MaxInterval caps the delay between checks when BackoffFactor grows it.
+	MaxInterval time.Duration
+}
+
+// WaitOption is a function type that modifies WaitOptions.
+type WaitOption func(*WaitOptions)
+
+// NewWaitOptions returns WaitOptions with the default values, modified by opts.
+func NewWaitOptions(opts ...WaitOption) WaitOptions {
+	o := WaitOptions{
+		PollInterval:  DefaultWaitPollInterval,
+		Timeout:       DefaultWaitTimeout,
+		BackoffFactor: DefaultWaitBackoffFactor,
+		MaxInterval:   DefaultWaitMaxInterval,
+	}
+	for _, opt := range opts {
+		opt(&o)
+	}
+	return o
+}
+
+// WithPollInterval sets the delay between checks.
+func WithPollInterval(interval time.Duration) WaitOption {
+	return func(o *WaitOptions) {
+		o.PollInterval = interval
+	}
+}
+
+// WithWaitTimeout bounds the whole wait. Zero waits until the context is done.
+func WithWaitTimeout(timeout time.Duration) WaitOption {
+	return func(o *WaitOptions) {
+		o.Timeout = timeout
+	}
+}
+
+// WithBackoffFactor grows the delay by factor after every check, up to MaxInterval.
+func WithBackoffFactor(factor float64) WaitOption {
+	return func(o *WaitOptions) {
+		o.BackoffFactor = factor
+	}
+}
+
+// WithMaxInterval caps the delay between checks.
+func WithMaxInterval(interval time.Duration) WaitOption {
+	return func(o *WaitOptions) {
+		o.MaxInterval = interval
+	}
+}
+
+// nextInterval returns the delay that follows current.
+func (o WaitOptions) nextInterval(current time.Duration) time.Duration {
+	if o.BackoffFactor <= 1 {
+		return current
+	}
+	next := time.Duration(float64(current) * o.BackoffFactor)
+	if o.MaxInterval > 0 && next > o.MaxInterval {
+		return o.MaxInterval
+	}
+	return next
+}
+
+// Poll calls check until it reports done or returns an error, sleeping between
+// calls as configured by opts. The first check runs immediately. A
+// non-positive PollInterval uses DefaultWaitPollInterval. Poll returns the
+// context error if ctx is done, or the Timeout expires, first.
+func Poll(ctx context.Context, opts WaitOptions, check func(ctx context.Context) (bool, error)) error {
+	if opts.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, opts.Timeout)
+		defer cancel()
+	}
+
+	interval := opts.PollInterval
+	if interval <= 0 {
+		interval = DefaultWaitPollInterval
+	}
+
+	for {
+		done, err := check(ctx)
+		if err != nil || done {
+			return err
+		}
+
+		if err := Sleep(ctx, interval); err != nil {
+			return err
+		}
+		interval = opts.nextInterval(interval)
+	}
+}
+
+// Sleep pauses for d, returning early with the context error if ctx is done.
+func Sleep(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// RetryWhileBusy calls op until it returns an error for which IsResourceBusy
+// is false, or no error, waiting between calls as configured by opts. It is meant
+// for follow-up operations issued while a resource is still settling after
+// an asynchronous change.
+func RetryWhileBusy(ctx context.Context, opts WaitOptions, op func(ctx context.Context) error) error {
+	var opErr error
+	err := Poll(ctx, opts, func(ctx context.Context) (bool, error) {
+		opErr = op(ctx)
+		if IsResourceBusy(opErr) {
+			return false, nil
+		}
+		return true, opErr
+	})
+	if err != nil && opErr != nil && IsResourceBusy(opErr) {
+		return fmt.Errorf("%w (last attempt: %v)", err, opErr)
+	}
+	return err
+}
@@ -0,0 +1,139 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestNewWaitOptions(t *testing.T) {
+	o := NewWaitOptions()
+	if o.PollInterval != DefaultWaitPollInterval || o.Timeout != DefaultWaitTimeout ||
+		o.BackoffFactor != DefaultWaitBackoffFactor || o.MaxInterval != DefaultWaitMaxInterval {
+		t.Errorf("NewWaitOptions() = %+v, want defaults", o)
+	}
+
+	o = NewWaitOptions(
+		WithPollInterval(time.Second),
+		WithWaitTimeout(time.Minute),
+		WithBackoffFactor(2),
+		WithMaxInterval(10*time.Second),
+	)
+	want := WaitOptions{PollInterval: time.Second, Timeout: time.Minute, BackoffFactor: 2, MaxInterval: 10 * time.Second}
+	if o != want {
+		t.Errorf("NewWaitOptions() = %+v, want %+v", o, want)
+	}
+}
+
+func TestWaitOptions_NextInterval(t *testing.T) {
+	constant := WaitOptions{BackoffFactor: 1}
+	if got := constant.nextInterval(time.Second); got != time.Second {
+		t.Errorf("nextInterval() = %v, want 1s", got)
+	}
+
+	backoff := WaitOptions{BackoffFactor: 2, MaxInterval: 3 * time.Second}
+	if got := backoff.nextInterval(time.Second); got != 2*time.Second {
+		t.Errorf("nextInterval() = %v, want 2s", got)
+	}
+	if got := backoff.nextInterval(2 * time.Second); got != 3*time.Second {
+		t.Errorf("nextInterval() = %v, want capped 3s", got)
+	}
+}
+
+func TestPoll(t *testing.T) {
+	t.Run("stops when done", func(t *testing.T) {
+		calls := 0
+		err := Poll(context.Background(), WaitOptions{PollInterval: time.Millisecond}, func(ctx context.Context) (bool, error) {
+			calls++
+			return calls == 3, nil
+		})
+		if err != nil {
+			t.Fatalf("Poll() error = %v", err)
+		}
+		if calls != 3 {
+			t.Errorf("check called %d times, want 3", calls)
+		}
+	})
+
+	t.Run("returns check error", func(t *testing.T) {
+		wantErr := errors.New("failed")
+		err := Poll(context.Background(), WaitOptions{PollInterval: time.Millisecond}, func(ctx context.Context) (bool, error) {
+			return false, wantErr
+		})
+		if !errors.Is(err, wantErr) {
+			t.Errorf("Poll() error = %v, want %v", err, wantErr)
+		}
+	})
+
+	t.Run("honors timeout", func(t *testing.T) {
+		opts := WaitOptions{PollInterval: time.Millisecond, Timeout: 20 * time.Millisecond}
+		err := Poll(context.Background(), opts, func(ctx context.Context) (bool, error) {
+			return false, nil
+		})
+		if !errors.Is(err, context.DeadlineExceeded) {
+			t.Errorf("Poll() error = %v, want context.DeadlineExceeded", err)
+		}
+	})
+}
+
+func TestSleep(t *testing.T) {
+	if err := Sleep(context.Background(), time.Millisecond); err != nil {
+		t.Errorf("Sleep() error = %v", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	start := time.Now()
+	if err := Sleep(ctx, time.Hour); !errors.Is(err, context.Canceled) {
+		t.Errorf("Sleep() error = %v, want context.Canceled", err)
+	}
+	if time.Since(start) > time.Second {
+		t.Error("Sleep() did not return when the context was canceled")
+	}
+}
+
+func TestRetryWhileBusy(t *testing.T) {
+	busy := &HTTPError{StatusCode: http.StatusConflict, Body: []byte("volume is busy")}
+
+	t.Run("retries until not busy", func(t *testing.T) {
+		calls := 0
+		err := RetryWhileBusy(context.Background(), WaitOptions{PollInterval: time.Millisecond}, func(ctx context.Context) error {
+			calls++
+			if calls < 3 {
+				return busy
+			}
+			return nil
+		})
+		if err != nil {
+			t.Fatalf("RetryWhileBusy() error = %v", err)
+		}
+		if calls != 3 {
+			t.Errorf("op called %d times, want 3", calls)
+		}
+	})
+
+	t.Run("returns other errors at once", func(t *testing.T) {
+		calls := 0
+		notFound := &HTTPError{StatusCode: http.StatusNotFound}
+		err := RetryWhileBusy(context.Background(), WaitOptions{PollInterval: time.Millisecond}, func(ctx context.Context) error {
+			calls++
+			return notFound
+		})
+		if !IsNotFound(err) || calls != 1 {
+			t.Errorf("RetryWhileBusy() error = %v after %d calls, want not found after 1", err, calls)
+		}
+	})
+
+	t.Run("reports last busy error on timeout", func(t *testing.T) {
+		opts := WaitOptions{PollInterval: time.Millisecond, Timeout: 20 * time.Millisecond}
+		err := RetryWhileBusy(context.Background(), opts, func(ctx context.Context) error {
+			return busy
+		})
+		if !errors.Is(err, context.DeadlineExceeded) || !strings.Contains(err.Error(), "volume is busy") {
+			t.Errorf("RetryWhileBusy() error = %v, want deadline mentioning the busy response", err)
+		}
+	})
+}
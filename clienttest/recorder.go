@@ -0,0 +1,227 @@
+// Package clienttest provides helpers for testing code built on the MagaluCloud SDK.
+// It contains a record-and-replay HTTP transport that stores real API
+// interactions on disk and serves them back offline.
+package clienttest
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/MagaluCloud/mgc-sdk-go/client"
+)
+
+// RecordMode controls whether a Recorder talks to the real API or to disk.
+type RecordMode int
+
+const (
+	// ModeReplay serves responses from previously recorded interactions and
+	// never touches the network.
+	ModeReplay RecordMode = iota
+	// ModeRecord sends requests to the real API and writes every interaction to disk.
+	ModeRecord
+)
+
+// redactedValue replaces the value of sensitive headers in recordings.
+const redactedValue = "REDACTED"
+
+// ErrInteractionNotFound is returned in ModeReplay when no recording matches a request.
+var ErrInteractionNotFound = errors.New("clienttest: no recorded interaction for request")
+
+// sensitiveHeaders are scrubbed from recordings before they are written to disk.
+var sensitiveHeaders = []string{"Authorization", "X-Api-Key", "Cookie", "Set-Cookie"}
+
+// Interaction is a single recorded request and its response.
+type Interaction struct {
+	Request  RecordedRequest  `json:"request"`
+	Response RecordedResponse `json:"response"`
+}
+
+// RecordedRequest is the stored form of an HTTP request.
+type RecordedRequest struct {
+	Method  string      `json:"method"`
+	URL     string      `json:"url"`
+	Headers http.Header `json:"headers,omitempty"`
+	Body    string      `json:"body,omitempty"`
+}
+
+// RecordedResponse is the stored form of an HTTP response.
+type RecordedResponse struct {
+	StatusCode int         `json:"status_code"`
+	Headers    http.Header `json:"headers,omitempty"`
+	Body       string      `json:"body,omitempty"`
+}
+
+// Recorder is an http.RoundTripper that records or replays API interactions.
+//
+// Requests are matched by method, path, query and body; the host is ignored so
+// recordings can be replayed against any base URL. Identical requests, such as
+// status polling, are stored in sequence. When replaying more identical
+// requests than were recorded, the last recorded response is reused.
+type Recorder struct {
+	dir  string
+	mode RecordMode
+	next http.RoundTripper
+
+	mu    sync.Mutex
+	calls map[string]int
+}
+
+// NewRecorder creates a Recorder storing interactions in dir. In ModeRecord,
+// requests are sent through next, or http.DefaultTransport when next is nil.
+func NewRecorder(dir string, mode RecordMode, next http.RoundTripper) *Recorder {
+	if next == nil {
+		next = http.DefaultTransport
+	}
+	return &Recorder{
+		dir:   dir,
+		mode:  mode,
+		next:  next,
+		calls: make(map[string]int),
+	}
+}
+
+// WithRecordingTransport returns a client option that routes all requests
+// through a Recorder storing interactions in dir.
+func WithRecordingTransport(dir string, mode RecordMode) client.Option {
+	return client.WithTransport(NewRecorder(dir, mode, nil))
+}
+
+// RoundTrip implements http.RoundTripper.
+func (r *Recorder) RoundTrip(req *http.Request) (*http.Response, error) {
+	body, err := readRequestBody(req)
+	if err != nil {
+		return nil, err
+	}
+
+	key := interactionKey(req, body)
+	r.mu.Lock()
+	r.calls[key]++
+	seq := r.calls[key]
+	r.mu.Unlock()
+
+	if r.mode == ModeRecord {
+		return r.record(req, body, key, seq)
+	}
+	return r.replay(req, key, seq)
+}
+
+func (r *Recorder) record(req *http.Request, body []byte, key string, seq int) (*http.Response, error) {
+	resp, err := r.next.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+
+	respBody, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, fmt.Errorf("clienttest: reading response body: %w", err)
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(respBody))
+
+	interaction := Interaction{
+		Request: RecordedRequest{
+			Method:  req.Method,
+			URL:     req.URL.RequestURI(),
+			Headers: scrubHeaders(req.Header),
+			Body:    string(body),
+		},
+		Response: RecordedResponse{
+			StatusCode: resp.StatusCode,
+			Headers:    scrubHeaders(resp.Header),
+			Body:       string(respBody),
+		},
+	}
+
+	data, err := json.MarshalIndent(interaction, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("clienttest: encoding interaction: %w", err)
+	}
+	if err := os.MkdirAll(r.dir, 0o755); err != nil {
+		return nil, fmt.Errorf("clienttest: creating recording directory: %w", err)
+	}
+	if err := os.WriteFile(r.path(key, seq), data, 0o644); err != nil {
+		return nil, fmt.Errorf("clienttest: writing interaction: %w", err)
+	}
+
+	return resp, nil
+}
+
+func (r *Recorder) replay(req *http.Request, key string, seq int) (*http.Response, error) {
+	data, err := os.ReadFile(r.path(key, seq))
+	for errors.Is(err, os.ErrNotExist) && seq > 1 {
+		seq--
+		data, err = os.ReadFile(r.path(key, seq))
+	}
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, fmt.Errorf("%w: %s %s", ErrInteractionNotFound, req.Method, req.URL.RequestURI())
+	}
+	if err != nil {
+		return nil, fmt.Errorf("clienttest: reading interaction: %w", err)
+	}
+
+	var interaction Interaction
+	if err := json.Unmarshal(data, &interaction); err != nil {
+		return nil, fmt.Errorf("clienttest: decoding interaction: %w", err)
+	}
+
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", interaction.Response.StatusCode, http.StatusText(interaction.Response.StatusCode)),
+		StatusCode:    interaction.Response.StatusCode,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        interaction.Response.Headers.Clone(),
+		Body:          io.NopCloser(bytes.NewReader([]byte(interaction.Response.Body))),
+		ContentLength: int64(len(interaction.Response.Body)),
+		Request:       req,
+	}, nil
+}
+
+func (r *Recorder) path(key string, seq int) string {
+	return filepath.Join(r.dir, fmt.Sprintf("%s-%d.json", key, seq))
+}
+
+// readRequestBody reads the request body and restores it so it can be sent.
+func readRequestBody(req *http.Request) ([]byte, error) {
+	if req.Body == nil || req.Body == http.NoBody {
+		return nil, nil
+	}
+	body, err := io.ReadAll(req.Body)
+	req.Body.Close()
+	if err != nil {
+		return nil, fmt.Errorf("clienttest: reading request body: %w", err)
+	}
+	req.Body = io.NopCloser(bytes.NewReader(body))
+	return body, nil
+}
+
+// interactionKey identifies a request by method, path, query and body.
+func interactionKey(req *http.Request, body []byte) string {
+	h := sha256.New()
+	h.Write([]byte(req.Method))
+	h.Write([]byte{0})
+	h.Write([]byte(req.URL.RequestURI()))
+	h.Write([]byte{0})
+	h.Write(body)
+	return hex.EncodeToString(h.Sum(nil))[:16]
+}
+
+// scrubHeaders returns a copy of headers with credentials redacted.
+func scrubHeaders(headers http.Header) http.Header {
+	scrubbed := headers.Clone()
+	for _, name := range sensitiveHeaders {
+		if scrubbed.Get(name) != "" {
+			scrubbed.Set(name, redactedValue)
+		}
+	}
+	return scrubbed
+}
@@ -0,0 +1,141 @@
+package clienttest
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/MagaluCloud/mgc-sdk-go/client"
+	"github.com/MagaluCloud/mgc-sdk-go/compute"
+)
+
+func newComputeClient(baseURL string, opts ...client.Option) *compute.VirtualMachineClient {
+	opts = append([]client.Option{
+		client.WithAPIKey("super-secret-key"),
+		client.WithBaseURL(client.MgcUrl(baseURL)),
+	}, opts...)
+	return compute.New(client.NewMgcClient(opts...))
+}
+
+func TestRecorder_RecordAndReplay(t *testing.T) {
+	dir := t.TempDir()
+
+	calls := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprintf(w, `{"id": "inst1", "status": "status-%d"}`, calls)
+	}))
+
+	recording := newComputeClient(server.URL, WithRecordingTransport(dir, ModeRecord))
+	for i := 1; i <= 2; i++ {
+		instance, err := recording.Instances().Get(context.Background(), "inst1", nil)
+		if err != nil {
+			t.Fatalf("Get() in record mode unexpected error: %v", err)
+		}
+		if want := fmt.Sprintf("status-%d", i); instance.Status != want {
+			t.Errorf("Get() in record mode status = %s, want %s", instance.Status, want)
+		}
+	}
+	server.Close()
+
+	files, err := filepath.Glob(filepath.Join(dir, "*.json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(files) != 2 {
+		t.Fatalf("expected 2 recorded interactions, got %d", len(files))
+	}
+	for _, file := range files {
+		data, err := os.ReadFile(file)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if strings.Contains(string(data), "super-secret-key") {
+			t.Errorf("recording %s contains the API key", file)
+		}
+		if !strings.Contains(string(data), redactedValue) {
+			t.Errorf("recording %s does not redact the API key header", file)
+		}
+	}
+
+	replaying := newComputeClient("http://replay.invalid", WithRecordingTransport(dir, ModeReplay))
+	for _, want := range []string{"status-1", "status-2", "status-2"} {
+		instance, err := replaying.Instances().Get(context.Background(), "inst1", nil)
+		if err != nil {
+			t.Fatalf("Get() in replay mode unexpected error: %v", err)
+		}
+		if instance.Status != want {
+			t.Errorf("Get() in replay mode status = %s, want %s", instance.Status, want)
+		}
+	}
+}
+
+func TestRecorder_ReplayErrorResponse(t *testing.T) {
+	dir := t.TempDir()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusNotFound)
+		w.Write([]byte(`{"error": "instance not found"}`))
+	}))
+	recording := newComputeClient(server.URL, WithRecordingTransport(dir, ModeRecord))
+	_, recordErr := recording.Instances().Get(context.Background(), "missing", nil)
+	server.Close()
+
+	replaying := newComputeClient("http://replay.invalid", WithRecordingTransport(dir, ModeReplay))
+	_, replayErr := replaying.Instances().Get(context.Background(), "missing", nil)
+
+	var recordHTTPErr, replayHTTPErr *client.HTTPError
+	if !errors.As(recordErr, &recordHTTPErr) || !errors.As(replayErr, &replayHTTPErr) {
+		t.Fatalf("expected HTTP errors, got %v and %v", recordErr, replayErr)
+	}
+	if replayHTTPErr.StatusCode != http.StatusNotFound {
+		t.Errorf("replayed status = %d, want %d", replayHTTPErr.StatusCode, http.StatusNotFound)
+	}
+}
+
+func TestRecorder_ReplayMissingInteraction(t *testing.T) {
+	recorder := NewRecorder(t.TempDir(), ModeReplay, nil)
+
+	req, err := http.NewRequest(http.MethodPost, "http://replay.invalid/compute/v1/instances", strings.NewReader(`{"name":"vm"}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = recorder.RoundTrip(req)
+	if !errors.Is(err, ErrInteractionNotFound) {
+		t.Errorf("RoundTrip() error = %v, want ErrInteractionNotFound", err)
+	}
+}
+
+func TestRecorder_MatchesRequestBody(t *testing.T) {
+	dir := t.TempDir()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(r.Method))
+	}))
+	defer server.Close()
+
+	recorder := NewRecorder(dir, ModeRecord, nil)
+	req, _ := http.NewRequest(http.MethodPost, server.URL+"/path", strings.NewReader(`{"a":1}`))
+	resp, err := recorder.RoundTrip(req)
+	if err != nil {
+		t.Fatalf("RoundTrip() unexpected error: %v", err)
+	}
+	resp.Body.Close()
+
+	replayer := NewRecorder(dir, ModeReplay, nil)
+	other, _ := http.NewRequest(http.MethodPost, server.URL+"/path", strings.NewReader(`{"a":2}`))
+	if _, err := replayer.RoundTrip(other); !errors.Is(err, ErrInteractionNotFound) {
+		t.Errorf("RoundTrip() with different body error = %v, want ErrInteractionNotFound", err)
+	}
+}
@@ -4,7 +4,6 @@ import (
 	"context"
 	"fmt"
 	"log"
-	"os"
 
 	"github.com/MagaluCloud/mgc-sdk-go/audit"
 	"github.com/MagaluCloud/mgc-sdk-go/client"
@@ -17,11 +16,10 @@ func main() {
 }
 
 func ExampleListEvents() {
-	apiToken := os.Getenv("MGC_API_TOKEN")
-	if apiToken == "" {
-		log.Fatal("MGC_API_TOKEN environment variable is not set")
+	c, err := client.NewFromEnv()
+	if err != nil {
+		log.Fatal(err)
 	}
-	c := client.NewMgcClient(client.WithAPIKey(apiToken))
 	eventsClient := audit.New(c)
 
 	eventsList, err := eventsClient.Events().List(context.Background(), &audit.ListEventsParams{
@@ -50,11 +48,10 @@ func ExampleListEvents() {
 }
 
 func ExampleListEventTypes() {
-	apiToken := os.Getenv("MGC_API_TOKEN")
-	if apiToken == "" {
-		log.Fatal("MGC_API_TOKEN environment variable is not set")
+	c, err := client.NewFromEnv()
+	if err != nil {
+		log.Fatal(err)
 	}
-	c := client.NewMgcClient(client.WithAPIKey(apiToken))
 	eventsClient := audit.New(c)
 
 	types, err := eventsClient.EventTypes().List(context.Background(), &audit.ListEventTypesParams{
@@ -4,7 +4,6 @@ import (
 	"context"
 	"fmt"
 	"log"
-	"os"
 
 	"github.com/MagaluCloud/mgc-sdk-go/availabilityzones"
 	"github.com/MagaluCloud/mgc-sdk-go/client"
@@ -15,11 +14,10 @@ func main() {
 }
 
 func ExampleListAvailabilityZones() {
-	apiToken := os.Getenv("MGC_API_TOKEN")
-	if apiToken == "" {
-		log.Fatal("MGC_API_TOKEN environment variable is not set")
+	c, err := client.NewFromEnv()
+	if err != nil {
+		log.Fatal(err)
 	}
-	c := client.NewMgcClient(client.WithAPIKey(apiToken))
 	azClient := availabilityzones.New(c)
 
 	response, err := azClient.AvailabilityZones().List(context.Background(), availabilityzones.ListOptions{
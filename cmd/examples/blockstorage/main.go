@@ -6,7 +6,6 @@ import (
 	"errors"
 	"fmt"
 	"log"
-	"os"
 	"time"
 
 	"github.com/MagaluCloud/mgc-sdk-go/blockstorage"
@@ -33,14 +32,13 @@ func main() {
 }
 
 func ExampleGetVolume(id string) {
-	apiToken := os.Getenv("MGC_API_TOKEN")
-	if apiToken == "" {
-		log.Fatal("MGC_API_TOKEN environment variable is not set")
+	c, err := client.NewFromEnv()
+	if err != nil {
+		log.Fatal(err)
 	}
-	c := client.NewMgcClient(client.WithAPIKey(apiToken))
 	blockClient := blockstorage.New(c)
 
-	volume, err := blockClient.Volumes().Get(context.Background(), id, []string{blockstorage.VolumeTypeExpand, blockstorage.VolumeAttachExpand})
+	volume, err := blockClient.Volumes().Get(context.Background(), id, []blockstorage.VolumeExpand{blockstorage.VolumeTypeExpand, blockstorage.VolumeAttachExpand})
 	if err != nil {
 		log.Fatal(err)
 	}
@@ -53,18 +51,17 @@ func ExampleGetVolume(id string) {
 
 func ExampleListVolumes() {
 	// Create a new client
-	apiToken := os.Getenv("MGC_API_TOKEN")
-	if apiToken == "" {
-		log.Fatal("MGC_API_TOKEN environment variable is not set")
+	c, err := client.NewFromEnv()
+	if err != nil {
+		log.Fatal(err)
 	}
-	c := client.NewMgcClient(client.WithAPIKey(apiToken))
 	blockClient := blockstorage.New(c)
 
 	// List volumes with pagination and expansion (paginated)
 	resp, err := blockClient.Volumes().List(context.Background(), blockstorage.ListOptions{
 		Limit:  helpers.IntPtr(10),
 		Offset: helpers.IntPtr(0),
-		Expand: []string{blockstorage.VolumeTypeExpand, blockstorage.VolumeAttachExpand},
+		Expand: []blockstorage.VolumeExpand{blockstorage.VolumeTypeExpand, blockstorage.VolumeAttachExpand},
 	})
 
 	if err != nil {
@@ -97,11 +94,10 @@ func ExampleListVolumes() {
 
 func ExampleListAllVolumes() {
 	// Create a new client
-	apiToken := os.Getenv("MGC_API_TOKEN")
-	if apiToken == "" {
-		log.Fatal("MGC_API_TOKEN environment variable is not set")
+	c, err := client.NewFromEnv()
+	if err != nil {
+		log.Fatal(err)
 	}
-	c := client.NewMgcClient(client.WithAPIKey(apiToken))
 	blockClient := blockstorage.New(c)
 
 	// List all volumes (fetches all pages automatically)
@@ -122,11 +118,10 @@ func ExampleListAllVolumes() {
 }
 
 func ExampleCreateVolume() string {
-	apiToken := os.Getenv("MGC_API_TOKEN")
-	if apiToken == "" {
-		log.Fatal("MGC_API_TOKEN environment variable is not set")
+	c, err := client.NewFromEnv()
+	if err != nil {
+		log.Fatal(err)
 	}
-	c := client.NewMgcClient(client.WithAPIKey(apiToken))
 	blockClient := blockstorage.New(c)
 
 	// Create a new volume
@@ -153,16 +148,15 @@ func ExampleCreateVolume() string {
 }
 
 func ExampleManageVolume(id string) {
-	apiToken := os.Getenv("MGC_API_TOKEN")
-	if apiToken == "" {
-		log.Fatal("MGC_API_TOKEN environment variable is not set")
+	c, err := client.NewFromEnv()
+	if err != nil {
+		log.Fatal(err)
 	}
-	c := client.NewMgcClient(client.WithAPIKey(apiToken))
 	blockClient := blockstorage.New(c)
 	ctx := context.Background()
 
 	// Get volume details
-	volume, err := blockClient.Volumes().Get(ctx, id, []string{blockstorage.VolumeTypeExpand})
+	volume, err := blockClient.Volumes().Get(ctx, id, []blockstorage.VolumeExpand{blockstorage.VolumeTypeExpand})
 	if err != nil {
 		log.Fatal(err)
 	}
@@ -197,11 +191,10 @@ func ExampleManageVolume(id string) {
 }
 
 func ExampleVolumeAttachments(volumeID string) {
-	apiToken := os.Getenv("MGC_API_TOKEN")
-	if apiToken == "" {
-		log.Fatal("MGC_API_TOKEN environment variable is not set")
+	c, err := client.NewFromEnv()
+	if err != nil {
+		log.Fatal(err)
 	}
-	c := client.NewMgcClient(client.WithAPIKey(apiToken))
 	blockClient := blockstorage.New(c)
 	ctx := context.Background()
 
@@ -215,7 +208,7 @@ func ExampleVolumeAttachments(volumeID string) {
 	fmt.Printf("Volume %s attached to instance %s\n", volumeID, instanceID)
 
 	// Get volume details with attachment info
-	volume, err := blockClient.Volumes().Get(ctx, volumeID, []string{blockstorage.VolumeAttachExpand})
+	volume, err := blockClient.Volumes().Get(ctx, volumeID, []blockstorage.VolumeExpand{blockstorage.VolumeAttachExpand})
 	if err != nil {
 		log.Fatal(err)
 	}
@@ -239,11 +232,10 @@ func ExampleVolumeAttachments(volumeID string) {
 }
 
 func ExampleDeleteVolume(id string) {
-	apiToken := os.Getenv("MGC_API_TOKEN")
-	if apiToken == "" {
-		log.Fatal("MGC_API_TOKEN environment variable is not set")
+	c, err := client.NewFromEnv()
+	if err != nil {
+		log.Fatal(err)
 	}
-	c := client.NewMgcClient(client.WithAPIKey(apiToken))
 	blockClient := blockstorage.New(c)
 
 	if err := blockClient.Volumes().Delete(context.Background(), id); err != nil {
@@ -254,11 +246,10 @@ func ExampleDeleteVolume(id string) {
 }
 
 func ExampleListVolumeTypes() {
-	apiToken := os.Getenv("MGC_API_TOKEN")
-	if apiToken == "" {
-		log.Fatal("MGC_API_TOKEN environment variable is not set")
+	c, err := client.NewFromEnv()
+	if err != nil {
+		log.Fatal(err)
 	}
-	c := client.NewMgcClient(client.WithAPIKey(apiToken))
 	blockClient := blockstorage.New(c)
 
 	// List volume types (paginated)
@@ -285,11 +276,10 @@ func ExampleListVolumeTypes() {
 }
 
 func ExampleListAllVolumeTypes() {
-	apiToken := os.Getenv("MGC_API_TOKEN")
-	if apiToken == "" {
-		log.Fatal("MGC_API_TOKEN environment variable is not set")
+	c, err := client.NewFromEnv()
+	if err != nil {
+		log.Fatal(err)
 	}
-	c := client.NewMgcClient(client.WithAPIKey(apiToken))
 	blockClient := blockstorage.New(c)
 
 	// List all volume types (fetches all pages automatically)
@@ -308,11 +298,10 @@ func ExampleListAllVolumeTypes() {
 }
 
 func ExampleSchedulers(volumeID string) {
-	apiToken := os.Getenv("MGC_API_TOKEN")
-	if apiToken == "" {
-		log.Fatal("MGC_API_TOKEN environment variable is not set")
+	c, err := client.NewFromEnv()
+	if err != nil {
+		log.Fatal(err)
 	}
-	c := client.NewMgcClient(client.WithAPIKey(apiToken))
 	blockClient := blockstorage.New(c)
 	ctx := context.Background()
 
@@ -415,12 +404,10 @@ func ExampleSchedulers(volumeID string) {
 }
 
 func ExampleCreateSnapshot(volumeID string) string {
-	apiToken := os.Getenv("MGC_API_TOKEN")
-	if apiToken == "" {
-		log.Fatal("MGC_API_TOKEN environment variable is not set")
+	c, err := client.NewFromEnv()
+	if err != nil {
+		log.Fatal(err)
 	}
-
-	c := client.NewMgcClient(client.WithAPIKey(apiToken))
 	blockClient := blockstorage.New(c)
 
 	// Create a new snapshot
@@ -448,15 +435,13 @@ func ExampleCreateSnapshot(volumeID string) string {
 }
 
 func ExampleCopySnapshot(id string) {
-	apiToken := os.Getenv("MGC_API_TOKEN")
-	if apiToken == "" {
-		log.Fatal("MGC_API_TOKEN environment variable is not set")
+	c, err := client.NewFromEnv()
+	if err != nil {
+		log.Fatal(err)
 	}
-
-	c := client.NewMgcClient(client.WithAPIKey(apiToken))
 	blockClient := blockstorage.New(c)
 
-	err := blockClient.Snapshots().Copy(context.Background(), id, "br-ne1")
+	err = blockClient.Snapshots().Copy(context.Background(), id, "br-ne1")
 
 	if err != nil {
 		var httpError *client.HTTPError
@@ -472,15 +457,13 @@ func ExampleCopySnapshot(id string) {
 }
 
 func ExampleDeleteSnapshot(id string) {
-	apiToken := os.Getenv("MGC_API_TOKEN")
-	if apiToken == "" {
-		log.Fatal("MGC_API_TOKEN environment variable is not set")
+	c, err := client.NewFromEnv()
+	if err != nil {
+		log.Fatal(err)
 	}
-
-	c := client.NewMgcClient(client.WithAPIKey(apiToken))
 	blockClient := blockstorage.New(c)
 
-	err := blockClient.Snapshots().Delete(context.Background(), id)
+	err = blockClient.Snapshots().Delete(context.Background(), id)
 
 	if err != nil {
 		var httpError *client.HTTPError
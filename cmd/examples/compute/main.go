@@ -15,9 +15,9 @@ import (
 
 func main() {
 	// Get credentials from environment
-	apiToken := os.Getenv("MGC_API_KEY")
-	if apiToken == "" {
-		log.Fatal("MGC_API_TOKEN environment variable is not set")
+	apiToken, err := client.APIKeyFromEnv()
+	if err != nil {
+		log.Fatal(err)
 	}
 
 	// Check for optional region parameter
@@ -64,11 +64,10 @@ func main() {
 /*
 func ExampleRenameAndRetypeInstance(id string) {
 	// Create a new client
-	apiToken := os.Getenv("MGC_API_TOKEN")
-	if apiToken == "" {
-		log.Fatal("MGC_API_TOKEN environment variable is not set")
+	c, err := client.NewFromEnv()
+	if err != nil {
+		log.Fatal(err)
 	}
-	c := client.NewMgcClient(client.WithAPIKey(apiToken))
 	computeClient := compute.New(c)
 	ctx := context.Background()
 	// Rename the instance
@@ -83,21 +82,21 @@ func ExampleRenameAndRetypeInstance(id string) {
 			Name: helpers.StrPtr("BV2-2-20"),
 		},
 	}
-	if err := computeClient.Instances().Retype(ctx, id, retypeReq); err != nil {
+	instance, err := computeClient.Instances().ResizeAndWait(ctx, id, retypeReq, compute.ResizeOptions{Rollback: true})
+	if err != nil {
 		log.Fatal(err)
 	}
-	fmt.Println("Instance machine type changed successfully")
+	fmt.Printf("Instance machine type changed to %s\n", *instance.MachineType.Name)
 }
 */
 
 /*
 func ExampleListInstances() string {
 	// Create a new client
-	apiToken := os.Getenv("MGC_API_TOKEN")
-	if apiToken == "" {
-		log.Fatal("MGC_API_TOKEN environment variable is not set")
+	c, err := client.NewFromEnv()
+	if err != nil {
+		log.Fatal(err)
 	}
-	c := client.NewMgcClient(client.WithAPIKey(apiToken))
 	computeClient := compute.New(c)
 
 	// List instances with pagination and sorting
@@ -150,11 +149,10 @@ func ExampleListInstances() string {
 
 /*
 func ExampleCreateInstance() string {
-	apiToken := os.Getenv("MGC_API_TOKEN")
-	if apiToken == "" {
-		log.Fatal("MGC_API_TOKEN environment variable is not set")
+	c, err := client.NewFromEnv()
+	if err != nil {
+		log.Fatal(err)
 	}
-	c := client.NewMgcClient(client.WithAPIKey(apiToken))
 	computeClient := compute.New(c)
 
 	// Create a new instance
@@ -189,11 +187,10 @@ func ExampleCreateInstance() string {
 
 /*
 func ExampleGetInstance(id string) {
-	apiToken := os.Getenv("MGC_API_TOKEN")
-	if apiToken == "" {
-		log.Fatal("MGC_API_TOKEN environment variable is not set")
+	c, err := client.NewFromEnv()
+	if err != nil {
+		log.Fatal(err)
 	}
-	c := client.NewMgcClient(client.WithAPIKey(apiToken))
 	computeClient := compute.New(c)
 	ctx := context.Background()
 
@@ -239,11 +236,10 @@ func ExampleGetInstance(id string) {
 
 /*
 func ExampleDeleteInstance(id string) {
-	apiToken := os.Getenv("MGC_API_TOKEN")
-	if apiToken == "" {
-		log.Fatal("MGC_API_TOKEN environment variable is not set")
+	c, err := client.NewFromEnv()
+	if err != nil {
+		log.Fatal(err)
 	}
-	c := client.NewMgcClient(client.WithAPIKey(apiToken))
 	computeClient := compute.New(c)
 
 	// Delete instance and its public IP
@@ -310,12 +306,10 @@ func ExampleListImagesWithJWTAndAPIKey(ctx context.Context, apiToken string) {
 
 /*
 func ExampleListMachineTypes() {
-	apiToken := os.Getenv("MGC_API_TOKEN")
-	if apiToken == "" {
-		log.Fatal("MGC_API_TOKEN environment variable is not set")
+	c, err := client.NewFromEnv()
+	if err != nil {
+		log.Fatal(err)
 	}
-
-	c := client.NewMgcClient(client.WithAPIKey(apiToken))
 	computeClient := compute.New(c)
 
 	// List machine types
@@ -337,12 +331,10 @@ func ExampleListMachineTypes() {
 */
 /*
 func ExampleListImages() {
-	apiToken := os.Getenv("MGC_API_TOKEN")
-	if apiToken == "" {
-		log.Fatal("MGC_API_TOKEN environment variable is not set")
+	c, err := client.NewFromEnv()
+	if err != nil {
+		log.Fatal(err)
 	}
-
-	c := client.NewMgcClient(client.WithAPIKey(apiToken))
 	computeClient := compute.New(c)
 
 	// List images
@@ -367,11 +359,10 @@ func ExampleListImages() {
 /*
 func ExampleInitLog(id string) {
 	awaitRunningCompleted(id)
-	apiToken := os.Getenv("MGC_API_TOKEN")
-	if apiToken == "" {
-		log.Fatal("MGC_API_TOKEN environment variable is not set")
+	c, err := client.NewFromEnv()
+	if err != nil {
+		log.Fatal(err)
 	}
-	c := client.NewMgcClient(client.WithAPIKey(apiToken))
 	computeClient := compute.New(c)
 	ctx := context.Background()
 
@@ -386,11 +377,10 @@ func ExampleInitLog(id string) {
 
 /*
 func awaitRunningCompleted(id string) {
-	apiToken := os.Getenv("MGC_API_TOKEN")
-	if apiToken == "" {
-		log.Fatal("MGC_API_TOKEN environment variable is not set")
+	c, err := client.NewFromEnv()
+	if err != nil {
+		log.Fatal(err)
 	}
-	c := client.NewMgcClient(client.WithAPIKey(apiToken))
 	computeClient := compute.New(c)
 	ctx := context.Background()
 
@@ -16,11 +16,10 @@ import (
 )
 
 func main() {
-	apiToken := os.Getenv("MGC_API_TOKEN")
-	if apiToken == "" {
-		log.Fatal("MGC_API_TOKEN environment variable is not set")
+	c, err := client.NewFromEnv(client.WithBaseURL(client.BrSe1), client.WithLogger(slog.New(slog.NewJSONHandler(os.Stdout, nil))))
+	if err != nil {
+		log.Fatal(err)
 	}
-	c := client.NewMgcClient(client.WithAPIKey(apiToken), client.WithBaseURL(client.BrSe1), client.WithLogger(slog.New(slog.NewJSONHandler(os.Stdout, nil))))
 
 	getCredentials(c)
 	resetPassword(c)
@@ -187,7 +186,7 @@ func listProxyCaches(crClient *containerregistry.ContainerRegistryClient) {
 	resp, err := crClient.ProxyCaches().List(context.Background(), containerregistry.ProxyCacheListOptions{
 		Limit:  helpers.IntPtr(10),
 		Offset: helpers.IntPtr(0),
-		Sort:   helpers.StrPtr("name:desc"),
+		Sort:   containerregistry.SortBy(containerregistry.SortFieldName, helpers.SortDescending),
 	})
 
 	if err != nil {
@@ -226,7 +225,7 @@ func listProxyCaches(crClient *containerregistry.ContainerRegistryClient) {
 func listAllProxyCaches(crClient *containerregistry.ContainerRegistryClient) {
 	// List proxy caches with pagination
 	resp, err := crClient.ProxyCaches().ListAll(context.Background(), containerregistry.ProxyCacheListAllOptions{
-		Sort: helpers.StrPtr("name:desc"),
+		Sort: containerregistry.SortBy(containerregistry.SortFieldName, helpers.SortDescending),
 	})
 
 	if err != nil {
@@ -4,7 +4,6 @@ import (
 	"context"
 	"fmt"
 	"log"
-	"os"
 
 	"github.com/MagaluCloud/mgc-sdk-go/client"
 	"github.com/MagaluCloud/mgc-sdk-go/dbaas"
@@ -41,11 +40,10 @@ func main() {
 }
 
 func ExampleListEngines() {
-	apiToken := os.Getenv("MGC_API_TOKEN")
-	if apiToken == "" {
-		log.Fatal("MGC_API_TOKEN environment variable is not set")
+	c, err := client.NewFromEnv()
+	if err != nil {
+		log.Fatal(err)
 	}
-	c := client.NewMgcClient(client.WithAPIKey(apiToken))
 	dbaasClient := dbaas.New(c)
 
 	resp, err := dbaasClient.Engines().List(context.Background(), dbaas.ListEngineOptions{
@@ -69,11 +67,10 @@ func ExampleListEngines() {
 }
 
 func ExampleListAllEngines() {
-	apiToken := os.Getenv("MGC_API_TOKEN")
-	if apiToken == "" {
-		log.Fatal("MGC_API_TOKEN environment variable is not set")
+	c, err := client.NewFromEnv()
+	if err != nil {
+		log.Fatal(err)
 	}
-	c := client.NewMgcClient(client.WithAPIKey(apiToken))
 	dbaasClient := dbaas.New(c)
 
 	// List all engines across all pages
@@ -90,11 +87,10 @@ func ExampleListAllEngines() {
 }
 
 func ExampleListInstanceTypes() {
-	apiToken := os.Getenv("MGC_API_TOKEN")
-	if apiToken == "" {
-		log.Fatal("MGC_API_TOKEN environment variable is not set")
+	c, err := client.NewFromEnv()
+	if err != nil {
+		log.Fatal(err)
 	}
-	c := client.NewMgcClient(client.WithAPIKey(apiToken))
 	dbaasClient := dbaas.New(c)
 
 	resp, err := dbaasClient.InstanceTypes().List(context.Background(), dbaas.ListInstanceTypeOptions{
@@ -121,11 +117,10 @@ func ExampleListInstanceTypes() {
 }
 
 func ExampleListAllInstanceTypes() {
-	apiToken := os.Getenv("MGC_API_TOKEN")
-	if apiToken == "" {
-		log.Fatal("MGC_API_TOKEN environment variable is not set")
+	c, err := client.NewFromEnv()
+	if err != nil {
+		log.Fatal(err)
 	}
-	c := client.NewMgcClient(client.WithAPIKey(apiToken))
 	dbaasClient := dbaas.New(c)
 
 	// List all instance types across all pages
@@ -142,11 +137,10 @@ func ExampleListAllInstanceTypes() {
 }
 
 func ExampleListInstances() {
-	apiToken := os.Getenv("MGC_API_TOKEN")
-	if apiToken == "" {
-		log.Fatal("MGC_API_TOKEN environment variable is not set")
+	c, err := client.NewFromEnv()
+	if err != nil {
+		log.Fatal(err)
 	}
-	c := client.NewMgcClient(client.WithAPIKey(apiToken))
 	dbaasClient := dbaas.New(c)
 
 	resp, err := dbaasClient.Instances().List(context.Background(), dbaas.ListInstanceOptions{
@@ -180,11 +174,10 @@ func ExampleListInstances() {
 }
 
 func ExampleListAllInstances() {
-	apiToken := os.Getenv("MGC_API_TOKEN")
-	if apiToken == "" {
-		log.Fatal("MGC_API_TOKEN environment variable is not set")
+	c, err := client.NewFromEnv()
+	if err != nil {
+		log.Fatal(err)
 	}
-	c := client.NewMgcClient(client.WithAPIKey(apiToken))
 	dbaasClient := dbaas.New(c)
 
 	// List all instances across all pages
@@ -201,11 +194,10 @@ func ExampleListAllInstances() {
 }
 
 func ExampleCreateInstance() {
-	apiToken := os.Getenv("MGC_API_TOKEN")
-	if apiToken == "" {
-		log.Fatal("MGC_API_TOKEN environment variable is not set")
+	c, err := client.NewFromEnv()
+	if err != nil {
+		log.Fatal(err)
 	}
-	c := client.NewMgcClient(client.WithAPIKey(apiToken))
 	dbaasClient := dbaas.New(c)
 
 	// Create a new database instance
@@ -230,11 +222,10 @@ func ExampleCreateInstance() {
 }
 
 func ExampleUpdateInstance() {
-	apiToken := os.Getenv("MGC_API_TOKEN")
-	if apiToken == "" {
-		log.Fatal("MGC_API_TOKEN environment variable is not set")
+	c, err := client.NewFromEnv()
+	if err != nil {
+		log.Fatal(err)
 	}
-	c := client.NewMgcClient(client.WithAPIKey(apiToken))
 	dbaasClient := dbaas.New(c)
 
 	instanceId := "your-instance-id"
@@ -255,11 +246,10 @@ func ExampleUpdateInstance() {
 }
 
 func ExampleGetInstance() {
-	apiToken := os.Getenv("MGC_API_TOKEN")
-	if apiToken == "" {
-		log.Fatal("MGC_API_TOKEN environment variable is not set")
+	c, err := client.NewFromEnv()
+	if err != nil {
+		log.Fatal(err)
 	}
-	c := client.NewMgcClient(client.WithAPIKey(apiToken))
 	dbaasClient := dbaas.New(c)
 
 	instanceId := "your-instance-id"
@@ -289,11 +279,10 @@ func ExampleGetInstance() {
 }
 
 func ExampleListClusters() {
-	apiToken := os.Getenv("MGC_API_TOKEN")
-	if apiToken == "" {
-		log.Fatal("MGC_API_TOKEN environment variable is not set")
+	c, err := client.NewFromEnv()
+	if err != nil {
+		log.Fatal(err)
 	}
-	c := client.NewMgcClient(client.WithAPIKey(apiToken))
 	dbaasClient := dbaas.New(c)
 
 	resp, err := dbaasClient.Clusters().List(context.Background(), dbaas.ListClustersOptions{
@@ -329,11 +318,10 @@ func ExampleListClusters() {
 }
 
 func ExampleListAllClusters() {
-	apiToken := os.Getenv("MGC_API_TOKEN")
-	if apiToken == "" {
-		log.Fatal("MGC_API_TOKEN environment variable is not set")
+	c, err := client.NewFromEnv()
+	if err != nil {
+		log.Fatal(err)
 	}
-	c := client.NewMgcClient(client.WithAPIKey(apiToken))
 	dbaasClient := dbaas.New(c)
 
 	// List all clusters across all pages
@@ -350,11 +338,10 @@ func ExampleListAllClusters() {
 }
 
 func ExampleCreateCluster() {
-	apiToken := os.Getenv("MGC_API_TOKEN")
-	if apiToken == "" {
-		log.Fatal("MGC_API_TOKEN environment variable is not set")
+	c, err := client.NewFromEnv()
+	if err != nil {
+		log.Fatal(err)
 	}
-	c := client.NewMgcClient(client.WithAPIKey(apiToken))
 	dbaasClient := dbaas.New(c)
 
 	// Create a new database cluster
@@ -385,11 +372,10 @@ func ExampleCreateCluster() {
 }
 
 func ExampleGetCluster() {
-	apiToken := os.Getenv("MGC_API_TOKEN")
-	if apiToken == "" {
-		log.Fatal("MGC_API_TOKEN environment variable is not set")
+	c, err := client.NewFromEnv()
+	if err != nil {
+		log.Fatal(err)
 	}
-	c := client.NewMgcClient(client.WithAPIKey(apiToken))
 	dbaasClient := dbaas.New(c)
 
 	clusterID := "your-cluster-id" // Replace with actual cluster ID
@@ -410,11 +396,10 @@ func ExampleGetCluster() {
 }
 
 func ExampleUpdateCluster() {
-	apiToken := os.Getenv("MGC_API_TOKEN")
-	if apiToken == "" {
-		log.Fatal("MGC_API_TOKEN environment variable is not set")
+	c, err := client.NewFromEnv()
+	if err != nil {
+		log.Fatal(err)
 	}
-	c := client.NewMgcClient(client.WithAPIKey(apiToken))
 	dbaasClient := dbaas.New(c)
 
 	clusterID := "your-cluster-id" // Replace with actual cluster ID
@@ -440,11 +425,10 @@ func ExampleUpdateCluster() {
 
 // Example for parameter groups
 func ExampleListParametersGroup() {
-	apiToken := os.Getenv("MGC_API_TOKEN")
-	if apiToken == "" {
-		log.Fatal("MGC_API_TOKEN environment variable is not set")
+	c, err := client.NewFromEnv()
+	if err != nil {
+		log.Fatal(err)
 	}
-	c := client.NewMgcClient(client.WithAPIKey(apiToken))
 	dbaasClient := dbaas.New(c)
 
 	resp, err := dbaasClient.ParametersGroup().List(context.Background(), dbaas.ListParameterGroupsOptions{
@@ -469,11 +453,10 @@ func ExampleListParametersGroup() {
 }
 
 func ExampleListAllParametersGroup() {
-	apiToken := os.Getenv("MGC_API_TOKEN")
-	if apiToken == "" {
-		log.Fatal("MGC_API_TOKEN environment variable is not set")
+	c, err := client.NewFromEnv()
+	if err != nil {
+		log.Fatal(err)
 	}
-	c := client.NewMgcClient(client.WithAPIKey(apiToken))
 	dbaasClient := dbaas.New(c)
 
 	// List all parameter groups across all pages
@@ -490,11 +473,10 @@ func ExampleListAllParametersGroup() {
 }
 
 func ExampleCreateParameterGroup() {
-	apiToken := os.Getenv("MGC_API_TOKEN")
-	if apiToken == "" {
-		log.Fatal("MGC_API_TOKEN environment variable is not set")
+	c, err := client.NewFromEnv()
+	if err != nil {
+		log.Fatal(err)
 	}
-	c := client.NewMgcClient(client.WithAPIKey(apiToken))
 	dbaasClient := dbaas.New(c)
 
 	description := "Custom parameter group for MySQL production databases"
@@ -512,11 +494,10 @@ func ExampleCreateParameterGroup() {
 }
 
 func ExampleGetParameterGroup() {
-	apiToken := os.Getenv("MGC_API_TOKEN")
-	if apiToken == "" {
-		log.Fatal("MGC_API_TOKEN environment variable is not set")
+	c, err := client.NewFromEnv()
+	if err != nil {
+		log.Fatal(err)
 	}
-	c := client.NewMgcClient(client.WithAPIKey(apiToken))
 	dbaasClient := dbaas.New(c)
 
 	paramGroupID := "your-parameter-group-id" // Replace with actual parameter group ID
@@ -532,11 +513,10 @@ func ExampleGetParameterGroup() {
 }
 
 func ExampleUpdateParameterGroup() {
-	apiToken := os.Getenv("MGC_API_TOKEN")
-	if apiToken == "" {
-		log.Fatal("MGC_API_TOKEN environment variable is not set")
+	c, err := client.NewFromEnv()
+	if err != nil {
+		log.Fatal(err)
 	}
-	c := client.NewMgcClient(client.WithAPIKey(apiToken))
 	dbaasClient := dbaas.New(c)
 
 	paramGroupID := "your-parameter-group-id" // Replace with actual parameter group ID
@@ -557,11 +537,10 @@ func ExampleUpdateParameterGroup() {
 }
 
 func ExampleListParameters() {
-	apiToken := os.Getenv("MGC_API_TOKEN")
-	if apiToken == "" {
-		log.Fatal("MGC_API_TOKEN environment variable is not set")
+	c, err := client.NewFromEnv()
+	if err != nil {
+		log.Fatal(err)
 	}
-	c := client.NewMgcClient(client.WithAPIKey(apiToken))
 	dbaasClient := dbaas.New(c)
 
 	resp, err := dbaasClient.Parameters().List(context.Background(), dbaas.ListParametersOptions{
@@ -584,11 +563,10 @@ func ExampleListParameters() {
 }
 
 func ExampleListAllParameters() {
-	apiToken := os.Getenv("MGC_API_TOKEN")
-	if apiToken == "" {
-		log.Fatal("MGC_API_TOKEN environment variable is not set")
+	c, err := client.NewFromEnv()
+	if err != nil {
+		log.Fatal(err)
 	}
-	c := client.NewMgcClient(client.WithAPIKey(apiToken))
 	dbaasClient := dbaas.New(c)
 
 	// List all parameters across all pages for a specific parameter group
@@ -606,11 +584,10 @@ func ExampleListAllParameters() {
 }
 
 func ExampleCreateParameter() {
-	apiToken := os.Getenv("MGC_API_TOKEN")
-	if apiToken == "" {
-		log.Fatal("MGC_API_TOKEN environment variable is not set")
+	c, err := client.NewFromEnv()
+	if err != nil {
+		log.Fatal(err)
 	}
-	c := client.NewMgcClient(client.WithAPIKey(apiToken))
 	dbaasClient := dbaas.New(c)
 
 	created, err := dbaasClient.Parameters().Create(context.Background(),
@@ -628,11 +605,10 @@ func ExampleCreateParameter() {
 }
 
 func ExampleUpdateParameter() {
-	apiToken := os.Getenv("MGC_API_TOKEN")
-	if apiToken == "" {
-		log.Fatal("MGC_API_TOKEN environment variable is not set")
+	c, err := client.NewFromEnv()
+	if err != nil {
+		log.Fatal(err)
 	}
-	c := client.NewMgcClient(client.WithAPIKey(apiToken))
 	dbaasClient := dbaas.New(c)
 
 	updated, err := dbaasClient.Parameters().Update(context.Background(),
@@ -650,14 +626,13 @@ func ExampleUpdateParameter() {
 }
 
 func ExampleDeleteParameter() {
-	apiToken := os.Getenv("MGC_API_TOKEN")
-	if apiToken == "" {
-		log.Fatal("MGC_API_TOKEN environment variable is not set")
+	c, err := client.NewFromEnv()
+	if err != nil {
+		log.Fatal(err)
 	}
-	c := client.NewMgcClient(client.WithAPIKey(apiToken))
 	dbaasClient := dbaas.New(c)
 
-	err := dbaasClient.Parameters().Delete(context.Background(),
+	err = dbaasClient.Parameters().Delete(context.Background(),
 		"88bd17e0-779c-43a5-9695-5cb9f6f918c0",
 		"68378760-c4e0-484a-b71a-b900942e7758",
 	)
@@ -669,11 +644,10 @@ func ExampleDeleteParameter() {
 }
 
 func ExampleStartImportMode() {
-	apiToken := os.Getenv("MGC_API_TOKEN")
-	if apiToken == "" {
-		log.Fatal("MGC_API_TOKEN environment variable is not set")
+	c, err := client.NewFromEnv()
+	if err != nil {
+		log.Fatal(err)
 	}
-	c := client.NewMgcClient(client.WithAPIKey(apiToken))
 	dbaasClient := dbaas.New(c)
 
 	updated, err := dbaasClient.Clusters().StartImportMode(context.Background(), "your-cluster-id")
@@ -685,11 +659,10 @@ func ExampleStartImportMode() {
 }
 
 func ExampleStopImportMode() {
-	apiToken := os.Getenv("MGC_API_TOKEN")
-	if apiToken == "" {
-		log.Fatal("MGC_API_TOKEN environment variable is not set")
+	c, err := client.NewFromEnv()
+	if err != nil {
+		log.Fatal(err)
 	}
-	c := client.NewMgcClient(client.WithAPIKey(apiToken))
 	dbaasClient := dbaas.New(c)
 
 	updated, err := dbaasClient.Clusters().StopImportMode(context.Background(), "your-cluster-id")
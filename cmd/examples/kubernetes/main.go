@@ -5,7 +5,6 @@ import (
 	"fmt"
 	"log"
 	"math/rand/v2"
-	"os"
 	"strconv"
 	"sync"
 	"time"
@@ -20,9 +19,9 @@ func randomString() string {
 }
 
 func main() {
-	apiToken := os.Getenv("MGC_API_TOKEN")
-	if apiToken == "" {
-		log.Fatal("MGC_API_TOKEN environment variable is not set")
+	apiToken, err := client.APIKeyFromEnv()
+	if err != nil {
+		log.Fatal(err)
 	}
 
 	wg := sync.WaitGroup{}
@@ -90,33 +89,11 @@ func main() {
 }
 
 func deleteAllClusters(k8sClient *kubernetes.KubernetesClient) {
-	clusters, err := k8sClient.Clusters().List(context.Background(), kubernetes.ListOptions{})
+	err := k8sClient.Clusters().DeleteAll(context.Background(), kubernetes.DeleteAllOptions{})
 	if err != nil {
 		log.Fatal(err)
 	}
 
-	for _, cluster := range clusters {
-		err = k8sClient.Clusters().Delete(context.Background(), cluster.ID)
-		if err != nil {
-			log.Fatal(err)
-		}
-		fmt.Println("Cluster deleted:", cluster.ID)
-	}
-
-	for {
-		clusters, err = k8sClient.Clusters().List(context.Background(), kubernetes.ListOptions{})
-		if err != nil {
-			log.Fatal(err)
-		}
-
-		if len(clusters) == 0 {
-			break
-		}
-
-		time.Sleep(10 * time.Second)
-		fmt.Println("Waiting for clusters to be deleted")
-	}
-
 	fmt.Println("All clusters deleted")
 }
 
@@ -370,6 +347,23 @@ func ExampleNodePoolOperations(k8sClient *kubernetes.KubernetesClient, clusterID
 	}
 
 	fmt.Printf("\nNode Pool atualizado: %d replicas\n", updatedPool.Replicas)
+
+	taintReq := kubernetes.PatchNodePoolRequest{
+		Taints: &[]kubernetes.Taint{
+			{
+				Key:    "gpu",
+				Value:  "true",
+				Effect: "NoSchedule",
+			},
+		},
+	}
+
+	taintedPool, err := k8sClient.Nodepools().Update(ctx, clusterID, newPool.ID, taintReq)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	fmt.Printf("\nNode Pool atualizado com taints: %v\n", taintedPool.Taints)
 	pool, err := k8sClient.Nodepools().Get(ctx, clusterID, newPool.ID)
 	if err != nil {
 		log.Fatal(err)
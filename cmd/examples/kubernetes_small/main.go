@@ -5,7 +5,6 @@ import (
 	"fmt"
 	"log"
 	"math/rand/v2"
-	"os"
 	"strconv"
 	"time"
 
@@ -19,12 +18,10 @@ func randomString() string {
 }
 
 func main() {
-	apiToken := os.Getenv("MGC_API_TOKEN")
-	if apiToken == "" {
-		log.Fatal("MGC_API_TOKEN environment variable is not set")
+	c, err := client.NewFromEnv(client.WithBaseURL(client.BrSe1), client.WithRetryConfig(15, 2*time.Second, 60*time.Second, 2.0))
+	if err != nil {
+		log.Fatal(err)
 	}
-
-	c := client.NewMgcClient(client.WithAPIKey(apiToken), client.WithBaseURL(client.BrSe1), client.WithRetryConfig(15, 2*time.Second, 60*time.Second, 2.0))
 	k8sClient := kubernetes.New(c)
 
 	idComNodePool := "87438b90-0af6-4b41-bb3c-b3f9a69617de"
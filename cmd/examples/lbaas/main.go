@@ -11,11 +11,11 @@ import (
 	"fmt"
 	"log"
 	"math/big"
-	"os"
 	"strings"
 	"time"
 
 	"github.com/MagaluCloud/mgc-sdk-go/client"
+	"github.com/MagaluCloud/mgc-sdk-go/helpers"
 	"github.com/MagaluCloud/mgc-sdk-go/lbaas"
 )
 
@@ -27,6 +27,8 @@ const (
 	ExampleSubnetPoolID = "subnet-pool-12345678-1234-1234-1234-123456789012"
 	// Replace with your actual public IP ID (optional for external LBs)
 	ExamplePublicIPID = "public-ip-12345678-1234-1234-1234-123456789012"
+	// Replace with the network interface (NIC) ID of an instance to use as backend target
+	ExampleInstanceNicID = "nic-12345678-1234-1234-1234-123456789012"
 )
 
 // Global variables to store created resources for cleanup
@@ -94,9 +96,9 @@ func initializeClient() (*client.CoreClient, error) {
 	fmt.Println("Initializing Magalu Cloud SDK client...")
 
 	// Check for required environment variables
-	apiKey := os.Getenv("MGC_API_KEY")
-	if apiKey == "" {
-		return nil, fmt.Errorf("MGC_API_KEY environment variable is required")
+	apiKey, err := client.APIKeyFromEnv()
+	if err != nil {
+		return nil, err
 	}
 
 	// Create the core client with configuration
@@ -121,7 +123,7 @@ func waitForLoadBalancerStatus(ctx context.Context, client *client.CoreClient, l
 			return fmt.Errorf("error checking load balancer status: %w", err)
 		}
 
-		currentStatus := lbaas.LoadBalancerStatus(lb.Status)
+		currentStatus := lb.Status
 		fmt.Printf("Current status: %s\n", currentStatus)
 
 		if currentStatus == targetStatus {
@@ -172,6 +174,14 @@ func runCreateLoadBalancerExample(ctx context.Context, client *client.CoreClient
 				TargetsType:                         lbaas.BackendTypeInstance,
 				PanicThreshold:                      floatPtr(50.0), // Panic when 50% of targets are unhealthy
 				CloseConnectionsOnHostHealthFailure: boolPtr(true),
+				// Instance targets are identified by the NIC ID of the instance;
+				// raw backends use IPAddress instead. Setting both is rejected.
+				Targets: &[]lbaas.NetworkBackendInstanceTargetRequest{
+					{
+						NicID: stringPtr(ExampleInstanceNicID),
+						Port:  80,
+					},
+				},
 				// Health check will be linked later
 			},
 			{
@@ -297,9 +307,10 @@ func runListLoadBalancersExample(ctx context.Context, client *client.CoreClient)
 
 	// Example 1: List with pagination options
 	listOptions := lbaas.ListNetworkLoadBalancerRequest{
-		Limit:  intPtr(10),                   // Get up to 10 results per page
-		Offset: intPtr(0),                    // Start from the beginning
-		Sort:   stringPtr("created_at:desc"), // Sort by creation date, newest first
+		Limit:  intPtr(10), // Get up to 10 results per page
+		Offset: intPtr(0),  // Start from the beginning
+		// Sort by creation date, newest first
+		Sort: lbaas.SortBy(lbaas.SortFieldCreatedAt, helpers.SortDescending),
 	}
 
 	paginatedResp, err := lbService.List(ctx, listOptions)
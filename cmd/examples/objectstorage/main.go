@@ -22,9 +22,9 @@ const (
 
 func main() {
 	// Get credentials from environment
-	apiToken := os.Getenv("MGC_API_KEY")
-	if apiToken == "" {
-		log.Fatal("❌ MGC_API_TOKEN environment variable is not set")
+	apiToken, err := client.APIKeyFromEnv()
+	if err != nil {
+		log.Fatal("❌ ", err)
 	}
 
 	accessKey := os.Getenv("MGC_OBJECT_STORAGE_ACCESS_KEY")
@@ -186,16 +186,14 @@ func testCreateBucket(ctx context.Context, osClient *objectstorage.ObjectStorage
 	fmt.Println("📝 Test 2: Create Bucket")
 	fmt.Println("─────────────────────────────────────────────────────────────")
 
-	// Check if bucket already exists
-	exists, err := osClient.Buckets().Exists(ctx, testBucketName)
-	if err == nil && exists {
-		fmt.Printf("⚠️  Bucket already exists: %s (skipping creation)\n\n", testBucketName)
+	created, err := osClient.Buckets().EnsureExists(ctx, testBucketName)
+	if err != nil {
+		fmt.Printf("❌ Failed: %v\n\n", err)
 		return
 	}
 
-	err = osClient.Buckets().Create(ctx, testBucketName)
-	if err != nil {
-		fmt.Printf("❌ Failed: %v\n\n", err)
+	if !created {
+		fmt.Printf("⚠️  Bucket already exists: %s (skipping creation)\n\n", testBucketName)
 		return
 	}
 
@@ -484,7 +482,7 @@ func testDeleteBucket(ctx context.Context, osClient *objectstorage.ObjectStorage
 	err := osClient.Buckets().Delete(ctx, testBucketName, true)
 	if err != nil {
 		fmt.Printf("❌ Failed: %v\n\n", err)
-		fmt.Printf("   Note: Bucket may not be empty or may not exist\n\n")
+		fmt.Printf("   Note: Bucket may not exist\n\n")
 		return
 	}
 
@@ -21,9 +21,9 @@ const (
 
 func main() {
 	// Get credentials from environment
-	apiToken := os.Getenv("MGC_API_KEY")
-	if apiToken == "" {
-		log.Fatal("❌ MGC_API_KEY environment variable is not set")
+	apiToken, err := client.APIKeyFromEnv()
+	if err != nil {
+		log.Fatal("❌ ", err)
 	}
 
 	accessKey := os.Getenv("MGC_OBJECT_STORAGE_ACCESS_KEY")
@@ -246,6 +246,7 @@ func main() {
 	// Step 12: Clean up - delete the bucket
 	fmt.Println("📍 Step 12: Clean up - delete bucket")
 	fmt.Printf("   Deleting bucket '%s'...\n", testBucketName)
+	// The object was removed above, so a non-forced delete is enough
 	err = osClient.Buckets().Delete(ctx, testBucketName, false)
 	if err != nil {
 		fmt.Printf("   ❌ Failed to delete bucket: %v\n", err)
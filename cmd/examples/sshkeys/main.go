@@ -4,7 +4,6 @@ import (
 	"context"
 	"fmt"
 	"log"
-	"os"
 
 	"github.com/MagaluCloud/mgc-sdk-go/client"
 	"github.com/MagaluCloud/mgc-sdk-go/helpers"
@@ -19,11 +18,10 @@ func main() {
 }
 
 func ExampleListSSHKeys() {
-	apiToken := os.Getenv("MGC_API_TOKEN")
-	if apiToken == "" {
-		log.Fatal("MGC_API_TOKEN environment variable is not set")
+	c, err := client.NewFromEnv()
+	if err != nil {
+		log.Fatal(err)
 	}
-	c := client.NewMgcClient(client.WithAPIKey(apiToken))
 	sshClient := sshkeys.New(c)
 
 	keys, err := sshClient.Keys().List(context.Background(), sshkeys.ListOptions{
@@ -41,11 +39,10 @@ func ExampleListSSHKeys() {
 }
 
 func ExampleCreateSSHKey() string {
-	apiToken := os.Getenv("MGC_API_TOKEN")
-	if apiToken == "" {
-		log.Fatal("MGC_API_TOKEN environment variable is not set")
+	c, err := client.NewFromEnv()
+	if err != nil {
+		log.Fatal(err)
 	}
-	c := client.NewMgcClient(client.WithAPIKey(apiToken))
 	sshClient := sshkeys.New(c)
 
 	key, err := sshClient.Keys().Create(context.Background(), sshkeys.CreateSSHKeyRequest{
@@ -61,11 +58,10 @@ func ExampleCreateSSHKey() string {
 }
 
 func ExampleGetSSHKey(id string) {
-	apiToken := os.Getenv("MGC_API_TOKEN")
-	if apiToken == "" {
-		log.Fatal("MGC_API_TOKEN environment variable is not set")
+	c, err := client.NewFromEnv()
+	if err != nil {
+		log.Fatal(err)
 	}
-	c := client.NewMgcClient(client.WithAPIKey(apiToken))
 	sshClient := sshkeys.New(c)
 
 	key, err := sshClient.Keys().Get(context.Background(), id)
@@ -80,11 +76,10 @@ func ExampleGetSSHKey(id string) {
 }
 
 func ExampleDeleteSSHKey(id string) {
-	apiToken := os.Getenv("MGC_API_TOKEN")
-	if apiToken == "" {
-		log.Fatal("MGC_API_TOKEN environment variable is not set")
+	c, err := client.NewFromEnv()
+	if err != nil {
+		log.Fatal(err)
 	}
-	c := client.NewMgcClient(client.WithAPIKey(apiToken))
 	sshClient := sshkeys.New(c)
 
 	key, err := sshClient.Keys().Delete(context.Background(), id)
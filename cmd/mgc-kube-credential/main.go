@@ -0,0 +1,52 @@
+// Command mgc-kube-credential is a kubectl exec credential plugin for
+// MagaluCloud Kubernetes clusters. It is referenced by kubeconfigs from
+// kubernetes.ClusterService.GetExecKubeConfig and prints the cluster's client
+// credentials as an ExecCredential, authenticating with the API key from the
+// MGC_API_KEY environment variable.
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+
+	"github.com/MagaluCloud/mgc-sdk-go/client"
+	"github.com/MagaluCloud/mgc-sdk-go/kubernetes"
+)
+
+func main() {
+	clusterID := flag.String("cluster-id", "", "ID of the cluster to authenticate to")
+	baseURL := flag.String("base-url", client.BrSe1.String(), "MagaluCloud API URL of the cluster region")
+	flag.Parse()
+
+	if err := run(*clusterID, *baseURL); err != nil {
+		fmt.Fprintln(os.Stderr, "mgc-kube-credential:", err)
+		os.Exit(1)
+	}
+}
+
+func run(clusterID, baseURL string) error {
+	if clusterID == "" {
+		return fmt.Errorf("--cluster-id is required")
+	}
+
+	// kubectl reads the credential from stdout, so logs are discarded
+	c, err := client.NewFromEnv(
+		client.WithBaseURL(client.MgcUrl(baseURL)),
+		client.WithLogger(slog.New(slog.NewTextHandler(io.Discard, nil))),
+	)
+	if err != nil {
+		return err
+	}
+
+	credential, err := kubernetes.New(c).Clusters().GetExecCredential(context.Background(), clusterID)
+	if err != nil {
+		return err
+	}
+
+	return json.NewEncoder(os.Stdout).Encode(credential)
+}
@@ -0,0 +1,19 @@
+// Command mgc-schema prints a JSON Schema document describing the SDK's
+// request and response types.
+package main
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/MagaluCloud/mgc-sdk-go/schema"
+)
+
+func main() {
+	data, err := schema.Dump()
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "mgc-schema:", err)
+		os.Exit(1)
+	}
+	os.Stdout.Write(append(data, '\n'))
+}
@@ -18,11 +18,21 @@ const (
 // It encapsulates functionality to access instances, images, instance types, and snapshots.
 type VirtualMachineClient struct {
 	*client.CoreClient
+	defaultExpand []InstanceExpand
 }
 
 // ClientOption allows customizing the virtual machine client configuration.
 type ClientOption func(*VirtualMachineClient)
 
+// WithDefaultExpand sets expands applied to every instance List, ListAll and
+// Get call made through the client. Expands passed to a call are added to
+// these defaults.
+func WithDefaultExpand(expand ...InstanceExpand) ClientOption {
+	return func(c *VirtualMachineClient) {
+		c.defaultExpand = expand
+	}
+}
+
 // New creates a new instance of VirtualMachineClient.
 // If the core client is nil, returns nil.
 func New(core *client.CoreClient, opts ...ClientOption) *VirtualMachineClient {
@@ -38,6 +48,12 @@ func New(core *client.CoreClient, opts ...ClientOption) *VirtualMachineClient {
 	return vmClient
 }
 
+// NewDefault creates a new instance of VirtualMachineClient using the client installed
+// with client.SetDefault. If no default client is set, returns nil.
+func NewDefault(opts ...ClientOption) *VirtualMachineClient {
+	return New(client.Default(), opts...)
+}
+
 // newRequest creates a new HTTP request for the compute service.
 // This method is internal and should not be called directly by SDK users.
 func (c *VirtualMachineClient) newRequest(ctx context.Context, method, path string, body any) (*http.Request, error) {
@@ -14,6 +14,9 @@ import (
 type ImageList struct {
 	Meta   Meta    `json:"meta"`
 	Images []Image `json:"images"`
+	// ETag identifies this version of the list. Pass it as
+	// ImageListOptions.IfNoneMatch to detect changes on the next call.
+	ETag string `json:"-"`
 }
 
 // Image represents a virtual machine image.
@@ -128,6 +131,10 @@ type ImageListOptions struct {
 	Offset           *int
 	Sort             *string
 	AvailabilityZone *string
+	// IfNoneMatch makes the request conditional on the ETag of a previous
+	// list. When nothing changed, List returns an error matching
+	// client.ErrNotModified and the previous list is still current.
+	IfNoneMatch string
 }
 
 // ImageFilterOptions defines filtering options for ListAll (without pagination)
@@ -161,12 +168,14 @@ func (s *imageService) List(ctx context.Context, opts ImageListOptions) (*ImageL
 	req.URL.RawQuery = q.Encode()
 
 	response := &ImageList{}
+	cond := &mgc_http.Conditional{IfNoneMatch: opts.IfNoneMatch}
 
-	_, err = mgc_http.Do(s.client.GetConfig(), ctx, req, response)
+	_, err = mgc_http.Do(s.client.GetConfig(), mgc_http.WithConditional(ctx, cond), req, response)
 	if err != nil {
 		return nil, err
 	}
 
+	response.ETag = cond.ETag
 	return response, nil
 }
 
@@ -6,6 +6,8 @@ import (
 	"net/http/httptest"
 	"strconv"
 	"testing"
+
+	"github.com/MagaluCloud/mgc-sdk-go/client"
 )
 
 func TestImageService_List(t *testing.T) {
@@ -515,3 +517,31 @@ func TestImageService_GetCustom(t *testing.T) {
 		})
 	}
 }
+
+func TestImageService_ListConditional(t *testing.T) {
+	const etag = `"images-v1"`
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("If-None-Match") == etag {
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("ETag", etag)
+		w.Write([]byte(`{"images": [{"id": "img1", "name": "ubuntu"}], "meta": {"page": {"count": 1}}}`))
+	}))
+	defer server.Close()
+
+	svc := testClient(server.URL).Images()
+	list, err := svc.List(context.Background(), ImageListOptions{})
+	if err != nil {
+		t.Fatalf("List() error = %v", err)
+	}
+	if list.ETag != etag {
+		t.Errorf("ETag = %q, want %q", list.ETag, etag)
+	}
+
+	_, err = svc.List(context.Background(), ImageListOptions{IfNoneMatch: list.ETag})
+	if !client.IsNotModified(err) {
+		t.Errorf("List() error = %v, want not modified", err)
+	}
+}
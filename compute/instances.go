@@ -2,15 +2,20 @@ package compute
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"net/http"
+	"slices"
 	"strconv"
 	"strings"
 	"time"
 
 	"github.com/MagaluCloud/mgc-sdk-go/client"
+	"github.com/MagaluCloud/mgc-sdk-go/helpers"
 
 	mgc_http "github.com/MagaluCloud/mgc-sdk-go/internal/http"
+	"github.com/MagaluCloud/mgc-sdk-go/internal/utils"
+	"github.com/MagaluCloud/mgc-sdk-go/sshkeys"
 )
 
 // InstanceExpand represents the expand options for instance responses.
@@ -21,8 +26,23 @@ const (
 	InstanceImageExpand       InstanceExpand = "image"
 	InstanceMachineTypeExpand InstanceExpand = "machine-type"
 	InstanceNetworkExpand     InstanceExpand = "network"
+	InstanceStorageExpand     InstanceExpand = "storage"
 )
 
+// String returns the expand value as sent to the API.
+func (e InstanceExpand) String() string {
+	return string(e)
+}
+
+// IsValid reports whether the expand value is one of the known values.
+func (e InstanceExpand) IsValid() bool {
+	switch e {
+	case InstanceImageExpand, InstanceMachineTypeExpand, InstanceNetworkExpand, InstanceStorageExpand:
+		return true
+	}
+	return false
+}
+
 // Constants for API version headers.
 const (
 	VmInstanceHeaderVersionName = "x-api-version"
@@ -76,17 +96,53 @@ type Error struct {
 }
 
 // CreateRequest represents the request to create a new instance.
+// SshKeyName references a key registered in the SSH keys service, while
+// SshPublicKey injects an OpenSSH public key at boot without registering it.
 type CreateRequest struct {
 	AvailabilityZone *string                  `json:"availability_zone,omitempty"`
+	BootVolume       *BootVolumeSpec          `json:"boot_volume,omitempty"`
 	Image            IDOrName                 `json:"image"`
 	Labels           *[]string                `json:"labels,omitempty"`
 	MachineType      IDOrName                 `json:"machine_type"`
 	Name             string                   `json:"name"`
 	Network          *CreateParametersNetwork `json:"network,omitempty"`
 	SshKeyName       *string                  `json:"ssh_key_name,omitempty"`
+	SshPublicKey     *string                  `json:"ssh_public_key,omitempty"`
 	UserData         *string                  `json:"user_data,omitempty"`
 }
 
+// Validate checks the required fields of the request without calling the API.
+// All problems found are returned together as client.ValidationErrors.
+func (r CreateRequest) Validate() error {
+	var errs client.ValidationErrors
+	if r.Name == "" {
+		errs.Add("name", utils.CannotBeEmpty)
+	}
+	if r.MachineType.ID == nil && r.MachineType.Name == nil {
+		errs.Add("machine_type", "id or name is required")
+	}
+	if r.Image.ID == nil && r.Image.Name == nil {
+		errs.Add("image", "id or name is required")
+	}
+	if r.BootVolume != nil && r.BootVolume.Size <= 0 {
+		errs.Add("boot_volume.size", "must be greater than zero")
+	}
+	if r.AvailabilityZone != nil && *r.AvailabilityZone == "" {
+		errs.Add("availability_zone", utils.CannotBeEmpty)
+	}
+	return errs.ErrOrNil()
+}
+
+// BootVolumeSpec configures the root disk of a new instance.
+// When omitted, the root disk uses the image's default size and the default volume type.
+type BootVolumeSpec struct {
+	// Size is the root disk size in GB. It must be at least the image's minimum
+	// disk requirement, which ValidateCreate checks before the instance is created.
+	Size int `json:"size"`
+	// Type is the volume type name used for the root disk.
+	Type *string `json:"type,omitempty"`
+}
+
 // CreateParametersNetwork represents network configuration for instance creation.
 type CreateParametersNetwork struct {
 	AssociatePublicIp *bool                             `json:"associate_public_ip,omitempty"`
@@ -121,6 +177,50 @@ type RetypeRequest struct {
 	MachineType IDOrName `json:"machine_type"`
 }
 
+// InstanceStatusCompleted is the status of an instance with no operation in progress.
+const InstanceStatusCompleted = "completed"
+
+// ResizeOptions configures ResizeAndWait.
+type ResizeOptions struct {
+	// Wait controls how the instance is polled. The zero value polls every
+	// client.DefaultWaitPollInterval until the context is done.
+	Wait client.WaitOptions
+	// Rollback retypes the instance back to its original machine type when
+	// the resize fails.
+	Rollback bool
+}
+
+// ResizeError is returned by ResizeAndWait when the instance reports an error
+// status during the resize.
+type ResizeError struct {
+	ID      string
+	Status  string
+	Message string
+	// RolledBack reports whether a retype to the original machine type was
+	// requested. RollbackErr holds the error of that request, if any.
+	RolledBack  bool
+	RollbackErr error
+}
+
+// Error returns a string representation of the error.
+func (e *ResizeError) Error() string {
+	msg := fmt.Sprintf("resize of instance %s failed with status %s", e.ID, e.Status)
+	if e.Message != "" {
+		msg += ": " + e.Message
+	}
+	if e.RollbackErr != nil {
+		msg += fmt.Sprintf(" (rollback failed: %v)", e.RollbackErr)
+	} else if e.RolledBack {
+		msg += " (rolled back)"
+	}
+	return msg
+}
+
+// Unwrap returns the rollback error, if any.
+func (e *ResizeError) Unwrap() error {
+	return e.RollbackErr
+}
+
 // WindowsPasswordResponse represents the response from getting Windows password.
 type WindowsPasswordResponse struct {
 	Instance WindowsPasswordInstance `json:"instance"`
@@ -176,11 +276,16 @@ type InitLogResponse struct {
 type InstanceService interface {
 	List(ctx context.Context, opts ListOptions) (*ListInstancesResponse, error)
 	ListAll(ctx context.Context, opts InstanceFilterOptions) ([]Instance, error)
+	ListByVPC(ctx context.Context, vpcID string) ([]Instance, error)
 	Create(ctx context.Context, req CreateRequest) (string, error)
+	ValidateCreate(ctx context.Context, req CreateRequest) error
 	Get(ctx context.Context, id string, expand []InstanceExpand) (*Instance, error)
+	GetMany(ctx context.Context, ids []string, expand []InstanceExpand) (map[string]*Instance, []error)
+	GetOrNil(ctx context.Context, id string, expand []InstanceExpand) (*Instance, error)
 	Delete(ctx context.Context, id string, deletePublicIP bool) error
 	Rename(ctx context.Context, id string, newName string) error
 	Retype(ctx context.Context, id string, req RetypeRequest) error
+	ResizeAndWait(ctx context.Context, id string, req RetypeRequest, opts ResizeOptions) (*Instance, error)
 	Start(ctx context.Context, id string) error
 	Stop(ctx context.Context, id string) error
 	Suspend(ctx context.Context, id string) error
@@ -197,18 +302,25 @@ type instanceService struct {
 
 // ListOptions defines the parameters for filtering and pagination of instance lists.
 type ListOptions struct {
-	Limit  *int
-	Offset *int
-	Sort   *string
-	Expand []InstanceExpand
-	Name   *string
-}
-
-// InstanceFilterOptions defines filtering options for ListAll (without pagination)
+	Limit  *int             `json:"_limit,omitempty"`
+	Offset *int             `json:"_offset,omitempty"`
+	Sort   *string          `json:"_sort,omitempty"`
+	Expand []InstanceExpand `json:"expand,omitempty"`
+	Name   *string          `json:"name,omitempty"`
+	// VPCID keeps only instances attached to the given VPC. The API has no
+	// such filter, so the network expand is requested and the returned page
+	// is filtered locally: a filtered page may hold fewer items than Limit
+	// while Meta still describes the unfiltered page.
+	VPCID *string `json:"-"`
+}
+
+// InstanceFilterOptions defines filtering options for ListAll (without pagination).
+// VPCID is applied locally after all pages have been fetched.
 type InstanceFilterOptions struct {
 	Sort   *string
 	Expand []InstanceExpand
 	Name   *string
+	VPCID  *string
 }
 
 // List retrieves instances with pagination metadata.
@@ -233,12 +345,12 @@ func (s *instanceService) List(ctx context.Context, opts ListOptions) (*ListInst
 	if opts.Sort != nil {
 		q.Add("_sort", *opts.Sort)
 	}
-	if len(opts.Expand) > 0 {
-		expandStrs := make([]string, len(opts.Expand))
-		for i, e := range opts.Expand {
-			expandStrs[i] = string(e)
+	if expand := helpers.MergeExpand(s.client.defaultExpand, vpcExpand(opts.Expand, opts.VPCID)); len(expand) > 0 {
+		expandStr, err := helpers.JoinExpand(expand)
+		if err != nil {
+			return nil, err
 		}
-		q.Add("expand", strings.Join(expandStrs, ","))
+		q.Add("expand", expandStr)
 	}
 	if opts.Name != nil {
 		q.Add("name", *opts.Name)
@@ -252,6 +364,7 @@ func (s *instanceService) List(ctx context.Context, opts ListOptions) (*ListInst
 	if err != nil {
 		return nil, err
 	}
+	response.Instances = filterInstancesByVPC(response.Instances, opts.VPCID)
 	return response, nil
 }
 
@@ -269,7 +382,7 @@ func (s *instanceService) ListAll(ctx context.Context, opts InstanceFilterOption
 			Offset: &currentOffset,
 			Limit:  &currentLimit,
 			Sort:   opts.Sort,
-			Expand: opts.Expand,
+			Expand: vpcExpand(opts.Expand, opts.VPCID),
 			Name:   opts.Name,
 		}
 
@@ -288,13 +401,51 @@ func (s *instanceService) ListAll(ctx context.Context, opts InstanceFilterOption
 		offset += limit
 	}
 
-	return allInstances, nil
+	return filterInstancesByVPC(allInstances, opts.VPCID), nil
+}
+
+// ListByVPC retrieves every instance attached to a VPC, across all pages.
+// The instances are returned with the network expand.
+func (s *instanceService) ListByVPC(ctx context.Context, vpcID string) ([]Instance, error) {
+	if vpcID == "" {
+		return nil, &client.ValidationError{Field: "vpcID", Message: utils.CannotBeEmpty}
+	}
+	return s.ListAll(ctx, InstanceFilterOptions{VPCID: &vpcID})
+}
+
+// vpcExpand adds the network expand needed to filter by VPC.
+func vpcExpand(expand []InstanceExpand, vpcID *string) []InstanceExpand {
+	if vpcID == nil {
+		return expand
+	}
+	return helpers.MergeExpand(expand, []InstanceExpand{InstanceNetworkExpand})
+}
+
+// filterInstancesByVPC keeps the instances attached to vpcID, in their
+// original order. A nil vpcID matches everything.
+func filterInstancesByVPC(instances []Instance, vpcID *string) []Instance {
+	if vpcID == nil {
+		return instances
+	}
+
+	filtered := make([]Instance, 0, len(instances))
+	for _, instance := range instances {
+		if instance.Network != nil && instance.Network.Vpc != nil &&
+			instance.Network.Vpc.ID != nil && *instance.Network.Vpc.ID == *vpcID {
+			filtered = append(filtered, instance)
+		}
+	}
+	return filtered
 }
 
 // Create creates a new instance.
 // This method makes an HTTP request to provision a new virtual machine instance
 // and returns the ID of the created instance.
 func (s *instanceService) Create(ctx context.Context, createReq CreateRequest) (string, error) {
+	if err := validateCreateRequest(createReq); err != nil {
+		return "", err
+	}
+
 	res, err := mgc_http.ExecuteSimpleRequestWithRespBody[struct{ ID string }](
 		ctx,
 		s.client.newRequest,
@@ -310,6 +461,112 @@ func (s *instanceService) Create(ctx context.Context, createReq CreateRequest) (
 	return res.ID, nil
 }
 
+// ValidateCreate runs the checks made by Create and also checks the request
+// against the catalogs: the availability zone must be offered by the machine
+// type and the boot volume must be at least the minimum disk of the image.
+// The catalogs are fetched on every call, so Create leaves these checks to
+// callers that ask for them.
+func (s *instanceService) ValidateCreate(ctx context.Context, createReq CreateRequest) error {
+	if err := validateCreateRequest(createReq); err != nil {
+		return err
+	}
+
+	if createReq.AvailabilityZone != nil {
+		if err := s.validateAvailabilityZone(ctx, createReq); err != nil {
+			return err
+		}
+	}
+
+	if createReq.BootVolume != nil {
+		if err := s.validateBootVolume(ctx, createReq); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// validateCreateRequest checks the request fields and the inline SSH key
+// without calling the API.
+func validateCreateRequest(createReq CreateRequest) error {
+	if err := createReq.Validate(); err != nil {
+		return err
+	}
+
+	if createReq.SshPublicKey != nil {
+		if err := sshkeys.ValidatePublicKey(*createReq.SshPublicKey); err != nil {
+			var validationErr *client.ValidationError
+			if errors.As(err, &validationErr) {
+				return &client.ValidationError{Field: "ssh_public_key", Message: validationErr.Message}
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+// validateAvailabilityZone checks that the requested zone is offered by the
+// selected machine type. Machine types that cannot be found, or that do not
+// list their zones, are left for the API to reject.
+func (s *instanceService) validateAvailabilityZone(ctx context.Context, createReq CreateRequest) error {
+	zone := *createReq.AvailabilityZone
+	if createReq.MachineType.ID == nil && createReq.MachineType.Name == nil {
+		return nil
+	}
+
+	machineTypes, err := s.client.InstanceTypes().ListAll(ctx, InstanceTypeFilterOptions{})
+	if err != nil {
+		return err
+	}
+
+	for _, machineType := range machineTypes {
+		if (createReq.MachineType.ID != nil && machineType.ID == *createReq.MachineType.ID) ||
+			(createReq.MachineType.Name != nil && machineType.Name == *createReq.MachineType.Name) {
+			if machineType.AvailabilityZones == nil || len(*machineType.AvailabilityZones) == 0 ||
+				slices.Contains(*machineType.AvailabilityZones, zone) {
+				return nil
+			}
+			return &client.ValidationError{
+				Field: "availability_zone",
+				Message: fmt.Sprintf("zone %q is not supported by machine type %s (supported: %s)",
+					zone, machineType.Name, strings.Join(*machineType.AvailabilityZones, ", ")),
+			}
+		}
+	}
+
+	return nil
+}
+
+// validateBootVolume checks the requested boot volume size against the minimum
+// disk requirement of the selected image. Images that cannot be found are left
+// for the API to reject.
+func (s *instanceService) validateBootVolume(ctx context.Context, createReq CreateRequest) error {
+	if createReq.Image.ID == nil && createReq.Image.Name == nil {
+		return nil
+	}
+
+	images, err := s.client.Images().ListAll(ctx, ImageFilterOptions{AvailabilityZone: createReq.AvailabilityZone})
+	if err != nil {
+		return err
+	}
+
+	for _, image := range images {
+		if (createReq.Image.ID != nil && image.ID == *createReq.Image.ID) ||
+			(createReq.Image.Name != nil && image.Name == *createReq.Image.Name) {
+			if createReq.BootVolume.Size < image.MinimumRequirements.Disk {
+				return &client.ValidationError{
+					Field:   "boot_volume.size",
+					Message: fmt.Sprintf("must be at least %d GB for image %s", image.MinimumRequirements.Disk, image.Name),
+				}
+			}
+			return nil
+		}
+	}
+
+	return nil
+}
+
 // Get retrieves a specific instance.
 // This method makes an HTTP request to get detailed information about an instance
 // and optionally expands related resources.
@@ -322,13 +579,14 @@ func (s *instanceService) Get(ctx context.Context, id string, expand []InstanceE
 	// Set API version header
 	req.Header.Set(VmInstanceHeaderVersionName, VmInstanceHeaderVersion)
 
+	expand = helpers.MergeExpand(s.client.defaultExpand, expand)
 	if len(expand) > 0 {
 		q := req.URL.Query()
-		expandStrs := make([]string, len(expand))
-		for i, e := range expand {
-			expandStrs[i] = string(e)
+		expandStr, err := helpers.JoinExpand(expand)
+		if err != nil {
+			return nil, err
 		}
-		q.Add("expand", strings.Join(expandStrs, ","))
+		q.Add("expand", expandStr)
 		req.URL.RawQuery = q.Encode()
 	}
 
@@ -340,6 +598,21 @@ func (s *instanceService) Get(ctx context.Context, id string, expand []InstanceE
 	return resp, nil
 }
 
+// GetMany retrieves several instances concurrently.
+// It returns the instances that were found, keyed by ID, and one error per
+// ID that could not be retrieved. Each error is prefixed with its ID.
+func (s *instanceService) GetMany(ctx context.Context, ids []string, expand []InstanceExpand) (map[string]*Instance, []error) {
+	return utils.GetMany(ctx, ids, utils.DefaultGetManyConcurrency, func(ctx context.Context, id string) (*Instance, error) {
+		return s.Get(ctx, id, expand)
+	})
+}
+
+// GetOrNil retrieves a specific instance, returning (nil, nil) when it does
+// not exist. Errors are reserved for real failures.
+func (s *instanceService) GetOrNil(ctx context.Context, id string, expand []InstanceExpand) (*Instance, error) {
+	return utils.NilIfNotFound(s.Get(ctx, id, expand))
+}
+
 // Delete removes an instance.
 // This method makes an HTTP request to terminate and remove an instance.
 // If deletePublicIP is true, any associated public IP will also be released.
@@ -398,6 +671,75 @@ func (s *instanceService) Retype(ctx context.Context, id string, retypeReq Retyp
 	)
 }
 
+// ResizeAndWait retypes the instance and waits until it reports the new
+// machine type with status InstanceStatusCompleted, returning the final
+// instance. If the instance reports an error status, it returns a
+// *ResizeError and, when opts.Rollback is set, requests a retype back to the
+// original machine type first.
+func (s *instanceService) ResizeAndWait(ctx context.Context, id string, req RetypeRequest, opts ResizeOptions) (*Instance, error) {
+	if id == "" {
+		return nil, &client.ValidationError{Field: "id", Message: "cannot be empty"}
+	}
+
+	expand := []InstanceExpand{InstanceMachineTypeExpand}
+	original, err := s.Get(ctx, id, expand)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.Retype(ctx, id, req); err != nil {
+		return nil, err
+	}
+
+	var instance *Instance
+	err = client.Poll(ctx, opts.Wait, func(ctx context.Context) (bool, error) {
+		current, err := s.Get(ctx, id, expand)
+		if err != nil {
+			return false, err
+		}
+		instance = current
+
+		if isErrorStatus(instance.Status) {
+			resizeErr := &ResizeError{ID: id, Status: instance.Status}
+			if instance.Error != nil {
+				resizeErr.Message = instance.Error.Message
+			}
+			if opts.Rollback && original.MachineType != nil && original.MachineType.ID != "" {
+				resizeErr.RolledBack = true
+				resizeErr.RollbackErr = s.Retype(ctx, id, RetypeRequest{MachineType: IDOrName{ID: &original.MachineType.ID}})
+			}
+			return false, resizeErr
+		}
+
+		return instance.Status == InstanceStatusCompleted && hasMachineType(instance.MachineType, req.MachineType), nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return instance, nil
+}
+
+// isErrorStatus reports whether an instance status is a failure, such as
+// "retyping_error".
+func isErrorStatus(status string) bool {
+	return status == "error" || strings.HasSuffix(status, "_error")
+}
+
+// hasMachineType reports whether the machine type of an instance is the one
+// referenced by want.
+func hasMachineType(current *InstanceTypes, want IDOrName) bool {
+	if current == nil {
+		return false
+	}
+	if want.ID != nil {
+		return current.ID == *want.ID
+	}
+	if want.Name != nil {
+		return current.Name != nil && *current.Name == *want.Name
+	}
+	return true
+}
+
 // Start starts the instance.
 // This method makes an HTTP request to power on a stopped instance.
 // Returns an error if the instance is already running or if the operation fails.
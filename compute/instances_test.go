@@ -1,16 +1,26 @@
 package compute
 
 import (
+	"bytes"
 	"context"
+	"encoding/base64"
+	"encoding/gob"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
 	"net/http"
 	"net/http/httptest"
 	"reflect"
 	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
 	"testing"
 	"time"
 
 	"github.com/MagaluCloud/mgc-sdk-go/client"
+	"github.com/MagaluCloud/mgc-sdk-go/helpers"
 )
 
 func TestInstanceService_List(t *testing.T) {
@@ -275,7 +285,9 @@ func TestInstanceService_Create(t *testing.T) {
 		{
 			name: "successful creation",
 			req: CreateRequest{
-				Name: "test-vm",
+				Name:        "test-vm",
+				MachineType: IDOrName{Name: strPtr("BV1-1-10")},
+				Image:       IDOrName{Name: strPtr("ubuntu-24.04")},
 			},
 			response:   `{"id": "inst1"}`,
 			statusCode: http.StatusOK,
@@ -352,6 +364,236 @@ func TestInstanceService_Create(t *testing.T) {
 	}
 }
 
+func TestInstanceService_CreateWithBootVolume(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/compute/v1/instances" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		body, _ := io.ReadAll(r.Body)
+		if !strings.Contains(string(body), `"boot_volume":{"size":10,"type":"nvme"}`) {
+			t.Errorf("expected boot_volume in body, got %s", body)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"id": "inst1"}`))
+	}))
+	defer server.Close()
+
+	// Create leaves the image minimum disk to ValidateCreate and the API.
+	_, err := testClient(server.URL).Instances().Create(context.Background(), CreateRequest{
+		Name:        "test-vm",
+		MachineType: IDOrName{Name: strPtr("BV1-1-10")},
+		Image:       IDOrName{ID: strPtr("img-1")},
+		BootVolume:  &BootVolumeSpec{Size: 10, Type: strPtr("nvme")},
+	})
+	if err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+}
+
+func TestInstanceService_ValidateCreateBootVolume(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name      string
+		req       CreateRequest
+		wantErr   bool
+		wantField string
+	}{
+		{
+			name: "size above image minimum",
+			req: CreateRequest{
+				Name:        "test-vm",
+				MachineType: IDOrName{Name: strPtr("BV1-1-10")},
+				Image:       IDOrName{Name: strPtr("ubuntu-24.04")},
+				BootVolume:  &BootVolumeSpec{Size: 40, Type: strPtr("nvme")},
+			},
+		},
+		{
+			name: "size below image minimum",
+			req: CreateRequest{
+				Name:        "test-vm",
+				MachineType: IDOrName{Name: strPtr("BV1-1-10")},
+				Image:       IDOrName{ID: strPtr("img-1")},
+				BootVolume:  &BootVolumeSpec{Size: 10},
+			},
+			wantErr:   true,
+			wantField: "boot_volume.size",
+		},
+		{
+			name: "zero size",
+			req: CreateRequest{
+				Name:        "test-vm",
+				MachineType: IDOrName{Name: strPtr("BV1-1-10")},
+				Image:       IDOrName{ID: strPtr("img-1")},
+				BootVolume:  &BootVolumeSpec{},
+			},
+			wantErr:   true,
+			wantField: "boot_volume.size",
+		},
+		{
+			name: "unknown image is left to the API",
+			req: CreateRequest{
+				Name:        "test-vm",
+				MachineType: IDOrName{Name: strPtr("BV1-1-10")},
+				Image:       IDOrName{Name: strPtr("custom")},
+				BootVolume:  &BootVolumeSpec{Size: 5},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.Method != http.MethodGet || r.URL.Path != "/compute/v1/images" {
+					t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+					w.WriteHeader(http.StatusNotFound)
+					return
+				}
+				w.Header().Set("Content-Type", "application/json")
+				w.Write([]byte(`{"meta":{},"images":[{"id":"img-1","name":"ubuntu-24.04","minimum_requirements":{"vcpu":1,"ram":1,"disk":20}}]}`))
+			}))
+			defer server.Close()
+
+			err := testClient(server.URL).Instances().ValidateCreate(context.Background(), tt.req)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ValidateCreate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				var validationErr *client.ValidationError
+				if !errors.As(err, &validationErr) || validationErr.Field != tt.wantField {
+					t.Errorf("expected ValidationError on %s, got %v", tt.wantField, err)
+				}
+			}
+		})
+	}
+}
+
+func TestInstanceService_CreateWithSshPublicKey(t *testing.T) {
+	t.Parallel()
+
+	blob := []byte{0, 0, 0, 11}
+	blob = append(blob, "ssh-ed25519"...)
+	blob = append(blob, make([]byte, 36)...)
+	validKey := "ssh-ed25519 " + base64.StdEncoding.EncodeToString(blob) + " ci@runner"
+
+	t.Run("valid key is sent inline", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			var body map[string]any
+			if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+				t.Fatalf("decode body: %v", err)
+			}
+			if body["ssh_public_key"] != validKey {
+				t.Errorf("ssh_public_key = %v, want %q", body["ssh_public_key"], validKey)
+			}
+			if _, ok := body["ssh_key_name"]; ok {
+				t.Errorf("ssh_key_name should be omitted")
+			}
+			w.Header().Set("Content-Type", "application/json")
+			w.Write([]byte(`{"id": "inst1"}`))
+		}))
+		defer server.Close()
+
+		id, err := testClient(server.URL).Instances().Create(context.Background(), CreateRequest{
+			Name:         "ci-vm",
+			MachineType:  IDOrName{Name: strPtr("BV1-1-10")},
+			Image:        IDOrName{Name: strPtr("ubuntu-24.04")},
+			SshPublicKey: &validKey,
+		})
+		if err != nil {
+			t.Fatalf("Create() error = %v", err)
+		}
+		if id != "inst1" {
+			t.Errorf("Create() got = %v, want inst1", id)
+		}
+	})
+
+	t.Run("invalid key is rejected locally", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}))
+		defer server.Close()
+
+		_, err := testClient(server.URL).Instances().Create(context.Background(), CreateRequest{
+			Name:         "ci-vm",
+			MachineType:  IDOrName{Name: strPtr("BV1-1-10")},
+			Image:        IDOrName{Name: strPtr("ubuntu-24.04")},
+			SshPublicKey: strPtr("not-a-key"),
+		})
+		validationErr, ok := err.(*client.ValidationError)
+		if !ok || validationErr.Field != "ssh_public_key" {
+			t.Errorf("expected ValidationError on ssh_public_key, got %v", err)
+		}
+	})
+}
+
+func TestInstanceService_CreateWithAvailabilityZone(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name        string
+		zone        string
+		machineType string
+		wantErr     bool
+		wantPost    bool
+	}{
+		{name: "supported zone", zone: "br-se1-a", machineType: "BV1-1-10", wantPost: true},
+		{name: "unsupported zone", zone: "br-ne1-a", machineType: "BV1-1-10", wantErr: true},
+		{name: "empty zone", zone: "", machineType: "BV1-1-10", wantErr: true},
+		{name: "machine type without zone list", zone: "br-ne1-a", machineType: "BV2-2-20", wantPost: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			posted, creating := false, false
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				switch r.URL.Path {
+				case "/compute/v1/instance-types":
+					if creating {
+						t.Error("Create() fetched the machine type catalog")
+					}
+					w.Write([]byte(`{"meta":{},"instance_types":[
+						{"id":"mt1","name":"BV1-1-10","availability_zones":["br-se1-a","br-se1-b"]},
+						{"id":"mt2","name":"BV2-2-20"}
+					]}`))
+				case "/compute/v1/instances":
+					posted = true
+					w.Write([]byte(`{"id": "inst1"}`))
+				default:
+					t.Errorf("unexpected request %s", r.URL.Path)
+				}
+			}))
+			defer server.Close()
+
+			svc := testClient(server.URL).Instances()
+			req := CreateRequest{
+				Name:             "test-vm",
+				MachineType:      IDOrName{Name: strPtr(tt.machineType)},
+				Image:            IDOrName{Name: strPtr("ubuntu-24.04")},
+				AvailabilityZone: strPtr(tt.zone),
+			}
+			err := svc.ValidateCreate(context.Background(), req)
+			if err == nil {
+				creating = true
+				_, err = svc.Create(context.Background(), req)
+			}
+			if tt.wantErr {
+				var validationErr *client.ValidationError
+				if !errors.As(err, &validationErr) || validationErr.Field != "availability_zone" {
+					t.Errorf("expected ValidationError on availability_zone, got %v", err)
+				}
+			} else if err != nil {
+				t.Fatalf("Create() error = %v", err)
+			}
+			if posted != tt.wantPost {
+				t.Errorf("create request sent = %v, want %v", posted, tt.wantPost)
+			}
+		})
+	}
+}
+
 func TestInstanceService_Get(t *testing.T) {
 	t.Parallel()
 	tests := []struct {
@@ -442,6 +684,47 @@ func TestInstanceService_Get(t *testing.T) {
 	}
 }
 
+func TestInstanceService_DefaultExpand(t *testing.T) {
+	t.Parallel()
+	var gotExpand string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotExpand = r.URL.Query().Get("expand")
+		w.Header().Set("Content-Type", "application/json")
+		if r.URL.Path == "/compute/v1/instances" {
+			w.Write([]byte(`{"meta": {}, "instances": []}`))
+			return
+		}
+		w.Write([]byte(`{"id": "inst1"}`))
+	}))
+	defer server.Close()
+
+	core := client.NewMgcClient(client.WithAPIKey("test-api-key"),
+		client.WithBaseURL(client.MgcUrl(server.URL)))
+	svc := New(core, WithDefaultExpand(InstanceNetworkExpand)).Instances()
+	ctx := context.Background()
+
+	if _, err := svc.Get(ctx, "inst1", nil); err != nil {
+		t.Fatalf("Get() unexpected error: %v", err)
+	}
+	if gotExpand != "network" {
+		t.Errorf("Get() expand = %q, want %q", gotExpand, "network")
+	}
+
+	if _, err := svc.Get(ctx, "inst1", []InstanceExpand{InstanceImageExpand, InstanceNetworkExpand}); err != nil {
+		t.Fatalf("Get() unexpected error: %v", err)
+	}
+	if gotExpand != "network,image" {
+		t.Errorf("Get() expand = %q, want %q", gotExpand, "network,image")
+	}
+
+	if _, err := svc.List(ctx, ListOptions{Expand: []InstanceExpand{InstanceMachineTypeExpand}}); err != nil {
+		t.Fatalf("List() unexpected error: %v", err)
+	}
+	if gotExpand != "network,machine-type" {
+		t.Errorf("List() expand = %q, want %q", gotExpand, "network,machine-type")
+	}
+}
+
 func TestInstanceService_Delete(t *testing.T) {
 	t.Parallel()
 	tests := []struct {
@@ -502,6 +785,8 @@ func TestInstanceService_Delete(t *testing.T) {
 	}
 }
 
+var _ helpers.Renamer = (InstanceService)(nil)
+
 func TestInstanceService_Rename(t *testing.T) {
 	t.Parallel()
 	tests := []struct {
@@ -628,6 +913,98 @@ func TestInstanceService_Retype(t *testing.T) {
 	}
 }
 
+// resizeTestServer serves an instance that reports the given statuses and
+// machine types, one per GET after the first, and records retype requests.
+func resizeTestServer(t *testing.T, states []string, retypes *[]string) *httptest.Server {
+	t.Helper()
+	var mu sync.Mutex
+	gets := 0
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		if r.Method == http.MethodPost {
+			var req RetypeRequest
+			json.NewDecoder(r.Body).Decode(&req)
+			*retypes = append(*retypes, *req.MachineType.ID)
+			w.WriteHeader(http.StatusAccepted)
+			return
+		}
+
+		state := states[min(gets, len(states)-1)]
+		gets++
+		status, machineType, _ := strings.Cut(state, ":")
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintf(w, `{"id": "inst1", "status": %q, "machine_type": {"id": %q}, "error": {"message": "no capacity"}}`, status, machineType)
+	}))
+}
+
+func TestInstanceService_ResizeAndWait(t *testing.T) {
+	t.Parallel()
+	opts := ResizeOptions{Wait: client.WaitOptions{PollInterval: time.Millisecond, Timeout: 5 * time.Second}}
+	req := RetypeRequest{MachineType: IDOrName{ID: strPtr("large")}}
+
+	t.Run("waits for the new machine type", func(t *testing.T) {
+		var retypes []string
+		server := resizeTestServer(t, []string{"completed:small", "completed:small", "retyping:small", "completed:large"}, &retypes)
+		defer server.Close()
+
+		instance, err := testClient(server.URL).Instances().ResizeAndWait(context.Background(), "inst1", req, opts)
+		if err != nil {
+			t.Fatalf("ResizeAndWait() unexpected error: %v", err)
+		}
+		if instance.MachineType.ID != "large" || instance.Status != InstanceStatusCompleted {
+			t.Errorf("ResizeAndWait() = %s/%s, want large/completed", instance.MachineType.ID, instance.Status)
+		}
+		if !reflect.DeepEqual(retypes, []string{"large"}) {
+			t.Errorf("retype requests = %v, want [large]", retypes)
+		}
+	})
+
+	t.Run("failure with rollback", func(t *testing.T) {
+		var retypes []string
+		server := resizeTestServer(t, []string{"completed:small", "retyping:small", "retyping_error:small"}, &retypes)
+		defer server.Close()
+
+		rollback := opts
+		rollback.Rollback = true
+		_, err := testClient(server.URL).Instances().ResizeAndWait(context.Background(), "inst1", req, rollback)
+		var resizeErr *ResizeError
+		if !errors.As(err, &resizeErr) {
+			t.Fatalf("ResizeAndWait() error = %v, want *ResizeError", err)
+		}
+		if resizeErr.Status != "retyping_error" || resizeErr.Message != "no capacity" || !resizeErr.RolledBack {
+			t.Errorf("ResizeError = %+v", resizeErr)
+		}
+		if !reflect.DeepEqual(retypes, []string{"large", "small"}) {
+			t.Errorf("retype requests = %v, want [large small]", retypes)
+		}
+	})
+
+	t.Run("failure without rollback", func(t *testing.T) {
+		var retypes []string
+		server := resizeTestServer(t, []string{"completed:small", "retyping_error:small"}, &retypes)
+		defer server.Close()
+
+		_, err := testClient(server.URL).Instances().ResizeAndWait(context.Background(), "inst1", req, opts)
+		var resizeErr *ResizeError
+		if !errors.As(err, &resizeErr) || resizeErr.RolledBack {
+			t.Fatalf("ResizeAndWait() error = %v, want *ResizeError without rollback", err)
+		}
+		if len(retypes) != 1 {
+			t.Errorf("retype requests = %v, want only the resize", retypes)
+		}
+	})
+
+	t.Run("empty id", func(t *testing.T) {
+		_, err := testClient("http://localhost").Instances().ResizeAndWait(context.Background(), "", req, opts)
+		var validationErr *client.ValidationError
+		if !errors.As(err, &validationErr) {
+			t.Errorf("ResizeAndWait() error = %v, want *client.ValidationError", err)
+		}
+	})
+}
+
 func TestInstanceService_StateOperations(t *testing.T) {
 	t.Parallel()
 	tests := []struct {
@@ -769,6 +1146,61 @@ func TestInstanceService_Concurrent(t *testing.T) {
 }
 
 // Helper functions
+func TestInstanceService_ListByVPC(t *testing.T) {
+	t.Parallel()
+	instanceJSON := func(id, vpcID string) string {
+		return fmt.Sprintf(`{"id": %q, "network": {"vpc": {"id": %q}}}`, id, vpcID)
+	}
+
+	var requests atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		query := r.URL.Query()
+		if got := query.Get("expand"); got != "network" {
+			t.Errorf("expand = %q, want network", got)
+		}
+
+		instances := make([]string, 0, 50)
+		if query.Get("_offset") == "0" {
+			// A full first page with a single matching instance must not stop pagination.
+			instances = append(instances, instanceJSON("vm-a", "vpc-1"))
+			for i := 1; i < 50; i++ {
+				instances = append(instances, instanceJSON(fmt.Sprintf("other-%d", i), "vpc-2"))
+			}
+		} else {
+			instances = append(instances, instanceJSON("vm-b", "vpc-1"), `{"id": "no-network"}`)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintf(w, `{"meta": {}, "instances": [%s]}`, strings.Join(instances, ","))
+	}))
+	defer server.Close()
+
+	svc := testClient(server.URL).Instances()
+	got, err := svc.ListByVPC(context.Background(), "vpc-1")
+	if err != nil {
+		t.Fatalf("ListByVPC() error = %v", err)
+	}
+	if len(got) != 2 || got[0].ID != "vm-a" || got[1].ID != "vm-b" {
+		t.Errorf("ListByVPC() = %v, want vm-a and vm-b", got)
+	}
+	if requests.Load() != 2 {
+		t.Errorf("expected 2 page requests, got %d", requests.Load())
+	}
+
+	page, err := svc.List(context.Background(), ListOptions{Offset: intPtr(0), Limit: intPtr(50), VPCID: strPtr("vpc-2")})
+	if err != nil {
+		t.Fatalf("List() error = %v", err)
+	}
+	if len(page.Instances) != 49 {
+		t.Errorf("List() returned %d instances, want 49", len(page.Instances))
+	}
+
+	if _, err := svc.ListByVPC(context.Background(), ""); err == nil {
+		t.Error("ListByVPC() expected error for empty VPC ID")
+	}
+}
+
 func testClient(baseURL string) *VirtualMachineClient {
 	httpClient := &http.Client{}
 	core := client.NewMgcClient(client.WithAPIKey("test-api-key"),
@@ -785,6 +1217,10 @@ func strPtr(s string) *string {
 	return &s
 }
 
+func boolPtr(b bool) *bool {
+	return &b
+}
+
 // here
 func TestInstanceService_ListWithExpand(t *testing.T) {
 	t.Parallel()
@@ -1420,3 +1856,225 @@ func TestInstanceService_InitLog(t *testing.T) {
 		})
 	}
 }
+
+func TestInstanceService_GetMany(t *testing.T) {
+	t.Parallel()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		id := strings.TrimPrefix(r.URL.Path, "/compute/v1/instances/")
+		if got := r.URL.Query().Get("expand"); got != "network" {
+			t.Errorf("expected expand=network, got %s", got)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		if id == "missing" {
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"error": "instance not found"}`))
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprintf(w, `{"id": %q, "status": "completed"}`, id)
+	}))
+	defer server.Close()
+
+	client := testClient(server.URL)
+	instances, errs := client.Instances().GetMany(context.Background(), []string{"inst1", "missing", "inst2"}, []InstanceExpand{InstanceNetworkExpand})
+
+	if len(instances) != 2 {
+		t.Fatalf("GetMany() got %d instances, want 2", len(instances))
+	}
+	if instances["inst2"] == nil || instances["inst2"].ID != "inst2" {
+		t.Errorf("GetMany() missing inst2: %v", instances)
+	}
+	if len(errs) != 1 {
+		t.Fatalf("GetMany() got %d errors, want 1", len(errs))
+	}
+	if !strings.HasPrefix(errs[0].Error(), "missing:") {
+		t.Errorf("GetMany() error = %q, want prefix missing:", errs[0])
+	}
+}
+
+func TestInstanceService_GetNotFound(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name         string
+		statusCode   int
+		response     string
+		wantNotFound bool
+	}{
+		{name: "not found", statusCode: http.StatusNotFound, response: `{"message": "not found"}`, wantNotFound: true},
+		{name: "not found without body", statusCode: http.StatusNotFound, wantNotFound: true},
+		{name: "forbidden", statusCode: http.StatusForbidden, response: `{"message": "forbidden"}`},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(tt.statusCode)
+				w.Write([]byte(tt.response))
+			}))
+			defer server.Close()
+
+			_, err := testClient(server.URL).Instances().Get(context.Background(), "res-1", nil)
+			if err == nil {
+				t.Fatal("Get() error = nil, want an error")
+			}
+			if got := client.IsNotFound(err); got != tt.wantNotFound {
+				t.Errorf("IsNotFound(%v) = %v, want %v", err, got, tt.wantNotFound)
+			}
+		})
+	}
+}
+
+func TestInstanceService_GetOrNil(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name       string
+		statusCode int
+		response   string
+		wantNil    bool
+		wantErr    bool
+	}{
+		{name: "exists", statusCode: http.StatusOK, response: `{"id": "inst-1"}`},
+		{name: "not found", statusCode: http.StatusNotFound, response: `{"message": "not found"}`, wantNil: true},
+		{name: "forbidden", statusCode: http.StatusForbidden, response: `{"message": "forbidden"}`, wantNil: true, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(tt.statusCode)
+				w.Write([]byte(tt.response))
+			}))
+			defer server.Close()
+
+			got, err := testClient(server.URL).Instances().GetOrNil(context.Background(), "inst-1", nil)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("GetOrNil() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if (got == nil) != tt.wantNil {
+				t.Fatalf("GetOrNil() = %v, wantNil %v", got, tt.wantNil)
+			}
+			if got != nil && got.ID != "inst-1" {
+				t.Errorf("GetOrNil() ID = %s, want inst-1", got.ID)
+			}
+		})
+	}
+}
+
+func TestInstanceExpand_IsValid(t *testing.T) {
+	for _, e := range []InstanceExpand{InstanceImageExpand, InstanceMachineTypeExpand, InstanceNetworkExpand} {
+		if !e.IsValid() {
+			t.Errorf("%s.IsValid() = false, want true", e)
+		}
+	}
+	if InstanceExpand("volumes").IsValid() {
+		t.Error("unknown expand value reported as valid")
+	}
+}
+
+func TestInstanceService_InvalidExpand(t *testing.T) {
+	t.Parallel()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Error("request should not be sent with an invalid expand value")
+	}))
+	defer server.Close()
+
+	svc := testClient(server.URL).Instances()
+	invalid := []InstanceExpand{InstanceImageExpand, "volumes"}
+
+	_, err := svc.Get(context.Background(), "inst-1", invalid)
+	if _, ok := err.(*client.ValidationError); !ok {
+		t.Errorf("Get() error = %v, want ValidationError", err)
+	}
+
+	_, err = svc.List(context.Background(), ListOptions{Expand: invalid})
+	if _, ok := err.(*client.ValidationError); !ok {
+		t.Errorf("List() error = %v, want ValidationError", err)
+	}
+}
+
+func TestCreateRequest_Validate(t *testing.T) {
+	valid := CreateRequest{
+		Name:        "test-vm",
+		MachineType: IDOrName{Name: strPtr("BV1-1-10")},
+		Image:       IDOrName{ID: strPtr("img-1")},
+	}
+	if err := valid.Validate(); err != nil {
+		t.Fatalf("Validate() error = %v", err)
+	}
+
+	err := CreateRequest{BootVolume: &BootVolumeSpec{}}.Validate()
+	var errs client.ValidationErrors
+	if !errors.As(err, &errs) {
+		t.Fatalf("Validate() error = %T, want client.ValidationErrors", err)
+	}
+	want := []string{"name", "machine_type", "image", "boot_volume.size"}
+	if len(errs) != len(want) {
+		t.Fatalf("Validate() returned %d problems, want %d: %v", len(errs), len(want), err)
+	}
+	for i, field := range want {
+		if errs[i].Field != field {
+			t.Errorf("problem %d field = %q, want %q", i, errs[i].Field, field)
+		}
+	}
+}
+
+func TestInstanceService_CreateInvalidRequest(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+	}))
+	defer server.Close()
+
+	_, err := testClient(server.URL).Instances().Create(context.Background(), CreateRequest{Name: "test-vm"})
+	var validationErr *client.ValidationError
+	if !errors.As(err, &validationErr) || validationErr.Field != "machine_type" {
+		t.Errorf("expected ValidationError on machine_type, got %v", err)
+	}
+}
+
+func TestListOptions_Serialization(t *testing.T) {
+	t.Parallel()
+
+	opts := ListOptions{
+		Limit:  helpers.IntPtr(10),
+		Offset: helpers.IntPtr(20),
+		Sort:   helpers.StrPtr("name:asc"),
+		Expand: []InstanceExpand{InstanceMachineTypeExpand},
+		Name:   helpers.StrPtr("web"),
+	}
+
+	data, err := json.Marshal(opts)
+	if err != nil {
+		t.Fatalf("json.Marshal() error = %v", err)
+	}
+	want := `{"_limit":10,"_offset":20,"_sort":"name:asc","expand":["machine-type"],"name":"web"}`
+	if string(data) != want {
+		t.Errorf("json.Marshal() = %s, want %s", data, want)
+	}
+
+	var fromJSON ListOptions
+	if err := json.Unmarshal(data, &fromJSON); err != nil {
+		t.Fatalf("json.Unmarshal() error = %v", err)
+	}
+	if !reflect.DeepEqual(fromJSON, opts) {
+		t.Errorf("JSON round trip = %+v, want %+v", fromJSON, opts)
+	}
+
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(opts); err != nil {
+		t.Fatalf("gob Encode() error = %v", err)
+	}
+	var fromGob ListOptions
+	if err := gob.NewDecoder(&buf).Decode(&fromGob); err != nil {
+		t.Fatalf("gob Decode() error = %v", err)
+	}
+	if !reflect.DeepEqual(fromGob, opts) {
+		t.Errorf("gob round trip = %+v, want %+v", fromGob, opts)
+	}
+}
@@ -5,9 +5,9 @@ import (
 	"fmt"
 	"net/http"
 	"strconv"
-	"strings"
 	"time"
 
+	"github.com/MagaluCloud/mgc-sdk-go/helpers"
 	mgc_http "github.com/MagaluCloud/mgc-sdk-go/internal/http"
 )
 
@@ -22,6 +22,16 @@ const (
 	SnapshotMachineTypeExpand SnapshotExpand = "machine-type"
 )
 
+// String returns the expand value as sent to the API.
+func (e SnapshotExpand) String() string {
+	return string(e)
+}
+
+// IsValid reports whether the expand value is one of the known values.
+func (e SnapshotExpand) IsValid() bool {
+	return e == SnapshotImageExpand || e == SnapshotMachineTypeExpand
+}
+
 // ListSnapshotsResponse represents the response from listing snapshots.
 // This structure encapsulates the API response format for snapshots with pagination metadata.
 type ListSnapshotsResponse struct {
@@ -124,11 +134,11 @@ func (s *snapshotService) List(ctx context.Context, opts SnapshotListOptions) (*
 		q.Add("_sort", *opts.Sort)
 	}
 	if len(opts.Expand) > 0 {
-		expandStrs := make([]string, len(opts.Expand))
-		for i, e := range opts.Expand {
-			expandStrs[i] = string(e)
+		expandStr, err := helpers.JoinExpand(opts.Expand)
+		if err != nil {
+			return nil, err
 		}
-		q.Add("expand", strings.Join(expandStrs, ","))
+		q.Add("expand", expandStr)
 	}
 	req.URL.RawQuery = q.Encode()
 
@@ -207,11 +217,11 @@ func (s *snapshotService) Get(ctx context.Context, id string, expand []SnapshotE
 
 	if len(expand) > 0 {
 		q := req.URL.Query()
-		expandStrs := make([]string, len(expand))
-		for i, e := range expand {
-			expandStrs[i] = string(e)
+		expandStr, err := helpers.JoinExpand(expand)
+		if err != nil {
+			return nil, err
 		}
-		q.Add("expand", strings.Join(expandStrs, ","))
+		q.Add("expand", expandStr)
 		req.URL.RawQuery = q.Encode()
 	}
 
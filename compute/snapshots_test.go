@@ -7,6 +7,8 @@ import (
 	"strconv"
 	"testing"
 	"time"
+
+	"github.com/MagaluCloud/mgc-sdk-go/helpers"
 )
 
 func TestSnapshotService_List(t *testing.T) {
@@ -469,6 +471,8 @@ func TestSnapshotService_Delete(t *testing.T) {
 	}
 }
 
+var _ helpers.Renamer = (SnapshotService)(nil)
+
 func TestSnapshotService_Rename(t *testing.T) {
 	tests := []struct {
 		name       string
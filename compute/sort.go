@@ -0,0 +1,20 @@
+package compute
+
+import "github.com/MagaluCloud/mgc-sdk-go/helpers"
+
+// SortField is a field that instance, machine type, image and snapshot listings can be sorted by.
+type SortField string
+
+const (
+	SortFieldName      SortField = "name"
+	SortFieldCreatedAt SortField = "created_at"
+	SortFieldUpdatedAt SortField = "updated_at"
+	SortFieldVCPUs     SortField = "vcpus"
+	SortFieldPlatform  SortField = "platform"
+)
+
+// SortBy builds the value of a Sort option from a typed field and order,
+// e.g. SortBy(SortFieldVCPUs, helpers.SortAscending).
+func SortBy(field SortField, order helpers.SortOrder) *string {
+	return helpers.SortBy(string(field), order)
+}
@@ -40,6 +40,9 @@ type InstanceType struct {
 type InstanceTypeList struct {
 	InstanceTypes []InstanceType `json:"instance_types"`
 	Meta          Meta           `json:"meta"`
+	// ETag identifies this version of the list. Pass it as
+	// InstanceTypeListOptions.IfNoneMatch to detect changes on the next call.
+	ETag string `json:"-"`
 }
 
 // InstanceTypeService provides operations for querying available machine types.
@@ -57,17 +60,40 @@ type instanceTypeService struct {
 
 // InstanceTypeListOptions defines parameters for filtering and pagination of machine type lists.
 // All fields are optional and allow controlling the listing behavior.
+//
+// Limit, Offset, Sort and AvailabilityZone are sent to the API. MinVCPU,
+// MinRAM and HasGPU are applied locally to the returned page, so a filtered
+// page may hold fewer items than Limit while Meta still describes the
+// unfiltered page.
 type InstanceTypeListOptions struct {
 	Limit            *int    `json:"_limit,omitempty"`
 	Offset           *int    `json:"_offset,omitempty"`
 	Sort             *string `json:"_sort,omitempty"`
 	AvailabilityZone string  `json:"availability-zone,omitempty"`
+	// MinVCPU keeps only instance types with at least this many vCPUs.
+	MinVCPU *int `json:"-"`
+	// MinRAM keeps only instance types with at least this much RAM, in MB.
+	MinRAM *int `json:"-"`
+	// HasGPU keeps only instance types with (true) or without (false) GPUs.
+	HasGPU *bool `json:"-"`
+	// IfNoneMatch makes the request conditional on the ETag of a previous
+	// list. When nothing changed, List returns an error matching
+	// client.ErrNotModified and the previous list is still current.
+	IfNoneMatch string `json:"-"`
 }
 
 // InstanceTypeFilterOptions defines filtering options for ListAll (without pagination).
+// Sort and AvailabilityZone are sent to the API; the remaining fields are
+// applied locally after all pages have been fetched.
 type InstanceTypeFilterOptions struct {
 	Sort             *string `json:"_sort,omitempty"`
 	AvailabilityZone string  `json:"availability-zone,omitempty"`
+	// MinVCPU keeps only instance types with at least this many vCPUs.
+	MinVCPU *int `json:"-"`
+	// MinRAM keeps only instance types with at least this much RAM, in MB.
+	MinRAM *int `json:"-"`
+	// HasGPU keeps only instance types with (true) or without (false) GPUs.
+	HasGPU *bool `json:"-"`
 }
 
 // List retrieves instance types with pagination metadata.
@@ -95,10 +121,14 @@ func (s *instanceTypeService) List(ctx context.Context, opts InstanceTypeListOpt
 	req.URL.RawQuery = q.Encode()
 
 	response := &InstanceTypeList{}
-	_, err = mgc_http.Do(s.client.GetConfig(), ctx, req, response)
+	cond := &mgc_http.Conditional{IfNoneMatch: opts.IfNoneMatch}
+	_, err = mgc_http.Do(s.client.GetConfig(), mgc_http.WithConditional(ctx, cond), req, response)
 	if err != nil {
 		return nil, err
 	}
+	response.ETag = cond.ETag
+
+	response.InstanceTypes = filterInstanceTypes(response.InstanceTypes, opts.MinVCPU, opts.MinRAM, opts.HasGPU)
 
 	return response, nil
 }
@@ -135,5 +165,28 @@ func (s *instanceTypeService) ListAll(ctx context.Context, opts InstanceTypeFilt
 		offset += limit
 	}
 
-	return allInstanceTypes, nil
+	return filterInstanceTypes(allInstanceTypes, opts.MinVCPU, opts.MinRAM, opts.HasGPU), nil
+}
+
+// filterInstanceTypes applies the locally evaluated filters, keeping the
+// original order. A nil filter matches everything.
+func filterInstanceTypes(types []InstanceType, minVCPU, minRAM *int, hasGPU *bool) []InstanceType {
+	if minVCPU == nil && minRAM == nil && hasGPU == nil {
+		return types
+	}
+
+	filtered := make([]InstanceType, 0, len(types))
+	for _, it := range types {
+		if minVCPU != nil && it.VCPUs < *minVCPU {
+			continue
+		}
+		if minRAM != nil && it.RAM < *minRAM {
+			continue
+		}
+		if hasGPU != nil && (it.GPU != nil && *it.GPU > 0) != *hasGPU {
+			continue
+		}
+		filtered = append(filtered, it)
+	}
+	return filtered
 }
@@ -2,10 +2,13 @@ package compute
 
 import (
 	"context"
+	"errors"
 	"net/http"
 	"net/http/httptest"
 	"strconv"
 	"testing"
+
+	"github.com/MagaluCloud/mgc-sdk-go/client"
 )
 
 func TestMachineTypeService_List(t *testing.T) {
@@ -197,6 +200,82 @@ func TestMachineTypeService_List(t *testing.T) {
 	}
 }
 
+func TestInstanceTypeService_LocalFilters(t *testing.T) {
+	response := `{
+		"instance_types": [
+			{"id": "mt1", "name": "BV2-4-40", "vcpus": 2, "ram": 4096, "disk": 40},
+			{"id": "mt2", "name": "BV8-32-100", "vcpus": 8, "ram": 32768, "disk": 100},
+			{"id": "mt3", "name": "BV8-32-100-1xL40", "vcpus": 8, "ram": 32768, "disk": 100, "gpu": 1},
+			{"id": "mt4", "name": "BV16-64-100-2xL40", "vcpus": 16, "ram": 65536, "disk": 100, "gpu": 2}
+		],
+		"meta": {"page": {"offset": 0, "limit": 50, "count": 4, "total": 4}}
+	}`
+
+	tests := []struct {
+		name    string
+		minVCPU *int
+		minRAM  *int
+		hasGPU  *bool
+		wantIDs []string
+	}{
+		{name: "no filters", wantIDs: []string{"mt1", "mt2", "mt3", "mt4"}},
+		{name: "min vcpu", minVCPU: intPtr(8), wantIDs: []string{"mt2", "mt3", "mt4"}},
+		{name: "gpu with at least 32GB", minRAM: intPtr(32768), hasGPU: boolPtr(true), wantIDs: []string{"mt3", "mt4"}},
+		{name: "without gpu", hasGPU: boolPtr(false), wantIDs: []string{"mt1", "mt2"}},
+		{name: "nothing matches", minVCPU: intPtr(32), wantIDs: []string{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				for _, param := range []string{"min_vcpu", "min_ram", "has_gpu"} {
+					if r.URL.Query().Has(param) {
+						t.Errorf("unexpected query parameter %s", param)
+					}
+				}
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(http.StatusOK)
+				w.Write([]byte(response))
+			}))
+			defer server.Close()
+
+			client := testClient(server.URL)
+
+			list, err := client.InstanceTypes().List(context.Background(), InstanceTypeListOptions{
+				MinVCPU: tt.minVCPU,
+				MinRAM:  tt.minRAM,
+				HasGPU:  tt.hasGPU,
+			})
+			if err != nil {
+				t.Fatalf("List() unexpected error: %v", err)
+			}
+			assertInstanceTypeIDs(t, "List()", list.InstanceTypes, tt.wantIDs)
+
+			all, err := client.InstanceTypes().ListAll(context.Background(), InstanceTypeFilterOptions{
+				MinVCPU: tt.minVCPU,
+				MinRAM:  tt.minRAM,
+				HasGPU:  tt.hasGPU,
+			})
+			if err != nil {
+				t.Fatalf("ListAll() unexpected error: %v", err)
+			}
+			assertInstanceTypeIDs(t, "ListAll()", all, tt.wantIDs)
+		})
+	}
+}
+
+func assertInstanceTypeIDs(t *testing.T, method string, got []InstanceType, want []string) {
+	t.Helper()
+	if len(got) != len(want) {
+		t.Fatalf("%s got %d instance types, want %d", method, len(got), len(want))
+	}
+	for i, it := range got {
+		if it.ID != want[i] {
+			t.Errorf("%s instance type %d = %s, want %s", method, i, it.ID, want[i])
+		}
+	}
+}
+
 func TestInstanceTypeService_ListAll(t *testing.T) {
 	tests := []struct {
 		name       string
@@ -397,3 +476,31 @@ func generateInstanceTypeJSON(count, startID int) string {
 	}
 	return result
 }
+
+func TestMachineTypeService_ListConditional(t *testing.T) {
+	const etag = `"types-v1"`
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("If-None-Match") == etag {
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("ETag", etag)
+		w.Write([]byte(`{"instance_types": [{"id": "type1", "name": "BV1-1-10", "vcpus": 1, "ram": 1024}]}`))
+	}))
+	defer server.Close()
+
+	svc := testClient(server.URL).InstanceTypes()
+	list, err := svc.List(context.Background(), InstanceTypeListOptions{})
+	if err != nil {
+		t.Fatalf("List() error = %v", err)
+	}
+	if list.ETag != etag {
+		t.Errorf("ETag = %q, want %q", list.ETag, etag)
+	}
+
+	_, err = svc.List(context.Background(), InstanceTypeListOptions{IfNoneMatch: list.ETag})
+	if !errors.Is(err, client.ErrNotModified) {
+		t.Errorf("List() error = %v, want ErrNotModified", err)
+	}
+}
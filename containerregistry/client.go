@@ -36,6 +36,12 @@ func New(core *client.CoreClient, opts ...ClientOption) *ContainerRegistryClient
 	return crClient
 }
 
+// NewDefault creates a new instance of ContainerRegistryClient using the client installed
+// with client.SetDefault. If no default client is set, returns nil.
+func NewDefault(opts ...ClientOption) *ContainerRegistryClient {
+	return New(client.Default(), opts...)
+}
+
 // newRequest creates a new HTTP request for the container registry API
 func (c *ContainerRegistryClient) newRequest(ctx context.Context, method, path string, body any) (*http.Request, error) {
 	return mgc_http.NewRequest(c.GetConfig(), ctx, method, DefaultBasePath+path, &body)
@@ -2,8 +2,11 @@ package containerregistry
 
 import (
 	"context"
+	"encoding/base64"
+	"encoding/json"
 	"net/http"
 
+	"github.com/MagaluCloud/mgc-sdk-go/client"
 	mgc_http "github.com/MagaluCloud/mgc-sdk-go/internal/http"
 )
 
@@ -12,6 +15,7 @@ type (
 	CredentialsService interface {
 		Get(ctx context.Context) (*CredentialsResponse, error)
 		ResetPassword(ctx context.Context) (*CredentialsResponse, error)
+		DockerConfigJSON(ctx context.Context, registryURL string) ([]byte, error)
 	}
 
 	// credentialsService implements the CredentialsService interface
@@ -25,6 +29,16 @@ type (
 		Password string `json:"password"`
 		Email    string `json:"email"`
 	}
+
+	// dockerConfig is the layout of a Docker ~/.docker/config.json file
+	dockerConfig struct {
+		Auths map[string]dockerAuth `json:"auths"`
+	}
+
+	// dockerAuth is a single registry entry of a Docker config file
+	dockerAuth struct {
+		Auth string `json:"auth"`
+	}
 )
 
 // Get retrieves the current container registry credentials
@@ -48,3 +62,22 @@ func (c *credentialsService) ResetPassword(ctx context.Context) (*CredentialsRes
 	}
 	return res, nil
 }
+
+// DockerConfigJSON returns a Docker config.json document authenticating to
+// registryURL with the current registry credentials, as written by docker login.
+// The result contains the registry password and should be handled as a secret.
+func (c *credentialsService) DockerConfigJSON(ctx context.Context, registryURL string) ([]byte, error) {
+	if registryURL == "" {
+		return nil, &client.ValidationError{Field: "registryURL", Message: "cannot be empty"}
+	}
+
+	creds, err := c.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	auth := base64.StdEncoding.EncodeToString([]byte(creds.Username + ":" + creds.Password))
+	return json.Marshal(dockerConfig{
+		Auths: map[string]dockerAuth{registryURL: {Auth: auth}},
+	})
+}
@@ -334,3 +334,32 @@ func TestCredentialsService_InvalidServerResponses(t *testing.T) {
 		})
 	}
 }
+
+func TestCredentialsService_DockerConfigJSON(t *testing.T) {
+	t.Parallel()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/container-registry/v0/credentials" {
+			t.Errorf("unexpected path %s", r.URL.Path)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"username": "user", "password": "secret", "email": "user@example.com"}`))
+	}))
+	defer server.Close()
+
+	svc := testClient(server.URL).Credentials()
+
+	got, err := svc.DockerConfigJSON(context.Background(), "container-registry.br-se1.magalu.cloud")
+	if err != nil {
+		t.Fatalf("DockerConfigJSON() error = %v", err)
+	}
+
+	// base64("user:secret")
+	want := `{"auths":{"container-registry.br-se1.magalu.cloud":{"auth":"dXNlcjpzZWNyZXQ="}}}`
+	if string(got) != want {
+		t.Errorf("DockerConfigJSON() = %s, want %s", got, want)
+	}
+
+	if _, err := svc.DockerConfigJSON(context.Background(), ""); err == nil {
+		t.Error("expected error for empty registry URL")
+	}
+}
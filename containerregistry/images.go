@@ -8,6 +8,7 @@ import (
 	"strconv"
 	"strings"
 
+	"github.com/MagaluCloud/mgc-sdk-go/client"
 	"github.com/MagaluCloud/mgc-sdk-go/helpers"
 	mgc_http "github.com/MagaluCloud/mgc-sdk-go/internal/http"
 )
@@ -23,6 +24,20 @@ const (
 	ImageMediaTypeExpand         ImageExpand = "media_type"
 )
 
+// String returns the expand value as sent to the API.
+func (e ImageExpand) String() string {
+	return string(e)
+}
+
+// IsValid reports whether the expand value is one of the known values.
+func (e ImageExpand) IsValid() bool {
+	switch e {
+	case ImageTagsDetailsExpand, ImageExtraAttrExpand, ImageManifestMediaTypeExpand, ImageMediaTypeExpand:
+		return true
+	}
+	return false
+}
+
 type (
 	// ImagesService provides methods for managing images within repositories
 	ImagesService interface {
@@ -30,6 +45,7 @@ type (
 		ListAll(ctx context.Context, registryID, repositoryName string, filterOpts ImageFilterOptions) ([]ImageResponse, error)
 		Delete(ctx context.Context, registryID, repositoryName, digestOrTag string) error
 		Get(ctx context.Context, registryID, repositoryName, digestOrTag string) (*ImageResponse, error)
+		GetByTag(ctx context.Context, registryID, repositoryName, tag string) (*ImageResponse, error)
 	}
 
 	// ImageListOptions provides options for listing images with pagination
@@ -77,6 +93,10 @@ type (
 
 // List retrieves a list of images within a repository with optional filtering and pagination
 func (c *imagesService) List(ctx context.Context, registryID, repositoryName string, opts ImageListOptions) (*ImagesResponse, error) {
+	if _, err := helpers.JoinExpand(opts.Expand); err != nil {
+		return nil, err
+	}
+
 	path := fmt.Sprintf("/v0/registries/%s/repositories/%s/images", registryID, repositoryName)
 	query := c.createImageQueryParams(opts)
 
@@ -164,3 +184,12 @@ func (c *imagesService) Get(ctx context.Context, registryID, repositoryName, dig
 	}
 	return res, nil
 }
+
+// GetByTag resolves a tag to the image it points to, for example to find the
+// digest behind "v1.2.3". Unknown tags return an error matched by client.IsNotFound.
+func (c *imagesService) GetByTag(ctx context.Context, registryID, repositoryName, tag string) (*ImageResponse, error) {
+	if tag == "" || strings.Contains(tag, ":") {
+		return nil, &client.ValidationError{Field: "tag", Message: "must be a tag name, not empty or a digest"}
+	}
+	return c.Get(ctx, registryID, repositoryName, tag)
+}
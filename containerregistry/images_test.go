@@ -6,6 +6,8 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"testing"
+
+	"github.com/MagaluCloud/mgc-sdk-go/client"
 )
 
 func TestImagesService_List(t *testing.T) {
@@ -700,3 +702,53 @@ func generateImageJSONArray(count int) string {
 	}
 	return result
 }
+
+func TestImagesService_GetByTag(t *testing.T) {
+	t.Parallel()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.URL.Path != "/container-registry/v0/registries/reg-123/repositories/repo-test/images/v1.2.3" {
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"message": "not found"}`))
+			return
+		}
+		w.Write([]byte(`{"digest": "sha256:abc", "tags": ["v1.2.3"]}`))
+	}))
+	defer server.Close()
+
+	svc := testClient(server.URL).Images()
+
+	image, err := svc.GetByTag(context.Background(), "reg-123", "repo-test", "v1.2.3")
+	if err != nil {
+		t.Fatalf("GetByTag() error = %v", err)
+	}
+	if image.Digest != "sha256:abc" {
+		t.Errorf("GetByTag() digest = %q, want %q", image.Digest, "sha256:abc")
+	}
+
+	if _, err := svc.GetByTag(context.Background(), "reg-123", "repo-test", "missing"); !client.IsNotFound(err) {
+		t.Errorf("expected a not found error for unknown tag, got %v", err)
+	}
+
+	for _, tag := range []string{"", "sha256:abc"} {
+		_, err := svc.GetByTag(context.Background(), "reg-123", "repo-test", tag)
+		if _, ok := err.(*client.ValidationError); !ok {
+			t.Errorf("GetByTag(%q) error = %v, want ValidationError", tag, err)
+		}
+	}
+}
+
+func TestImagesService_ListInvalidExpand(t *testing.T) {
+	t.Parallel()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Error("request should not be sent with an invalid expand value")
+	}))
+	defer server.Close()
+
+	_, err := testClient(server.URL).Images().List(context.Background(), "reg-123", "repo-test", ImageListOptions{
+		ImageFilterOptions: ImageFilterOptions{Expand: []ImageExpand{ImageTagsDetailsExpand, "labels"}},
+	})
+	if _, ok := err.(*client.ValidationError); !ok {
+		t.Errorf("List() error = %v, want ValidationError", err)
+	}
+}
@@ -61,6 +61,12 @@ type UpdateProxyCacheRequest struct {
 	Description  *string `json:"description"`
 }
 
+// renameProxyCacheRequest updates only the name, leaving the other fields of
+// UpdateProxyCacheRequest out of the request body.
+type renameProxyCacheRequest struct {
+	Name string `json:"name"`
+}
+
 type GetProxyCacheResponse struct {
 	ProxyCache
 	Description string `json:"description"`
@@ -90,6 +96,7 @@ type ProxyCachesService interface {
 	Create(ctx context.Context, req CreateProxyCacheRequest) (*CreateProxyCacheResponse, error)
 	Delete(ctx context.Context, id string) error
 	Update(ctx context.Context, id string, req UpdateProxyCacheRequest) (*ProxyCache, error)
+	Rename(ctx context.Context, id string, newName string) error
 	Get(ctx context.Context, id string) (*GetProxyCacheResponse, error)
 	ListStatus(ctx context.Context, id string) (*ListProxyCacheStatusResponse, error)
 	CreateStatus(ctx context.Context, req CreateProxyCacheStatusRequest) (*CreateProxyCacheStatusResponse, error)
@@ -220,6 +227,19 @@ func (s *proxyCachesService) Update(ctx context.Context, id string, req UpdatePr
 	return result, nil
 }
 
+// This method makes a HTTP request to change the name of a proxy-cache.
+func (s *proxyCachesService) Rename(ctx context.Context, id string, newName string) error {
+	return mgc_http.ExecuteSimpleRequest(
+		ctx,
+		s.client.newRequest,
+		s.client.GetConfig(),
+		http.MethodPatch,
+		fmt.Sprintf(pathWithID, id),
+		renameProxyCacheRequest{Name: newName},
+		nil,
+	)
+}
+
 // This method makes a HTTP request to get detailed informations about a proxy-cache.
 func (s *proxyCachesService) Get(ctx context.Context, id string) (*GetProxyCacheResponse, error) {
 	return mgc_http.ExecuteSimpleRequestWithRespBody[GetProxyCacheResponse](
@@ -798,6 +798,35 @@ func TestProxyCachesService_Update(t *testing.T) {
 	}
 }
 
+var _ helpers.Renamer = (ProxyCachesService)(nil)
+
+func TestProxyCachesService_Rename(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/container-registry/v0/proxy-caches/id-1" {
+			t.Errorf("unexpected path: %s", r.URL.Path)
+		}
+		if r.Method != http.MethodPatch {
+			t.Errorf("expected PATCH method, got %s", r.Method)
+		}
+
+		var body map[string]any
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Errorf("error decoding request: %v", err)
+		}
+		if len(body) != 1 || body["name"] != "renamed" {
+			t.Errorf("request body = %v, want only the name", body)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"id": "id-1", "name": "renamed"}`))
+	}))
+	defer server.Close()
+
+	if err := testClient(server.URL).ProxyCaches().Rename(context.Background(), "id-1", "renamed"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
 func TestProxyCachesService_ListStatus(t *testing.T) {
 	tests := []struct {
 		name         string
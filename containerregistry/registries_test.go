@@ -6,6 +6,8 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"testing"
+
+	"github.com/MagaluCloud/mgc-sdk-go/client"
 )
 
 func intPtr(i int) *int {
@@ -790,3 +792,38 @@ func generateRegistryJSONArray(count int) string {
 	}
 	return result
 }
+
+func TestRegistriesService_GetNotFound(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name         string
+		statusCode   int
+		response     string
+		wantNotFound bool
+	}{
+		{name: "not found", statusCode: http.StatusNotFound, response: `{"message": "not found"}`, wantNotFound: true},
+		{name: "not found without body", statusCode: http.StatusNotFound, wantNotFound: true},
+		{name: "forbidden", statusCode: http.StatusForbidden, response: `{"message": "forbidden"}`},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(tt.statusCode)
+				w.Write([]byte(tt.response))
+			}))
+			defer server.Close()
+
+			_, err := testClient(server.URL).Registries().Get(context.Background(), "res-1")
+			if err == nil {
+				t.Fatal("Get() error = nil, want an error")
+			}
+			if got := client.IsNotFound(err); got != tt.wantNotFound {
+				t.Errorf("IsNotFound(%v) = %v, want %v", err, got, tt.wantNotFound)
+			}
+		})
+	}
+}
@@ -18,6 +18,7 @@ type (
 		ListAll(ctx context.Context, registryID string, filterOpts RepositoryFilterOptions) ([]RepositoryResponse, error)
 		Get(ctx context.Context, registryID, repositoryName string) (*RepositoryResponse, error)
 		Delete(ctx context.Context, registryID, repositoryName string) error
+		ListTags(ctx context.Context, registryID, repositoryName string) ([]string, error)
 	}
 
 	// RepositoryListOptions provides options for listing repositories with pagination
@@ -137,3 +138,17 @@ func (c *repositoriesService) Delete(ctx context.Context, registryID, repository
 	}
 	return nil
 }
+
+// ListTags retrieves the names of all tags in a repository, across all of its images
+func (c *repositoriesService) ListTags(ctx context.Context, registryID, repositoryName string) ([]string, error) {
+	images, err := c.client.Images().ListAll(ctx, registryID, repositoryName, ImageFilterOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	tags := make([]string, 0)
+	for _, image := range images {
+		tags = append(tags, image.Tags...)
+	}
+	return tags, nil
+}
@@ -585,3 +585,31 @@ func generateRepositoryJSONArray(count int) string {
 	}
 	return result
 }
+
+func TestRepositoriesService_ListTags(t *testing.T) {
+	t.Parallel()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/container-registry/v0/registries/reg-123/repositories/repo-test/images" {
+			t.Errorf("unexpected path %s", r.URL.Path)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{
+			"meta": {"page": {"count": 2, "limit": 50, "offset": 0, "total": 2}},
+			"results": [
+				{"digest": "sha256:1", "tags": ["latest", "v1.1"]},
+				{"digest": "sha256:2", "tags": ["v1.0"]}
+			]
+		}`))
+	}))
+	defer server.Close()
+
+	tags, err := testClient(server.URL).Repositories().ListTags(context.Background(), "reg-123", "repo-test")
+	if err != nil {
+		t.Fatalf("ListTags() error = %v", err)
+	}
+
+	want := []string{"latest", "v1.1", "v1.0"}
+	if fmt.Sprint(tags) != fmt.Sprint(want) {
+		t.Errorf("ListTags() = %v, want %v", tags, want)
+	}
+}
@@ -0,0 +1,58 @@
+package dbaas
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+)
+
+// Backup is a restore point of an instance. Automated backups are taken daily
+// at BackupStartAt and kept for BackupRetentionDays; on-demand backups are the
+// snapshots created with CreateSnapshot.
+type Backup struct {
+	ID   string       `json:"id"`
+	Name string       `json:"name"`
+	Type SnapshotType `json:"type"`
+	// Size is the allocated size of the backup, in GB.
+	Size int `json:"size"`
+	// CreatedAt is the point in time the backup restores to.
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// ListBackups returns the available restore points of an instance, newest first.
+func (s *instanceService) ListBackups(ctx context.Context, instanceID string) ([]Backup, error) {
+	if instanceID == "" {
+		return nil, fmt.Errorf(errIDCannotBeEmpty)
+	}
+
+	status := SnapshotStatusAvailable
+	snapshots, err := s.ListAllSnapshots(ctx, instanceID, SnapshotFilterOptions{Status: &status})
+	if err != nil {
+		return nil, err
+	}
+
+	return backupsFromSnapshots(snapshots), nil
+}
+
+// backupsFromSnapshots converts the available snapshots into backups, newest first.
+func backupsFromSnapshots(snapshots []SnapshotDetailResponse) []Backup {
+	backups := make([]Backup, 0, len(snapshots))
+	for _, snapshot := range snapshots {
+		if snapshot.Status != SnapshotStatusAvailable {
+			continue
+		}
+		backups = append(backups, Backup{
+			ID:        snapshot.ID,
+			Name:      snapshot.Name,
+			Type:      snapshot.Type,
+			Size:      snapshot.AllocatedSize,
+			CreatedAt: snapshot.CreatedAt,
+		})
+	}
+
+	sort.SliceStable(backups, func(i, j int) bool {
+		return backups[i].CreatedAt.After(backups[j].CreatedAt)
+	})
+	return backups
+}
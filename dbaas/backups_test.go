@@ -0,0 +1,46 @@
+package dbaas
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+const backupsResponse = `{
+	"meta": {"page": {"offset": 0, "limit": 25, "count": 3, "total": 3, "max_limit": 100}},
+	"results": [
+		{"id": "snap-1", "name": "daily", "type": "AUTOMATED", "status": "AVAILABLE", "allocated_size": 20, "created_at": "2024-01-01T03:00:00Z"},
+		{"id": "snap-2", "name": "before-upgrade", "type": "ON_DEMAND", "status": "AVAILABLE", "allocated_size": 21, "created_at": "2024-01-02T10:00:00Z"},
+		{"id": "snap-3", "name": "daily", "type": "AUTOMATED", "status": "CREATING", "allocated_size": 0, "created_at": "2024-01-03T03:00:00Z"}
+	]
+}`
+
+func assertBackups(t *testing.T, backups []Backup) {
+	t.Helper()
+	assertEqual(t, 2, len(backups))
+	assertEqual(t, "snap-2", backups[0].ID)
+	assertEqual(t, SnapshotTypeOnDemand, backups[0].Type)
+	assertEqual(t, 21, backups[0].Size)
+	assertEqual(t, time.Date(2024, 1, 2, 10, 0, 0, 0, time.UTC), backups[0].CreatedAt.UTC())
+	assertEqual(t, "snap-1", backups[1].ID)
+	assertEqual(t, SnapshotTypeAutomated, backups[1].Type)
+}
+
+func TestInstanceService_ListBackups(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assertEqual(t, "/database/v2/instances/inst-1/snapshots", r.URL.Path)
+		assertEqual(t, "AVAILABLE", r.URL.Query().Get("status"))
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(backupsResponse))
+	}))
+	defer server.Close()
+
+	backups, err := testInstanceClient(server.URL).ListBackups(context.Background(), "inst-1")
+	assertNoError(t, err)
+	assertBackups(t, backups)
+
+	_, err = testInstanceClient(server.URL).ListBackups(context.Background(), "")
+	assertError(t, err)
+}
@@ -39,6 +39,12 @@ func New(core *client.CoreClient, opts ...ClientOption) *DBaaSClient {
 	return client
 }
 
+// NewDefault creates a new instance of DBaaSClient using the client installed
+// with client.SetDefault. If no default client is set, returns nil.
+func NewDefault(opts ...ClientOption) *DBaaSClient {
+	return New(client.Default(), opts...)
+}
+
 // newRequest creates a new HTTP request for the database API
 func (c *DBaaSClient) newRequest(ctx context.Context, method, path string, body any) (*http.Request, error) {
 	return mgc_http.NewRequest(c.GetConfig(), ctx, method, DefaultBasePath+path, &body)
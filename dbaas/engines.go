@@ -28,6 +28,9 @@ type (
 	ListEnginesResponse struct {
 		Meta    MetaResponse   `json:"meta"`
 		Results []EngineDetail `json:"results"`
+		// ETag identifies this version of the list. Pass it as
+		// ListEngineOptions.IfNoneMatch to detect changes on the next call.
+		ETag string `json:"-"`
 	}
 
 	// MetaResponse contains metadata about the response
@@ -64,6 +67,10 @@ type (
 		Offset *int
 		Limit  *int
 		Status *string
+		// IfNoneMatch makes the request conditional on the ETag of a previous
+		// list. When nothing changed, List returns an error matching
+		// client.ErrNotModified and the previous list is still current.
+		IfNoneMatch string
 	}
 
 	// EngineFilterOptions provides filtering options for ListAll (without pagination)
@@ -114,8 +121,9 @@ func (s *engineService) List(ctx context.Context, opts ListEngineOptions) (*List
 		query.Set("status", string(*opts.Status))
 	}
 
+	cond := &mgc_http.Conditional{IfNoneMatch: opts.IfNoneMatch}
 	result, err := mgc_http.ExecuteSimpleRequestWithRespBody[ListEnginesResponse](
-		ctx,
+		mgc_http.WithConditional(ctx, cond),
 		s.client.newRequest,
 		s.client.GetConfig(),
 		http.MethodGet,
@@ -127,6 +135,7 @@ func (s *engineService) List(ctx context.Context, opts ListEngineOptions) (*List
 		return nil, err
 	}
 
+	result.ETag = cond.ETag
 	return result, nil
 }
 
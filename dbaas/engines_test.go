@@ -469,3 +469,25 @@ func TestEngineService_ListAll_WithFilters(t *testing.T) {
 		assertEqual(t, "ACTIVE", engine.Status)
 	}
 }
+
+func TestEngineService_ListConditional(t *testing.T) {
+	const etag = `"catalog-v1"`
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("If-None-Match") == etag {
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("ETag", etag)
+		w.Write([]byte(`{"meta": {"page": {"offset": 0, "limit": 25, "count": 1, "total": 1, "max_limit": 100}}, "results": [{"id": "id1"}]}`))
+	}))
+	defer server.Close()
+
+	svc := testEngineClient(server.URL)
+	list, err := svc.List(context.Background(), ListEngineOptions{})
+	assertNoError(t, err)
+	assertEqual(t, etag, list.ETag)
+
+	_, err = svc.List(context.Background(), ListEngineOptions{IfNoneMatch: list.ETag})
+	assertEqual(t, true, client.IsNotModified(err))
+}
@@ -6,7 +6,9 @@ import (
 	"net/http"
 	"net/url"
 	"strconv"
+	"strings"
 
+	"github.com/MagaluCloud/mgc-sdk-go/client"
 	mgc_http "github.com/MagaluCloud/mgc-sdk-go/internal/http"
 )
 
@@ -15,6 +17,9 @@ type (
 	ListInstanceTypesResponse struct {
 		Meta    MetaResponse   `json:"meta"`
 		Results []InstanceType `json:"results"`
+		// ETag identifies this version of the list. Pass it as
+		// ListInstanceTypeOptions.IfNoneMatch to detect changes on the next call.
+		ETag string `json:"-"`
 	}
 
 	// InstanceType represents a database instance type
@@ -27,16 +32,26 @@ type (
 		FamilyDescription string `json:"family_description"`
 		FamilySlug        string `json:"family_slug"`
 		Size              string `json:"size"`
+		EngineID          string `json:"engine_id"`
 		CompatibleProduct string `json:"compatible_product"`
 	}
 )
 
+// Products an instance type can be compatible with.
+const (
+	CompatibleProductSingleInstance        = "SINGLE_INSTANCE"
+	CompatibleProductSingleInstanceReplica = "SINGLE_INSTANCE_REPLICA"
+	CompatibleProductCluster               = "CLUSTER"
+)
+
 type (
 	// InstanceTypeService provides methods for managing database instance types
 	InstanceTypeService interface {
 		List(ctx context.Context, opts ListInstanceTypeOptions) (*ListInstanceTypesResponse, error)
 		ListAll(ctx context.Context, filterOpts InstanceTypeFilterOptions) ([]InstanceType, error)
 		Get(ctx context.Context, id string) (*InstanceType, error)
+		ListCompatible(ctx context.Context, engineID string, product string) ([]InstanceType, error)
+		ValidateCompatibility(ctx context.Context, engineID string, instanceTypeID string, product string) error
 	}
 
 	// instanceTypeService implements the InstanceTypeService interface
@@ -44,20 +59,40 @@ type (
 		client *DBaaSClient
 	}
 
-	// ListInstanceTypeOptions provides options for listing instance types
+	// ListInstanceTypeOptions provides options for listing instance types.
+	// MinVCPU, MinRAM and FamilySlug are applied locally to the returned page;
+	// all other fields are sent to the API.
 	ListInstanceTypeOptions struct {
 		Offset            *int    `json:"offset,omitempty"`
 		Limit             *int    `json:"limit,omitempty"`
 		Status            *string `json:"status,omitempty"`
 		EngineID          *string `json:"engine_id,omitempty"`
 		CompatibleProduct *string `json:"compatible_product,omitempty"`
+		// MinVCPU keeps only instance types with at least this many vCPUs.
+		MinVCPU *int `json:"-"`
+		// MinRAM keeps only instance types with at least this much RAM, in GB.
+		MinRAM *int `json:"-"`
+		// FamilySlug keeps only instance types of the given family.
+		FamilySlug *string `json:"-"`
+		// IfNoneMatch makes the request conditional on the ETag of a previous
+		// list. When nothing changed, List returns an error matching
+		// client.ErrNotModified and the previous list is still current.
+		IfNoneMatch string `json:"-"`
 	}
 
-	// InstanceTypeFilterOptions provides filtering options for ListAll (without pagination)
+	// InstanceTypeFilterOptions provides filtering options for ListAll (without pagination).
+	// MinVCPU, MinRAM and FamilySlug are applied locally after all pages have
+	// been fetched.
 	InstanceTypeFilterOptions struct {
 		Status            *string `json:"status,omitempty"`
 		EngineID          *string `json:"engine_id,omitempty"`
 		CompatibleProduct *string `json:"compatible_product,omitempty"`
+		// MinVCPU keeps only instance types with at least this many vCPUs.
+		MinVCPU *int `json:"-"`
+		// MinRAM keeps only instance types with at least this much RAM, in GB.
+		MinRAM *int `json:"-"`
+		// FamilySlug keeps only instance types of the given family.
+		FamilySlug *string `json:"-"`
 	}
 )
 
@@ -81,8 +116,9 @@ func (s *instanceTypeService) List(ctx context.Context, opts ListInstanceTypeOpt
 		query.Set("compatible_product", *opts.CompatibleProduct)
 	}
 
+	cond := &mgc_http.Conditional{IfNoneMatch: opts.IfNoneMatch}
 	result, err := mgc_http.ExecuteSimpleRequestWithRespBody[ListInstanceTypesResponse](
-		ctx,
+		mgc_http.WithConditional(ctx, cond),
 		s.client.newRequest,
 		s.client.GetConfig(),
 		http.MethodGet,
@@ -94,6 +130,9 @@ func (s *instanceTypeService) List(ctx context.Context, opts ListInstanceTypeOpt
 		return nil, err
 	}
 
+	result.ETag = cond.ETag
+	result.Results = filterInstanceTypes(result.Results, opts.MinVCPU, opts.MinRAM, opts.FamilySlug)
+
 	return result, nil
 }
 
@@ -128,7 +167,54 @@ func (s *instanceTypeService) ListAll(ctx context.Context, filterOpts InstanceTy
 		offset += limit
 	}
 
-	return allInstanceTypes, nil
+	return filterInstanceTypes(allInstanceTypes, filterOpts.MinVCPU, filterOpts.MinRAM, filterOpts.FamilySlug), nil
+}
+
+// filterInstanceTypes applies the locally evaluated filters, keeping the
+// original order. Instance types whose vCPU or RAM cannot be parsed are
+// dropped whenever the corresponding filter is set.
+func filterInstanceTypes(types []InstanceType, minVCPU, minRAM *int, familySlug *string) []InstanceType {
+	if minVCPU == nil && minRAM == nil && familySlug == nil {
+		return types
+	}
+
+	filtered := make([]InstanceType, 0, len(types))
+	for _, it := range types {
+		if minVCPU != nil {
+			vcpu, ok := leadingInt(it.VCPU)
+			if !ok || vcpu < *minVCPU {
+				continue
+			}
+		}
+		if minRAM != nil {
+			ram, ok := leadingInt(it.RAM)
+			if !ok || ram < *minRAM {
+				continue
+			}
+		}
+		if familySlug != nil && it.FamilySlug != *familySlug {
+			continue
+		}
+		filtered = append(filtered, it)
+	}
+	return filtered
+}
+
+// leadingInt parses the integer at the start of values such as "2" or "4GB".
+func leadingInt(s string) (int, bool) {
+	s = strings.TrimSpace(s)
+	end := 0
+	for end < len(s) && s[end] >= '0' && s[end] <= '9' {
+		end++
+	}
+	if end == 0 {
+		return 0, false
+	}
+	n, err := strconv.Atoi(s[:end])
+	if err != nil {
+		return 0, false
+	}
+	return n, true
 }
 
 // Get retrieves detailed information about a specific instance type
@@ -143,3 +229,67 @@ func (s *instanceTypeService) Get(ctx context.Context, id string) (*InstanceType
 		nil,
 	)
 }
+
+// IsCompatible reports whether instanceType can be used with the engine
+// identified by engineID. Instance types that do not name an engine are
+// accepted for any engine.
+func IsCompatible(engineID string, instanceType InstanceType) bool {
+	return instanceType.EngineID == "" || instanceType.EngineID == engineID
+}
+
+// ListCompatible returns the instance types that can be used with the given
+// engine. A non-empty product, such as CompatibleProductCluster, also limits
+// the result to instance types of that product.
+func (s *instanceTypeService) ListCompatible(ctx context.Context, engineID string, product string) ([]InstanceType, error) {
+	filterOpts := InstanceTypeFilterOptions{EngineID: &engineID}
+	if product != "" {
+		filterOpts.CompatibleProduct = &product
+	}
+
+	types, err := s.ListAll(ctx, filterOpts)
+	if err != nil {
+		return nil, err
+	}
+
+	compatible := make([]InstanceType, 0, len(types))
+	for _, it := range types {
+		if IsCompatible(engineID, it) {
+			compatible = append(compatible, it)
+		}
+	}
+	return compatible, nil
+}
+
+// ValidateCompatibility checks, before an instance or cluster is created,
+// that the instance type can be used with the engine and, when product is
+// not empty, with that product. An incompatible combination returns a
+// *client.ValidationError for the instance_type_id field.
+func (s *instanceTypeService) ValidateCompatibility(ctx context.Context, engineID string, instanceTypeID string, product string) error {
+	if engineID == "" {
+		return &client.ValidationError{Field: "engine_id", Message: "cannot be empty"}
+	}
+	if instanceTypeID == "" {
+		return &client.ValidationError{Field: "instance_type_id", Message: "cannot be empty"}
+	}
+
+	instanceType, err := s.Get(ctx, instanceTypeID)
+	if err != nil {
+		return err
+	}
+
+	if !IsCompatible(engineID, *instanceType) {
+		return &client.ValidationError{
+			Field:   "instance_type_id",
+			Message: fmt.Sprintf("instance type %q is for engine %q, not %q", instanceTypeID, instanceType.EngineID, engineID),
+		}
+	}
+
+	if product != "" && instanceType.CompatibleProduct != "" && instanceType.CompatibleProduct != product {
+		return &client.ValidationError{
+			Field:   "instance_type_id",
+			Message: fmt.Sprintf("instance type %q is for %s, not %s", instanceTypeID, instanceType.CompatibleProduct, product),
+		}
+	}
+
+	return nil
+}
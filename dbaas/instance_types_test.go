@@ -2,6 +2,7 @@ package dbaas
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"net/http"
 	"net/http/httptest"
@@ -539,3 +540,163 @@ func TestInstanceTypeService_ListAll_WithFilters(t *testing.T) {
 		assertEqual(t, "SINGLE_INSTANCE", it.CompatibleProduct)
 	}
 }
+
+func TestInstanceTypeService_LocalFilters(t *testing.T) {
+	response := `{
+		"meta": {"page": {"offset": 0, "limit": 25, "count": 4, "total": 4, "max_limit": 100}},
+		"results": [
+			{"id": "type-1", "vcpu": "1", "ram": "2GB", "family_slug": "BV"},
+			{"id": "type-2", "vcpu": "4", "ram": "16GB", "family_slug": "BV"},
+			{"id": "type-3", "vcpu": "8", "ram": "64GB", "family_slug": "DP"},
+			{"id": "type-4", "vcpu": "n/a", "ram": "", "family_slug": "DP"}
+		]
+	}`
+
+	tests := []struct {
+		name       string
+		minVCPU    *int
+		minRAM     *int
+		familySlug *string
+		wantIDs    []string
+	}{
+		{name: "no filters", wantIDs: []string{"type-1", "type-2", "type-3", "type-4"}},
+		{name: "min vcpu", minVCPU: helpers.IntPtr(4), wantIDs: []string{"type-2", "type-3"}},
+		{name: "min ram", minRAM: helpers.IntPtr(32), wantIDs: []string{"type-3"}},
+		{name: "family slug", familySlug: helpers.StrPtr("DP"), wantIDs: []string{"type-3", "type-4"}},
+		{name: "combined", minRAM: helpers.IntPtr(16), familySlug: helpers.StrPtr("BV"), wantIDs: []string{"type-2"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				for _, param := range []string{"min_vcpu", "min_ram", "family_slug"} {
+					if r.URL.Query().Has(param) {
+						t.Errorf("unexpected query parameter %s", param)
+					}
+				}
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(http.StatusOK)
+				w.Write([]byte(response))
+			}))
+			defer server.Close()
+
+			client := testInstanceTypeClient(server.URL)
+
+			list, err := client.List(context.Background(), ListInstanceTypeOptions{
+				MinVCPU:    tt.minVCPU,
+				MinRAM:     tt.minRAM,
+				FamilySlug: tt.familySlug,
+			})
+			assertNoError(t, err)
+			assertEqual(t, len(tt.wantIDs), len(list.Results))
+			for i, it := range list.Results {
+				assertEqual(t, tt.wantIDs[i], it.ID)
+			}
+
+			all, err := client.ListAll(context.Background(), InstanceTypeFilterOptions{
+				MinVCPU:    tt.minVCPU,
+				MinRAM:     tt.minRAM,
+				FamilySlug: tt.familySlug,
+			})
+			assertNoError(t, err)
+			assertEqual(t, len(tt.wantIDs), len(all))
+			for i, it := range all {
+				assertEqual(t, tt.wantIDs[i], it.ID)
+			}
+		})
+	}
+}
+
+func TestIsCompatible(t *testing.T) {
+	assertEqual(t, true, IsCompatible("mysql8_id", InstanceType{EngineID: "mysql8_id"}))
+	assertEqual(t, false, IsCompatible("mysql8_id", InstanceType{EngineID: "postgresql16_id"}))
+	assertEqual(t, true, IsCompatible("mysql8_id", InstanceType{}))
+}
+
+func TestInstanceTypeService_ListCompatible(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		query := r.URL.Query()
+		assertEqual(t, "mysql8_id", query.Get("engine_id"))
+		assertEqual(t, "CLUSTER", query.Get("compatible_product"))
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{
+			"meta": {"total": 2},
+			"results": [
+				{"id": "type1_mysql8", "engine_id": "mysql8_id", "compatible_product": "CLUSTER"},
+				{"id": "type1_postgres16", "engine_id": "postgresql16_id", "compatible_product": "CLUSTER"}
+			]
+		}`))
+	}))
+	defer server.Close()
+
+	svc := testInstanceTypeClient(server.URL)
+	result, err := svc.ListCompatible(context.Background(), "mysql8_id", CompatibleProductCluster)
+
+	assertNoError(t, err)
+	assertEqual(t, 1, len(result))
+	assertEqual(t, "type1_mysql8", result[0].ID)
+}
+
+func TestInstanceTypeService_ValidateCompatibility(t *testing.T) {
+	tests := []struct {
+		name           string
+		engineID       string
+		instanceTypeID string
+		product        string
+		wantField      string
+	}{
+		{name: "compatible", engineID: "mysql8_id", instanceTypeID: "type1", product: CompatibleProductSingleInstance},
+		{name: "compatible without product", engineID: "mysql8_id", instanceTypeID: "type1"},
+		{name: "other engine", engineID: "postgresql16_id", instanceTypeID: "type1", wantField: "instance_type_id"},
+		{name: "other product", engineID: "mysql8_id", instanceTypeID: "type1", product: CompatibleProductCluster, wantField: "instance_type_id"},
+		{name: "empty engine", instanceTypeID: "type1", wantField: "engine_id"},
+		{name: "empty instance type", engineID: "mysql8_id", wantField: "instance_type_id"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assertEqual(t, "/database/v2/instance-types/type1", r.URL.Path)
+				w.Header().Set("Content-Type", "application/json")
+				w.Write([]byte(`{"id": "type1", "engine_id": "mysql8_id", "compatible_product": "SINGLE_INSTANCE"}`))
+			}))
+			defer server.Close()
+
+			svc := testInstanceTypeClient(server.URL)
+			err := svc.ValidateCompatibility(context.Background(), tt.engineID, tt.instanceTypeID, tt.product)
+
+			if tt.wantField == "" {
+				assertNoError(t, err)
+				return
+			}
+			var validationErr *client.ValidationError
+			if !errors.As(err, &validationErr) {
+				t.Fatalf("ValidateCompatibility() error = %v, want *client.ValidationError", err)
+			}
+			assertEqual(t, tt.wantField, validationErr.Field)
+		})
+	}
+}
+
+func TestInstanceTypeService_ListConditional(t *testing.T) {
+	const etag = `"catalog-v1"`
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("If-None-Match") == etag {
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("ETag", etag)
+		w.Write([]byte(`{"meta": {"page": {"offset": 0, "limit": 25, "count": 1, "total": 1, "max_limit": 100}}, "results": [{"id": "id1"}]}`))
+	}))
+	defer server.Close()
+
+	svc := testInstanceTypeClient(server.URL)
+	list, err := svc.List(context.Background(), ListInstanceTypeOptions{})
+	assertNoError(t, err)
+	assertEqual(t, etag, list.ETag)
+
+	_, err = svc.List(context.Background(), ListInstanceTypeOptions{IfNoneMatch: list.ETag})
+	assertEqual(t, true, client.IsNotModified(err))
+}
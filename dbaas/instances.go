@@ -241,6 +241,7 @@ type (
 		UpdateSnapshot(ctx context.Context, instanceID, snapshotID string, req SnapshotUpdateRequest) (*SnapshotDetailResponse, error)
 		DeleteSnapshot(ctx context.Context, instanceID, snapshotID string) error
 		RestoreSnapshot(ctx context.Context, instanceID, snapshotID string, req RestoreSnapshotRequest) (*InstanceResponse, error)
+		ListBackups(ctx context.Context, instanceID string) ([]Backup, error)
 	}
 
 	// instanceService implements the InstanceService interface
@@ -76,6 +76,7 @@ type (
 		Create(ctx context.Context, req ParameterGroupCreateRequest) (*ParameterGroupResponse, error)
 		Get(ctx context.Context, ID string) (*ParameterGroupDetailResponse, error)
 		Update(ctx context.Context, ID string, req ParameterGroupUpdateRequest) (*ParameterGroupDetailResponse, error)
+		Rename(ctx context.Context, ID string, newName string) error
 		Delete(ctx context.Context, ID string) error
 	}
 
@@ -198,6 +199,12 @@ func (s *parameterGroupService) Update(ctx context.Context, ID string, req Param
 	)
 }
 
+// Rename changes the name of a parameter group, keeping its description.
+func (s *parameterGroupService) Rename(ctx context.Context, ID string, newName string) error {
+	_, err := s.Update(ctx, ID, ParameterGroupUpdateRequest{Name: &newName})
+	return err
+}
+
 // Delete deletes a custom parameter group.
 func (s *parameterGroupService) Delete(ctx context.Context, ID string) error {
 	if ID == "" {
@@ -2,6 +2,7 @@ package dbaas
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"net/http"
 	"net/http/httptest"
@@ -468,6 +469,35 @@ func TestParameterGroupService_Update(t *testing.T) {
 	}
 }
 
+var _ helpers.Renamer = (ParameterGroupService)(nil)
+
+func TestParameterGroupService_Rename(t *testing.T) {
+	t.Parallel()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPatch || r.URL.Path != "/database/v2/parameter-groups/pg1" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		var body map[string]any
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Fatalf("error decoding request: %v", err)
+		}
+		if len(body) != 1 || body["name"] != "renamed" {
+			t.Errorf("request body = %v, want only the name", body)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"id": "pg1", "name": "renamed", "type": "USER", "engine_id": "eng1"}`))
+	}))
+	defer server.Close()
+
+	svc := testClientParamerts(server.URL)
+	if err := svc.Rename(context.Background(), "pg1", "renamed"); err != nil {
+		t.Errorf("Rename() error = %v", err)
+	}
+	if err := svc.Rename(context.Background(), "", "renamed"); err == nil {
+		t.Error("Rename() expected error for empty ID")
+	}
+}
+
 func TestParameterGroupService_Delete(t *testing.T) {
 	t.Parallel()
 	tests := []struct {
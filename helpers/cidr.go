@@ -0,0 +1,20 @@
+package helpers
+
+import (
+	"fmt"
+	"net/netip"
+)
+
+// ValidateCIDR checks that s is an IPv4 or IPv6 network in CIDR notation,
+// such as "192.168.0.0/24" or "2001:db8::/64". Addresses with host bits set
+// beyond the prefix length, like "192.168.0.1/24", are rejected as well.
+func ValidateCIDR(s string) error {
+	prefix, err := netip.ParsePrefix(s)
+	if err != nil {
+		return fmt.Errorf("invalid CIDR %q: expected an IPv4 or IPv6 network such as 192.168.0.0/24", s)
+	}
+	if masked := prefix.Masked(); masked != prefix {
+		return fmt.Errorf("invalid CIDR %q: host bits are set, did you mean %s?", s, masked)
+	}
+	return nil
+}
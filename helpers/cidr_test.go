@@ -0,0 +1,39 @@
+package helpers
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestValidateCIDR(t *testing.T) {
+	tests := []struct {
+		cidr    string
+		wantErr string
+	}{
+		{cidr: "192.168.0.0/24"},
+		{cidr: "0.0.0.0/0"},
+		{cidr: "10.0.0.5/32"},
+		{cidr: "2001:db8::/64"},
+		{cidr: "", wantErr: "expected an IPv4 or IPv6 network"},
+		{cidr: "192.168.0.0", wantErr: "expected an IPv4 or IPv6 network"},
+		{cidr: "192.168.0.0/33", wantErr: "expected an IPv4 or IPv6 network"},
+		{cidr: "not-a-cidr", wantErr: "expected an IPv4 or IPv6 network"},
+		{cidr: "192.168.0.1/24", wantErr: "did you mean 192.168.0.0/24?"},
+		{cidr: "2001:db8::1/64", wantErr: "did you mean 2001:db8::/64?"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.cidr, func(t *testing.T) {
+			err := ValidateCIDR(tt.cidr)
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Errorf("ValidateCIDR(%q) error = %v", tt.cidr, err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("ValidateCIDR(%q) error = %v, want containing %q", tt.cidr, err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,50 @@
+package helpers
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/MagaluCloud/mgc-sdk-go/client"
+)
+
+// ExpandValue is implemented by the typed expand options of each service,
+// such as compute.InstanceExpand.
+type ExpandValue interface {
+	~string
+	IsValid() bool
+}
+
+// JoinExpand validates expand values and joins them into the comma separated
+// form sent in the expand query parameter. Unknown values return a
+// ValidationError instead of being silently ignored by the server.
+func JoinExpand[T ExpandValue](values []T) (string, error) {
+	parts := make([]string, len(values))
+	for i, v := range values {
+		if !v.IsValid() {
+			return "", &client.ValidationError{Field: "expand", Message: fmt.Sprintf("unknown value %q", string(v))}
+		}
+		parts[i] = string(v)
+	}
+	return strings.Join(parts, ","), nil
+}
+
+// MergeExpand returns defaults followed by the values not already in
+// defaults, so per-call expands extend a client's default expands. It returns
+// values unchanged when there are no defaults.
+func MergeExpand[T ExpandValue](defaults, values []T) []T {
+	if len(defaults) == 0 {
+		return values
+	}
+
+	merged := make([]T, 0, len(defaults)+len(values))
+	seen := make(map[T]bool, len(defaults)+len(values))
+	for _, list := range [][]T{defaults, values} {
+		for _, v := range list {
+			if !seen[v] {
+				seen[v] = true
+				merged = append(merged, v)
+			}
+		}
+	}
+	return merged
+}
@@ -0,0 +1,69 @@
+package helpers
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/MagaluCloud/mgc-sdk-go/client"
+)
+
+type testExpand string
+
+func (e testExpand) IsValid() bool {
+	return e == "a" || e == "b"
+}
+
+func TestJoinExpand(t *testing.T) {
+	tests := []struct {
+		name    string
+		values  []testExpand
+		want    string
+		wantErr bool
+	}{
+		{name: "empty", values: nil, want: ""},
+		{name: "single", values: []testExpand{"a"}, want: "a"},
+		{name: "multiple", values: []testExpand{"a", "b"}, want: "a,b"},
+		{name: "unknown value", values: []testExpand{"a", "c"}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := JoinExpand(tt.values)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("JoinExpand() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				if _, ok := err.(*client.ValidationError); !ok {
+					t.Errorf("JoinExpand() error = %T, want *client.ValidationError", err)
+				}
+				return
+			}
+			if got != tt.want {
+				t.Errorf("JoinExpand() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMergeExpand(t *testing.T) {
+	tests := []struct {
+		name     string
+		defaults []testExpand
+		values   []testExpand
+		want     []testExpand
+	}{
+		{name: "no defaults", values: []testExpand{"b"}, want: []testExpand{"b"}},
+		{name: "defaults only", defaults: []testExpand{"a"}, want: []testExpand{"a"}},
+		{name: "extends defaults", defaults: []testExpand{"a"}, values: []testExpand{"b"}, want: []testExpand{"a", "b"}},
+		{name: "drops duplicates", defaults: []testExpand{"a", "b"}, values: []testExpand{"b", "a"}, want: []testExpand{"a", "b"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := MergeExpand(tt.defaults, tt.values)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("MergeExpand() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
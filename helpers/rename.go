@@ -0,0 +1,10 @@
+package helpers
+
+import "context"
+
+// Renamer is implemented by every service whose resources have a display name
+// that can be changed after creation, so tooling can rename resources without
+// knowing the service behind them.
+type Renamer interface {
+	Rename(ctx context.Context, id string, newName string) error
+}
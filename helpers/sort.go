@@ -0,0 +1,25 @@
+package helpers
+
+// SortOrder is the direction of a sorted listing.
+type SortOrder string
+
+const (
+	SortAscending  SortOrder = "asc"
+	SortDescending SortOrder = "desc"
+)
+
+// IsValid reports whether the sort order is one of the known values.
+func (o SortOrder) IsValid() bool {
+	return o == SortAscending || o == SortDescending
+}
+
+// SortBy formats the "field:order" expression expected by the Sort option of
+// list requests. An unknown order falls back to SortAscending, which is the
+// API default, rather than producing a value the server would ignore.
+func SortBy(field string, order SortOrder) *string {
+	if !order.IsValid() {
+		order = SortAscending
+	}
+	sort := field + ":" + string(order)
+	return &sort
+}
@@ -0,0 +1,25 @@
+package helpers
+
+import "testing"
+
+func TestSortBy(t *testing.T) {
+	tests := []struct {
+		name  string
+		field string
+		order SortOrder
+		want  string
+	}{
+		{name: "ascending", field: "name", order: SortAscending, want: "name:asc"},
+		{name: "descending", field: "created_at", order: SortDescending, want: "created_at:desc"},
+		{name: "unknown order", field: "name", order: SortOrder("down"), want: "name:asc"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := SortBy(tt.field, tt.order)
+			if got == nil || *got != tt.want {
+				t.Errorf("SortBy() = %v, want %s", got, tt.want)
+			}
+		})
+	}
+}
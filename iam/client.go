@@ -47,6 +47,12 @@ func New(core *client.CoreClient, opts ...ClientOption) *IAMClient {
 	return iamClient
 }
 
+// NewDefault creates a new instance of IAMClient using the client installed
+// with client.SetDefault. If no default client is set, returns nil.
+func NewDefault(opts ...ClientOption) *IAMClient {
+	return New(client.Default(), opts...)
+}
+
 // newRequest creates a new HTTP request for the IAM API
 func (c *IAMClient) newRequest(ctx context.Context, method, path string, body any) (*http.Request, error) {
 	return mgc_http.NewRequest(c.GetConfig(), ctx, method, DefaultBasePath+path, &body)
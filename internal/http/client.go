@@ -86,6 +86,10 @@ func NewRequest[T any](c *client.Config, ctx context.Context, method, path strin
 		}
 	}
 
+	if label := client.OperationLabelFromContext(ctx); label != "" && c.OperationLabelHeader != "" {
+		req.Header.Set(c.OperationLabelHeader, label)
+	}
+
 	return req, nil
 }
 
@@ -94,7 +98,12 @@ func NewRequest[T any](c *client.Config, ctx context.Context, method, path strin
 // Returns the parsed response and an error if the request fails,
 // the response status is not 2xx, or if there are JSON decoding issues.
 func Do[T any](c *client.Config, ctx context.Context, req *http.Request, v *T) (*T, error) {
-	c.Logger.Debug("starting request execution",
+	logger := c.Logger
+	if label := client.OperationLabelFromContext(ctx); label != "" {
+		logger = logger.With("operationLabel", label)
+	}
+
+	logger.Debug("starting request execution",
 		"method", req.Method,
 		"url", req.URL.String(),
 		"expectResponse", v != nil)
@@ -119,10 +128,21 @@ func Do[T any](c *client.Config, ctx context.Context, req *http.Request, v *T) (
 		defer cancel()
 	}
 
+	shouldRetry := c.RetryPolicy
+	if shouldRetry == nil {
+		shouldRetry = client.DefaultRetryPolicy
+	}
+
 	var lastError error
 	for attempt := range c.RetryConfig.MaxAttempts {
 		if attempt > 0 {
 			backoff := retry.GetNextBackoff(attempt-1, c.RetryConfig.BackoffFactor, c.RetryConfig.InitialInterval, c.RetryConfig.MaxInterval)
+			switch c.RetryJitter {
+			case client.FullJitter:
+				backoff = retry.FullJitter(backoff)
+			case client.EqualJitter:
+				backoff = retry.EqualJitter(backoff)
+			}
 			timer := time.NewTimer(backoff)
 			select {
 			case <-ctx.Done():
@@ -132,45 +152,64 @@ func Do[T any](c *client.Config, ctx context.Context, req *http.Request, v *T) (
 			}
 		}
 
+		if c.CircuitBreaker != nil {
+			if err := c.CircuitBreaker.Allow(); err != nil {
+				return nil, err
+			}
+		}
+
 		clonedReq := req.Clone(ctx)
 		if len(bodyBytes) > 0 {
 			clonedReq.Body = io.NopCloser(bytes.NewReader(bodyBytes))
 		}
+		setConditionalHeader(ctx, clonedReq)
 
-		c.Logger.Info("making request",
+		logger.Info("making request",
 			"method", clonedReq.Method,
 			"url", clonedReq.URL.String(),
 			"attempt", attempt+1)
 
+		start := time.Now()
 		resp, err := c.HTTPClient.Do(clonedReq)
+		if c.Metrics != nil {
+			recordMetrics(c, ctx, clonedReq, resp, err, time.Since(start))
+		}
+		if c.CircuitBreaker != nil && ctx.Err() == nil {
+			c.CircuitBreaker.Record(err == nil && resp.StatusCode < http.StatusInternalServerError)
+		}
 		if err != nil {
 			lastError = err
+			if !shouldRetry(clonedReq, nil, err) {
+				return nil, err
+			}
 			continue
 		}
 
 		defer resp.Body.Close()
 
 		if xRequestID := resp.Header.Get("X-Request-ID"); xRequestID != "" {
-			c.Logger.Info("X-Request-ID received in response", "requestID", xRequestID)
+			logger.Info("X-Request-ID received in response", "requestID", xRequestID)
 		} else {
-			c.Logger.Info("X-Request-ID not found in response")
+			logger.Info("X-Request-ID not found in response")
 		}
 
 		if xTraceID := resp.Header.Get("X-Mgc-Trace-Id"); xTraceID != "" {
-			c.Logger.Info("X-Mgc-Trace-ID received in response", "mgcTraceID", xTraceID)
+			logger.Info("X-Mgc-Trace-ID received in response", "mgcTraceID", xTraceID)
 		} else {
-			c.Logger.Info("X-Mgc-Trace-ID not found in response")
+			logger.Info("X-Mgc-Trace-ID not found in response")
 		}
 
 		if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
 			lastError = client.NewHTTPError(resp)
 
-			if !retry.ShouldRetry(resp.StatusCode) {
+			if !shouldRetry(clonedReq, resp, nil) {
 				return nil, lastError
 			}
 			continue
 		}
 
+		recordETag(ctx, resp)
+
 		if v != nil && resp.StatusCode != http.StatusNoContent {
 			ct := resp.Header.Get("Content-Type")
 			if strings.Contains(ct, "application/x-yaml") || strings.Contains(ct, "application/yaml") {
@@ -186,6 +225,35 @@ func Do[T any](c *client.Config, ctx context.Context, req *http.Request, v *T) (
 	return nil, &client.RetryError{LastError: lastError, Retries: c.RetryConfig.MaxAttempts}
 }
 
+// recordMetrics reports a single attempt to the configured metrics recorder.
+// A 304 answering a conditional request is a successful outcome, not an error.
+func recordMetrics(c *client.Config, ctx context.Context, req *http.Request, resp *http.Response, err error, duration time.Duration) {
+	m := client.RequestMetrics{
+		Service:   serviceFromRequest(c, req),
+		Operation: req.Method,
+		Duration:  duration,
+		Err:       err,
+	}
+	if resp != nil {
+		m.StatusCode = resp.StatusCode
+		notModified := resp.StatusCode == http.StatusNotModified && conditionalFromContext(ctx) != nil
+		if (resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices) && !notModified {
+			m.Err = &client.HTTPError{StatusCode: resp.StatusCode, Status: resp.Status}
+		}
+	}
+	c.Metrics.ObserveRequest(m)
+}
+
+// serviceFromRequest returns the first path segment after the base URL, such as "compute"
+func serviceFromRequest(c *client.Config, req *http.Request) string {
+	path := req.URL.Path
+	if base, err := url.Parse(c.BaseURL.String()); err == nil {
+		path = strings.TrimPrefix(path, strings.TrimSuffix(base.Path, "/"))
+	}
+	service, _, _ := strings.Cut(strings.TrimPrefix(path, "/"), "/")
+	return service
+}
+
 func decodeYamlResponse[T any](resp *http.Response, v *T) (*T, error) {
 	body, err := io.ReadAll(resp.Body)
 	if err != nil {
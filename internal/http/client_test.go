@@ -3,9 +3,11 @@ package mgc_http
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"log/slog"
+	"net"
 	"net/http"
 	"net/http/httptest"
 	"net/url"
@@ -1365,6 +1367,8 @@ func TestRetryPreservesRequestBody(t *testing.T) {
 	}
 
 	req.Header.Set("Content-Type", "application/json")
+	// POST is only retried on a bare 503 when the request is marked idempotent.
+	req.Header.Set(client.IdempotencyKeyHeader, "retry-body-test")
 
 	var response mockResponse
 	_, err = Do(ct.GetConfig(), context.Background(), req, &response)
@@ -1482,3 +1486,257 @@ func TestNewRequest_Authentication(t *testing.T) {
 		})
 	}
 }
+
+func TestDo_RetryPolicy(t *testing.T) {
+	tests := []struct {
+		name         string
+		method       string
+		headers      map[string]string
+		status       int
+		retryAfter   string
+		policy       client.RetryPolicy
+		wantAttempts int
+	}{
+		{name: "GET retried on 500", method: http.MethodGet, status: http.StatusInternalServerError, wantAttempts: 3},
+		{name: "DELETE retried on 502", method: http.MethodDelete, status: http.StatusBadGateway, wantAttempts: 3},
+		{name: "POST not retried on 500", method: http.MethodPost, status: http.StatusInternalServerError, wantAttempts: 1},
+		{name: "PATCH not retried on 503 without Retry-After", method: http.MethodPatch, status: http.StatusServiceUnavailable, wantAttempts: 1},
+		{name: "POST retried on 503 with Retry-After", method: http.MethodPost, status: http.StatusServiceUnavailable, retryAfter: "1", wantAttempts: 3},
+		{name: "POST retried on 429 with Retry-After", method: http.MethodPost, status: http.StatusTooManyRequests, retryAfter: "1", wantAttempts: 3},
+		{
+			name:         "POST with idempotency key retried on 500",
+			method:       http.MethodPost,
+			headers:      map[string]string{client.IdempotencyKeyHeader: "key-1"},
+			status:       http.StatusInternalServerError,
+			wantAttempts: 3,
+		},
+		{
+			name:         "custom policy overrides default",
+			method:       http.MethodPost,
+			status:       http.StatusInternalServerError,
+			policy:       func(req *http.Request, resp *http.Response, err error) bool { return true },
+			wantAttempts: 3,
+		},
+		{
+			name:         "custom policy can disable retries",
+			method:       http.MethodGet,
+			status:       http.StatusInternalServerError,
+			policy:       func(req *http.Request, resp *http.Response, err error) bool { return false },
+			wantAttempts: 1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			attempts := 0
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				attempts++
+				if tt.retryAfter != "" {
+					w.Header().Set("Retry-After", tt.retryAfter)
+				}
+				w.WriteHeader(tt.status)
+			}))
+			defer server.Close()
+
+			opts := []client.Option{
+				client.WithAPIKey("test-api-key"),
+				client.WithBaseURL(client.MgcUrl(server.URL)),
+				client.WithRetryConfig(3, time.Millisecond, 5*time.Millisecond, 1.5),
+			}
+			if tt.policy != nil {
+				opts = append(opts, client.WithRetryPolicy(tt.policy))
+			}
+			cfg := client.NewMgcClient(opts...).GetConfig()
+
+			req, err := http.NewRequestWithContext(context.Background(), tt.method, server.URL+"/test", nil)
+			if err != nil {
+				t.Fatalf("Failed to create request: %v", err)
+			}
+			for k, v := range tt.headers {
+				req.Header.Set(k, v)
+			}
+
+			_, err = Do[any](cfg, context.Background(), req, nil)
+			if err == nil {
+				t.Fatal("expected error, got nil")
+			}
+			if attempts != tt.wantAttempts {
+				t.Errorf("expected %d attempts, got %d", tt.wantAttempts, attempts)
+			}
+		})
+	}
+}
+
+func TestDo_RetryPolicyConnectionErrors(t *testing.T) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("failed to listen: %v", err)
+	}
+	addr := listener.Addr().String()
+	listener.Close()
+
+	var calls int
+	policy := func(req *http.Request, resp *http.Response, err error) bool {
+		calls++
+		return client.DefaultRetryPolicy(req, resp, err)
+	}
+	cfg := client.NewMgcClient(
+		client.WithAPIKey("test-api-key"),
+		client.WithRetryConfig(3, time.Millisecond, 5*time.Millisecond, 1.5),
+		client.WithRetryPolicy(policy),
+	).GetConfig()
+
+	req, _ := http.NewRequestWithContext(context.Background(), http.MethodPost, "http://"+addr+"/test", nil)
+	_, err = Do[any](cfg, context.Background(), req, nil)
+
+	var retryErr *client.RetryError
+	if !errors.As(err, &retryErr) {
+		t.Fatalf("expected RetryError after refused connections, got %v", err)
+	}
+	if calls != 3 {
+		t.Errorf("expected policy to be consulted 3 times, got %d", calls)
+	}
+}
+
+func TestNewRequest_OperationLabel(t *testing.T) {
+	tests := []struct {
+		name       string
+		headerName string
+		label      string
+		wantHeader string
+	}{
+		{name: "label with header configured", headerName: "X-Operation", label: "nightly-backup", wantHeader: "nightly-backup"},
+		{name: "label without header configured", label: "nightly-backup"},
+		{name: "header configured without label", headerName: "X-Operation"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := client.NewMgcClient(client.WithOperationLabelHeader(tt.headerName)).GetConfig()
+			ctx := context.Background()
+			if tt.label != "" {
+				ctx = client.WithOperationLabel(ctx, tt.label)
+			}
+
+			req, err := NewRequest[any](cfg, ctx, http.MethodGet, "/test", nil)
+			if err != nil {
+				t.Fatalf("NewRequest() error = %v", err)
+			}
+			if got := req.Header.Get("X-Operation"); got != tt.wantHeader {
+				t.Errorf("X-Operation = %q, want %q", got, tt.wantHeader)
+			}
+		})
+	}
+}
+
+func TestDo_OperationLabelLogged(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	var buf strings.Builder
+	logger := slog.New(slog.NewTextHandler(&buf, &slog.HandlerOptions{Level: slog.LevelDebug}))
+	cfg := client.NewMgcClient(client.WithLogger(logger)).GetConfig()
+
+	ctx := client.WithOperationLabel(context.Background(), "nightly-backup")
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, server.URL, nil)
+	if err != nil {
+		t.Fatalf("failed to create request: %v", err)
+	}
+
+	if _, err := Do[any](cfg, ctx, req, nil); err != nil {
+		t.Fatalf("Do() error = %v", err)
+	}
+	if !strings.Contains(buf.String(), "operationLabel=nightly-backup") {
+		t.Errorf("expected operation label in logs, got %q", buf.String())
+	}
+}
+
+func TestDo_CircuitBreaker(t *testing.T) {
+	calls := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer server.Close()
+
+	cfg := client.NewMgcClient(
+		client.WithRetryConfig(1, time.Millisecond, time.Millisecond, 1),
+		client.WithCircuitBreaker(client.CircuitBreakerSettings{FailureThreshold: 2, Cooldown: time.Hour}),
+	).GetConfig()
+
+	for range 2 {
+		req, _ := http.NewRequest(http.MethodGet, server.URL, nil)
+		if _, err := Do[any](cfg, context.Background(), req, nil); err == nil {
+			t.Fatal("expected error from failing server")
+		}
+	}
+
+	if got := cfg.CircuitBreaker.State(); got != client.CircuitOpen {
+		t.Fatalf("State() = %v, want %v", got, client.CircuitOpen)
+	}
+
+	req, _ := http.NewRequest(http.MethodGet, server.URL, nil)
+	if _, err := Do[any](cfg, context.Background(), req, nil); !errors.Is(err, client.ErrCircuitOpen) {
+		t.Fatalf("Do() error = %v, want ErrCircuitOpen", err)
+	}
+	if calls != 2 {
+		t.Errorf("expected open circuit to skip the request, got %d calls", calls)
+	}
+}
+
+type fakeMetricsRecorder struct {
+	observed []client.RequestMetrics
+}
+
+func (f *fakeMetricsRecorder) ObserveRequest(m client.RequestMetrics) {
+	f.observed = append(f.observed, m)
+}
+
+func TestDo_Metrics(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer server.Close()
+
+	recorder := &fakeMetricsRecorder{}
+	cfg := client.NewMgcClient(
+		client.WithBaseURL(client.MgcUrl(server.URL+"/br-se1")),
+		client.WithMetrics(recorder),
+	).GetConfig()
+
+	ctx := client.WithOperationLabel(context.Background(), "nightly-backup")
+	req, err := NewRequest[any](cfg, ctx, http.MethodGet, "/compute/v1/instances", nil)
+	if err != nil {
+		t.Fatalf("NewRequest() error = %v", err)
+	}
+	if _, err := Do[any](cfg, ctx, req, nil); err == nil {
+		t.Fatal("expected error for 404 response")
+	}
+
+	if len(recorder.observed) != 1 {
+		t.Fatalf("expected 1 observation, got %d", len(recorder.observed))
+	}
+	m := recorder.observed[0]
+	if m.Service != "compute" || m.Operation != http.MethodGet || m.StatusCode != http.StatusNotFound || m.Err == nil {
+		t.Errorf("unexpected metrics %+v", m)
+	}
+}
+
+func TestDo_ResourceBusy(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusConflict)
+		w.Write([]byte(`{"message": "instance has an operation in progress"}`))
+	}))
+	defer server.Close()
+
+	req, _ := http.NewRequest(http.MethodPost, server.URL, nil)
+	_, err := Do[any](client.NewMgcClient().GetConfig(), context.Background(), req, nil)
+	if !client.IsResourceBusy(err) {
+		t.Fatalf("Do() error = %v, want a busy resource", err)
+	}
+	if httpErr, ok := err.(*client.HTTPError); !ok || httpErr.StatusCode != http.StatusConflict {
+		t.Errorf("Do() error = %T, want the 409 *client.HTTPError", err)
+	}
+}
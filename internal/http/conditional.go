@@ -0,0 +1,42 @@
+package mgc_http
+
+import (
+	"context"
+	"net/http"
+)
+
+type conditionalKey struct{}
+
+// Conditional carries the ETag of a conditional GET. IfNoneMatch is sent in the
+// If-None-Match header and ETag receives the ETag of a successful response.
+type Conditional struct {
+	IfNoneMatch string
+	ETag        string
+}
+
+// WithConditional returns a context that makes Do send cond.IfNoneMatch and
+// record the response ETag in cond.
+func WithConditional(ctx context.Context, cond *Conditional) context.Context {
+	return context.WithValue(ctx, conditionalKey{}, cond)
+}
+
+// conditionalFromContext returns the Conditional set by WithConditional, or nil.
+func conditionalFromContext(ctx context.Context) *Conditional {
+	cond, _ := ctx.Value(conditionalKey{}).(*Conditional)
+	return cond
+}
+
+// setConditionalHeader adds If-None-Match to req when a Conditional with an
+// ETag is attached to ctx.
+func setConditionalHeader(ctx context.Context, req *http.Request) {
+	if cond := conditionalFromContext(ctx); cond != nil && cond.IfNoneMatch != "" {
+		req.Header.Set("If-None-Match", cond.IfNoneMatch)
+	}
+}
+
+// recordETag stores the ETag of resp in the Conditional attached to ctx.
+func recordETag(ctx context.Context, resp *http.Response) {
+	if cond := conditionalFromContext(ctx); cond != nil {
+		cond.ETag = resp.Header.Get("ETag")
+	}
+}
@@ -0,0 +1,97 @@
+package mgc_http
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/MagaluCloud/mgc-sdk-go/client"
+)
+
+func TestDo_Conditional(t *testing.T) {
+	const etag = `"v1"`
+	calls := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		if r.Header.Get("If-None-Match") == etag {
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		w.Header().Set("ETag", etag)
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"name": "catalog"}`))
+	}))
+	defer server.Close()
+
+	cfg := client.NewMgcClient().GetConfig()
+	type catalog struct {
+		Name string `json:"name"`
+	}
+
+	cond := &Conditional{}
+	req, _ := http.NewRequest(http.MethodGet, server.URL, nil)
+	got, err := Do(cfg, WithConditional(context.Background(), cond), req, &catalog{})
+	if err != nil {
+		t.Fatalf("Do() error = %v", err)
+	}
+	if got.Name != "catalog" || cond.ETag != etag {
+		t.Errorf("Do() = %+v with ETag %q, want catalog with ETag %q", got, cond.ETag, etag)
+	}
+
+	cond = &Conditional{IfNoneMatch: etag}
+	req, _ = http.NewRequest(http.MethodGet, server.URL, nil)
+	_, err = Do(cfg, WithConditional(context.Background(), cond), req, &catalog{})
+	if !client.IsNotModified(err) {
+		t.Fatalf("Do() error = %v, want not modified", err)
+	}
+	if calls != 2 {
+		t.Errorf("expected 304 not to be retried, got %d calls", calls)
+	}
+}
+
+func TestDo_WithoutConditional(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if got := r.Header.Get("If-None-Match"); got != "" {
+			t.Errorf("If-None-Match = %q, want none", got)
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	req, _ := http.NewRequest(http.MethodGet, server.URL, nil)
+	if _, err := Do[any](client.NewMgcClient().GetConfig(), WithConditional(context.Background(), &Conditional{}), req, nil); err != nil {
+		t.Fatalf("Do() error = %v", err)
+	}
+}
+
+func TestDo_ConditionalMetrics(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotModified)
+	}))
+	defer server.Close()
+
+	recorder := &fakeMetricsRecorder{}
+	cfg := client.NewMgcClient(client.WithMetrics(recorder)).GetConfig()
+
+	req, _ := http.NewRequest(http.MethodGet, server.URL, nil)
+	cond := &Conditional{IfNoneMatch: `"v1"`}
+	if _, err := Do[any](cfg, WithConditional(context.Background(), cond), req, nil); !client.IsNotModified(err) {
+		t.Fatalf("Do() error = %v, want not modified", err)
+	}
+
+	req, _ = http.NewRequest(http.MethodGet, server.URL, nil)
+	if _, err := Do[any](cfg, context.Background(), req, nil); err == nil {
+		t.Fatal("Do() expected error for an unconditional 304")
+	}
+
+	if len(recorder.observed) != 2 {
+		t.Fatalf("expected 2 observations, got %d", len(recorder.observed))
+	}
+	if m := recorder.observed[0]; m.StatusCode != http.StatusNotModified || m.Err != nil {
+		t.Errorf("conditional 304 recorded as %+v, want no error", m)
+	}
+	if m := recorder.observed[1]; m.Err == nil {
+		t.Errorf("unconditional 304 recorded as %+v, want an error", m)
+	}
+}
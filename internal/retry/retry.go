@@ -2,6 +2,7 @@ package retry
 
 import (
 	"math"
+	"math/rand/v2"
 	"time"
 )
 
@@ -13,3 +14,20 @@ func GetNextBackoff(attempt int, backoffFactor float64, initialInterval, maxInte
 	multiplier := math.Pow(backoffFactor, float64(attempt))
 	return min(initialInterval*time.Duration(multiplier), maxInterval)
 }
+
+// FullJitter returns a random duration between zero and d.
+func FullJitter(d time.Duration) time.Duration {
+	if d <= 0 {
+		return 0
+	}
+	return rand.N(d + 1)
+}
+
+// EqualJitter returns a random duration between d/2 and d.
+func EqualJitter(d time.Duration) time.Duration {
+	if d <= 0 {
+		return 0
+	}
+	half := d / 2
+	return half + rand.N(d-half+1)
+}
@@ -84,3 +84,40 @@ func Test_getNextBackoff(t *testing.T) {
 		})
 	}
 }
+
+func TestJitter(t *testing.T) {
+	const backoff = 10 * time.Second
+	tests := []struct {
+		name   string
+		jitter func(time.Duration) time.Duration
+		min    time.Duration
+	}{
+		{"full jitter", FullJitter, 0},
+		{"equal jitter", EqualJitter, backoff / 2},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			seen := make(map[time.Duration]bool)
+			for range 100 {
+				d := tt.jitter(backoff)
+				if d < tt.min || d > backoff {
+					t.Fatalf("delay %v out of bounds [%v, %v]", d, tt.min, backoff)
+				}
+				seen[d] = true
+			}
+			if len(seen) < 2 {
+				t.Errorf("expected successive delays to vary, got %d distinct values", len(seen))
+			}
+		})
+	}
+}
+
+func TestJitter_NonPositive(t *testing.T) {
+	if got := FullJitter(0); got != 0 {
+		t.Errorf("FullJitter(0) = %v, want 0", got)
+	}
+	if got := EqualJitter(-time.Second); got != 0 {
+		t.Errorf("EqualJitter(-1s) = %v, want 0", got)
+	}
+}
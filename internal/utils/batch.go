@@ -0,0 +1,71 @@
+package utils
+
+import (
+	"context"
+	"fmt"
+	"sync"
+)
+
+// DefaultGetManyConcurrency is the number of concurrent requests made by GetMany.
+const DefaultGetManyConcurrency = 10
+
+// GetMany calls get for every distinct ID with at most concurrency requests in
+// flight. It returns the resources that were fetched, keyed by ID, and one
+// error per failed ID in the order the IDs were given. Each error is prefixed
+// with its ID and wraps the error returned by get.
+func GetMany[T any](ctx context.Context, ids []string, concurrency int, get func(ctx context.Context, id string) (*T, error)) (map[string]*T, []error) {
+	if concurrency <= 0 {
+		concurrency = DefaultGetManyConcurrency
+	}
+
+	unique := make([]string, 0, len(ids))
+	seen := make(map[string]struct{}, len(ids))
+	for _, id := range ids {
+		if _, ok := seen[id]; ok {
+			continue
+		}
+		seen[id] = struct{}{}
+		unique = append(unique, id)
+	}
+
+	results := make(map[string]*T, len(unique))
+	errs := make([]error, len(unique))
+
+	var (
+		mu  sync.Mutex
+		wg  sync.WaitGroup
+		sem = make(chan struct{}, concurrency)
+	)
+	for i, id := range unique {
+		wg.Add(1)
+		go func(i int, id string) {
+			defer wg.Done()
+
+			select {
+			case sem <- struct{}{}:
+				defer func() { <-sem }()
+			case <-ctx.Done():
+				errs[i] = fmt.Errorf("%s: %w", id, ctx.Err())
+				return
+			}
+
+			resource, err := get(ctx, id)
+			if err != nil {
+				errs[i] = fmt.Errorf("%s: %w", id, err)
+				return
+			}
+			mu.Lock()
+			results[id] = resource
+			mu.Unlock()
+		}(i, id)
+	}
+	wg.Wait()
+
+	var failed []error
+	for _, err := range errs {
+		if err != nil {
+			failed = append(failed, err)
+		}
+	}
+	return results, failed
+}
@@ -0,0 +1,92 @@
+package utils
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestGetMany(t *testing.T) {
+	errNotFound := errors.New("not found")
+	var calls, inFlight, maxInFlight int32
+
+	get := func(ctx context.Context, id string) (*string, error) {
+		atomic.AddInt32(&calls, 1)
+		current := atomic.AddInt32(&inFlight, 1)
+		defer atomic.AddInt32(&inFlight, -1)
+		for {
+			previous := atomic.LoadInt32(&maxInFlight)
+			if current <= previous || atomic.CompareAndSwapInt32(&maxInFlight, previous, current) {
+				break
+			}
+		}
+		time.Sleep(5 * time.Millisecond)
+
+		if strings.HasPrefix(id, "missing") {
+			return nil, errNotFound
+		}
+		value := "value-" + id
+		return &value, nil
+	}
+
+	ids := []string{"a", "missing-1", "b", "a", "c", "missing-2", "d"}
+	results, errs := GetMany(context.Background(), ids, 2, get)
+
+	if calls != 6 {
+		t.Errorf("GetMany() made %d calls, want 6 (duplicates skipped)", calls)
+	}
+	if maxInFlight > 2 {
+		t.Errorf("GetMany() had %d requests in flight, want at most 2", maxInFlight)
+	}
+	if len(results) != 4 {
+		t.Fatalf("GetMany() got %d results, want 4", len(results))
+	}
+	if *results["c"] != "value-c" {
+		t.Errorf("GetMany() results[c] = %s, want value-c", *results["c"])
+	}
+	if len(errs) != 2 {
+		t.Fatalf("GetMany() got %d errors, want 2", len(errs))
+	}
+	for i, want := range []string{"missing-1", "missing-2"} {
+		if !errors.Is(errs[i], errNotFound) {
+			t.Errorf("GetMany() errs[%d] = %v, want wrapped not found", i, errs[i])
+		}
+		if !strings.HasPrefix(errs[i].Error(), want+":") {
+			t.Errorf("GetMany() errs[%d] = %q, want prefix %q", i, errs[i], want)
+		}
+	}
+}
+
+func TestGetMany_CanceledContext(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	results, errs := GetMany(ctx, []string{"a", "b"}, 1, func(ctx context.Context, id string) (*string, error) {
+		return nil, ctx.Err()
+	})
+
+	if len(results) != 0 {
+		t.Errorf("GetMany() got %d results, want 0", len(results))
+	}
+	if len(errs) != 2 {
+		t.Fatalf("GetMany() got %d errors, want 2", len(errs))
+	}
+	for _, err := range errs {
+		if !errors.Is(err, context.Canceled) {
+			t.Errorf("GetMany() error = %v, want context.Canceled", err)
+		}
+	}
+}
+
+func TestGetMany_Empty(t *testing.T) {
+	results, errs := GetMany(context.Background(), nil, 0, func(ctx context.Context, id string) (*string, error) {
+		t.Fatal("get should not be called")
+		return nil, nil
+	})
+	if len(results) != 0 || len(errs) != 0 {
+		t.Errorf("GetMany() = %v, %v; want empty", results, errs)
+	}
+}
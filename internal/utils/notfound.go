@@ -0,0 +1,15 @@
+package utils
+
+import "github.com/MagaluCloud/mgc-sdk-go/client"
+
+// NilIfNotFound turns the result of a Get call into an existence check: a 404
+// error becomes (nil, nil), while any other error is returned unchanged.
+func NilIfNotFound[T any](v *T, err error) (*T, error) {
+	if client.IsNotFound(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return v, nil
+}
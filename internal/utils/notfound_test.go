@@ -0,0 +1,30 @@
+package utils
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/MagaluCloud/mgc-sdk-go/client"
+)
+
+func TestNilIfNotFound(t *testing.T) {
+	value := "found"
+
+	got, err := NilIfNotFound(&value, nil)
+	if err != nil || got != &value {
+		t.Errorf("NilIfNotFound() = (%v, %v), want the value and no error", got, err)
+	}
+
+	got, err = NilIfNotFound[string](nil, fmt.Errorf("get: %w", &client.HTTPError{StatusCode: http.StatusNotFound}))
+	if err != nil || got != nil {
+		t.Errorf("NilIfNotFound() on 404 = (%v, %v), want (nil, nil)", got, err)
+	}
+
+	failure := &client.HTTPError{StatusCode: http.StatusInternalServerError}
+	got, err = NilIfNotFound[string](nil, failure)
+	if !errors.Is(err, failure) || got != nil {
+		t.Errorf("NilIfNotFound() on 500 = (%v, %v), want the error", got, err)
+	}
+}
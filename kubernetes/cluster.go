@@ -2,31 +2,68 @@ package kubernetes
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"net/http"
 	"net/url"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/MagaluCloud/mgc-sdk-go/client"
+	"github.com/MagaluCloud/mgc-sdk-go/helpers"
 	mgc_http "github.com/MagaluCloud/mgc-sdk-go/internal/http"
 	"github.com/MagaluCloud/mgc-sdk-go/internal/utils"
 )
 
 const (
 	clusterUrlWithID = "/v0/clusters/%s"
+
+	// DefaultDeleteAllConcurrency is the number of clusters deleted in parallel by DeleteAll
+	DefaultDeleteAllConcurrency = 5
+	// DefaultDeleteAllPollInterval is the interval between checks for deleted clusters in DeleteAll
+	DefaultDeleteAllPollInterval = 10 * time.Second
 )
 
 type (
 	// ClusterService provides methods for managing Kubernetes clusters
 	ClusterService interface {
 		List(ctx context.Context, opts ListOptions) ([]ClusterList, error)
+		ListAll(ctx context.Context, opts ListOptions) ([]ClusterList, error)
 		Create(ctx context.Context, req ClusterRequest) (*CreateClusterResponse, error)
 		Get(ctx context.Context, clusterID string) (*Cluster, error)
+		GetOrNil(ctx context.Context, clusterID string) (*Cluster, error)
 		Delete(ctx context.Context, clusterID string) error
 		Update(ctx context.Context, clusterID string, req PatchClusterRequest) (*PatchClusterResponse, error)
 		GetKubeConfig(ctx context.Context, clusterID string) (*KubeConfig, error)
+		GetExecKubeConfig(ctx context.Context, clusterID string) (*KubeConfig, error)
+		GetExecCredential(ctx context.Context, clusterID string) (*ExecCredential, error)
+		DeleteAll(ctx context.Context, opts DeleteAllOptions) error
+		DeleteAllWithResult(ctx context.Context, opts DeleteAllOptions) (*DeleteAllResult, error)
+	}
+
+	// DeleteAllOptions configures the bulk deletion performed by DeleteAll
+	DeleteAllOptions struct {
+		// Filter selects the clusters to delete. When nil, every cluster is deleted.
+		Filter func(cluster ClusterList) bool
+		// Concurrency is the maximum number of parallel delete requests.
+		// Defaults to DefaultDeleteAllConcurrency.
+		Concurrency int
+		// Wait controls how the cluster list is polled for the deleted clusters
+		// to disappear. A zero PollInterval defaults to DefaultDeleteAllPollInterval.
+		Wait client.WaitOptions
+		// DryRun lists the clusters that would be deleted without deleting them.
+		DryRun bool
+	}
+
+	// DeleteAllResult reports the clusters handled by DeleteAllWithResult
+	DeleteAllResult struct {
+		// Planned holds the IDs of the clusters matched by the filter.
+		Planned []string
+		// Deleted holds the IDs of the clusters that were deleted. It is empty
+		// when DryRun is set.
+		Deleted []string
 	}
 
 	//VPC related network settings
@@ -168,8 +205,9 @@ type (
 		Users          []struct {
 			Name string `yaml:"name"`
 			User struct {
-				ClientCertificateData string `yaml:"client-certificate-data"`
-				ClientKeyData         string `yaml:"client-key-data"`
+				ClientCertificateData string      `yaml:"client-certificate-data,omitempty"`
+				ClientKeyData         string      `yaml:"client-key-data,omitempty"`
+				Exec                  *ExecConfig `yaml:"exec,omitempty"`
 			} `yaml:"user"`
 		} `yaml:"users"`
 	}
@@ -201,6 +239,9 @@ func (s *clusterService) List(ctx context.Context, opts ListOptions) ([]ClusterL
 	if len(opts.Expand) > 0 {
 		query.Add("expand", strings.Join(opts.Expand, ","))
 	}
+	if len(opts.Tags) > 0 {
+		query.Add("tags", strings.Join(opts.Tags, ","))
+	}
 
 	resp, err := mgc_http.ExecuteSimpleRequestWithRespBody[ClusterListResponse](ctx, s.client.newRequest, s.client.GetConfig(), http.MethodGet, "/v0/clusters", nil, query)
 	if err != nil {
@@ -210,8 +251,88 @@ func (s *clusterService) List(ctx context.Context, opts ListOptions) ([]ClusterL
 	return resp.Results, nil
 }
 
+// ListAll retrieves all Kubernetes clusters by fetching all pages. The Limit
+// and Offset of opts are ignored; the other options apply to every page.
+func (s *clusterService) ListAll(ctx context.Context, opts ListOptions) ([]ClusterList, error) {
+	var allClusters []ClusterList
+	offset := 0
+	limit := 50
+
+	for {
+		currentOffset := offset
+		currentLimit := limit
+		opts.Offset = &currentOffset
+		opts.Limit = &currentLimit
+
+		clusters, err := s.List(ctx, opts)
+		if err != nil {
+			return nil, err
+		}
+
+		allClusters = append(allClusters, clusters...)
+
+		if len(clusters) < limit {
+			break
+		}
+
+		offset += limit
+	}
+
+	return allClusters, nil
+}
+
+// Validate checks the required fields of the request and of its node pools
+// without calling the API. All problems found are returned together as
+// client.ValidationErrors.
+func (r ClusterRequest) Validate() error {
+	var errs client.ValidationErrors
+	if r.Name == "" {
+		errs.Add("name", utils.CannotBeEmpty)
+	}
+	if r.NodePools != nil {
+		for i, pool := range *r.NodePools {
+			validateNodePool(&errs, fmt.Sprintf("node_pools[%d].", i), pool)
+		}
+	}
+	validateAllowedCIDRs(&errs, r.AllowedCIDRs)
+	if r.ServicesIpV4CIDR != nil {
+		if err := helpers.ValidateCIDR(*r.ServicesIpV4CIDR); err != nil {
+			errs.Add("services_ipv4_cidr", err.Error())
+		}
+	}
+	if r.ClusterIPv4CIDR != nil {
+		if err := helpers.ValidateCIDR(*r.ClusterIPv4CIDR); err != nil {
+			errs.Add("cluster_ipv4_cidr", err.Error())
+		}
+	}
+	return errs.ErrOrNil()
+}
+
+// Validate checks the allowed CIDRs of the request without calling the API.
+func (r PatchClusterRequest) Validate() error {
+	var errs client.ValidationErrors
+	validateAllowedCIDRs(&errs, r.AllowedCIDRs)
+	return errs.ErrOrNil()
+}
+
+// validateAllowedCIDRs records every entry of cidrs that is not a valid network.
+func validateAllowedCIDRs(errs *client.ValidationErrors, cidrs *[]string) {
+	if cidrs == nil {
+		return
+	}
+	for i, cidr := range *cidrs {
+		if err := helpers.ValidateCIDR(cidr); err != nil {
+			errs.Add(fmt.Sprintf("allowed_cidrs[%d]", i), err.Error())
+		}
+	}
+}
+
 // Create creates a new Kubernetes cluster
 func (s *clusterService) Create(ctx context.Context, req ClusterRequest) (*CreateClusterResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+
 	response, err := mgc_http.ExecuteSimpleRequestWithRespBody[CreateClusterResponse](ctx, s.client.newRequest, s.client.GetConfig(), http.MethodPost, "/v0/clusters", req, nil)
 	if err != nil {
 		return nil, err
@@ -229,6 +350,12 @@ func (s *clusterService) Get(ctx context.Context, clusterID string) (*Cluster, e
 	return mgc_http.ExecuteSimpleRequestWithRespBody[Cluster](ctx, s.client.newRequest, s.client.GetConfig(), http.MethodGet, fmt.Sprintf(clusterUrlWithID, clusterID), nil, nil)
 }
 
+// GetOrNil retrieves a specific cluster, returning (nil, nil) when it does not
+// exist. Errors are reserved for real failures.
+func (s *clusterService) GetOrNil(ctx context.Context, clusterID string) (*Cluster, error) {
+	return utils.NilIfNotFound(s.Get(ctx, clusterID))
+}
+
 // Delete removes a Kubernetes cluster
 func (s *clusterService) Delete(ctx context.Context, clusterID string) error {
 	if clusterID == "" {
@@ -244,6 +371,10 @@ func (s *clusterService) Update(ctx context.Context, clusterID string, req Patch
 		return nil, &client.ValidationError{Field: "clusterID", Message: utils.CannotBeEmpty}
 	}
 
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+
 	return mgc_http.ExecuteSimpleRequestWithRespBody[PatchClusterResponse](ctx, s.client.newRequest, s.client.GetConfig(), http.MethodPatch, fmt.Sprintf(clusterUrlWithID, clusterID), req, nil)
 }
 
@@ -255,3 +386,117 @@ func (s *clusterService) GetKubeConfig(ctx context.Context, clusterID string) (*
 
 	return mgc_http.ExecuteSimpleRequestWithRespBody[KubeConfig](ctx, s.client.newRequest, s.client.GetConfig(), http.MethodGet, fmt.Sprintf(clusterUrlWithID+"/kubeconfig", clusterID), nil, nil)
 }
+
+// DeleteAll deletes every cluster matched by opts.Filter with bounded parallelism and
+// waits until the deleted clusters are no longer listed. Errors from individual
+// deletions are aggregated into the returned error.
+func (s *clusterService) DeleteAll(ctx context.Context, opts DeleteAllOptions) error {
+	_, err := s.DeleteAllWithResult(ctx, opts)
+	return err
+}
+
+// DeleteAllWithResult works like DeleteAll and also reports which clusters
+// were matched and deleted. With opts.DryRun set, it only lists the matched
+// clusters in the result and deletes nothing.
+func (s *clusterService) DeleteAllWithResult(ctx context.Context, opts DeleteAllOptions) (*DeleteAllResult, error) {
+	concurrency := opts.Concurrency
+	if concurrency <= 0 {
+		concurrency = DefaultDeleteAllConcurrency
+	}
+
+	wait := opts.Wait
+	if wait.PollInterval <= 0 {
+		wait.PollInterval = DefaultDeleteAllPollInterval
+	}
+
+	clusters, err := s.ListAll(ctx, ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	result := &DeleteAllResult{}
+	for _, cluster := range clusters {
+		if opts.Filter == nil || opts.Filter(cluster) {
+			result.Planned = append(result.Planned, cluster.ID)
+		}
+	}
+
+	if opts.DryRun {
+		return result, nil
+	}
+
+	var (
+		wg      sync.WaitGroup
+		mu      sync.Mutex
+		errs    []error
+		pending = make(map[string]struct{})
+		sem     = make(chan struct{}, concurrency)
+	)
+
+	// fillDeleted records the accepted deletions in result.Planned order.
+	fillDeleted := func() {
+		mu.Lock()
+		defer mu.Unlock()
+		for _, clusterID := range result.Planned {
+			if _, ok := pending[clusterID]; ok {
+				result.Deleted = append(result.Deleted, clusterID)
+			}
+		}
+	}
+
+	for _, clusterID := range result.Planned {
+		select {
+		case <-ctx.Done():
+			wg.Wait()
+			fillDeleted()
+			return result, errors.Join(append(errs, ctx.Err())...)
+		case sem <- struct{}{}:
+		}
+
+		wg.Add(1)
+		go func(clusterID string) {
+			defer wg.Done()
+			defer func() { <-sem }()
+
+			err := s.Delete(ctx, clusterID)
+
+			mu.Lock()
+			defer mu.Unlock()
+			if err != nil {
+				errs = append(errs, fmt.Errorf("failed to delete cluster %s: %w", clusterID, err))
+				return
+			}
+			pending[clusterID] = struct{}{}
+		}(clusterID)
+	}
+
+	wg.Wait()
+	fillDeleted()
+
+	if err := s.waitDeleted(ctx, pending, wait); err != nil {
+		errs = append(errs, err)
+	}
+
+	return result, errors.Join(errs...)
+}
+
+// waitDeleted polls the cluster list until none of the given clusters are listed
+func (s *clusterService) waitDeleted(ctx context.Context, pending map[string]struct{}, opts client.WaitOptions) error {
+	if len(pending) == 0 {
+		return nil
+	}
+
+	return client.Poll(ctx, opts, func(ctx context.Context) (bool, error) {
+		clusters, err := s.ListAll(ctx, ListOptions{})
+		if err != nil {
+			return false, err
+		}
+
+		for _, cluster := range clusters {
+			if _, ok := pending[cluster.ID]; ok {
+				return false, nil
+			}
+		}
+		return true, nil
+	})
+}
@@ -3,12 +3,19 @@ package kubernetes
 import (
 	"context"
 	"encoding/json"
+	"errors"
+	"fmt"
 	"io"
 	"net/http"
 	"net/http/httptest"
+	"reflect"
 	"strings"
+	"sync"
+	"sync/atomic"
 	"testing"
 	"time"
+
+	"github.com/MagaluCloud/mgc-sdk-go/client"
 )
 
 func TestClusterService_List(t *testing.T) {
@@ -90,6 +97,61 @@ func TestClusterService_List(t *testing.T) {
 	}
 }
 
+func TestClusterService_List_TagsFilter(t *testing.T) {
+	var gotTags string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotTags = r.URL.Query().Get("tags")
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"results": [{"id": "cluster1", "name": "prod-cluster"}]}`))
+	}))
+	defer server.Close()
+
+	_, err := testClient(server.URL).Clusters().List(context.Background(), ListOptions{
+		Tags: []string{"ai"},
+	})
+	if err != nil {
+		t.Fatalf("List() unexpected error: %v", err)
+	}
+
+	if gotTags != "ai" {
+		t.Errorf("List() tags query = %q, want %q", gotTags, "ai")
+	}
+}
+
+func TestClusterService_ListAll(t *testing.T) {
+	var offsets []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		query := r.URL.Query()
+		offsets = append(offsets, query.Get("_offset"))
+		if query.Get("_limit") != "50" || query.Get("tags") != "ai" {
+			t.Errorf("ListAll() query = %v", query)
+		}
+
+		count := 50
+		if query.Get("_offset") != "0" {
+			count = 1
+		}
+		results := make([]map[string]string, count)
+		for i := range results {
+			results[i] = map[string]string{"id": "cluster"}
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(map[string]any{"results": results})
+	}))
+	defer server.Close()
+
+	clusters, err := testClient(server.URL).Clusters().ListAll(context.Background(), ListOptions{Tags: []string{"ai"}})
+	if err != nil {
+		t.Fatalf("ListAll() unexpected error: %v", err)
+	}
+	if len(clusters) != 51 {
+		t.Errorf("ListAll() returned %d clusters, want 51", len(clusters))
+	}
+	if !reflect.DeepEqual(offsets, []string{"0", "50"}) {
+		t.Errorf("ListAll() requested offsets %v, want [0 50]", offsets)
+	}
+}
+
 func TestClusterService_Create(t *testing.T) {
 	tests := []struct {
 		name       string
@@ -194,6 +256,51 @@ func TestClusterService_Create_WithCustomerChosenSubnets(t *testing.T) {
 	})
 }
 
+func TestClusterRequest_JSONRoundTrip(t *testing.T) {
+	original := ClusterRequest{
+		Name:               "cluster",
+		Version:            strPtr("v1.30.2"),
+		Description:        strPtr("round trip"),
+		EnabledServerGroup: func() *bool { b := false; return &b }(),
+		NodePools: &[]CreateNodePoolRequest{
+			{
+				Name:      "pool",
+				Flavor:    "cloud-k8s.gp1.small",
+				Replicas:  3,
+				Tags:      &[]string{"ai"},
+				Taints:    &[]Taint{{Key: "gpu", Value: "true", Effect: "NoSchedule"}},
+				AutoScale: &AutoScale{MinReplicas: intPtr(1), MaxReplicas: intPtr(5)},
+			},
+		},
+		AllowedCIDRs:     &[]string{"192.168.0.0/24"},
+		ServicesIpV4CIDR: strPtr("10.96.0.0/12"),
+		ClusterIPv4CIDR:  strPtr("172.16.0.0/16"),
+		Network:          &KubernetesNetworkRequest{SubnetIDs: []string{"subnet-1"}},
+	}
+
+	data, err := json.Marshal(original)
+	if err != nil {
+		t.Fatalf("Marshal() unexpected error: %v", err)
+	}
+
+	var decoded ClusterRequest
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		t.Fatalf("Unmarshal() unexpected error: %v", err)
+	}
+
+	if !reflect.DeepEqual(original, decoded) {
+		t.Errorf("round trip mismatch:\noriginal: %+v\ndecoded:  %+v", original, decoded)
+	}
+
+	again, err := json.Marshal(decoded)
+	if err != nil {
+		t.Fatalf("Marshal() unexpected error: %v", err)
+	}
+	if string(again) != string(data) {
+		t.Errorf("round trip JSON mismatch:\nfirst:  %s\nsecond: %s", data, again)
+	}
+}
+
 func TestClusterService_Delete(t *testing.T) {
 	tests := []struct {
 		name       string
@@ -505,3 +612,352 @@ func TestClusterService_EdgeCases(t *testing.T) {
 		}
 	})
 }
+
+func TestClusterService_DeleteAll(t *testing.T) {
+	t.Run("deletes filtered clusters and waits for removal", func(t *testing.T) {
+		var mu sync.Mutex
+		deleted := map[string]bool{}
+
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			mu.Lock()
+			defer mu.Unlock()
+
+			switch r.Method {
+			case http.MethodGet:
+				results := []map[string]string{}
+				for _, id := range []string{"cluster-1", "cluster-2", "keep-me"} {
+					if !deleted[id] {
+						results = append(results, map[string]string{"id": id, "name": id})
+					}
+				}
+				w.Header().Set("Content-Type", "application/json")
+				json.NewEncoder(w).Encode(map[string]any{"results": results})
+			case http.MethodDelete:
+				deleted[strings.TrimPrefix(r.URL.Path, "/kubernetes/v0/clusters/")] = true
+				w.WriteHeader(http.StatusNoContent)
+			}
+		}))
+		defer server.Close()
+
+		err := testClient(server.URL).Clusters().DeleteAll(context.Background(), DeleteAllOptions{
+			Filter:      func(c ClusterList) bool { return strings.HasPrefix(c.Name, "cluster-") },
+			Concurrency: 2,
+			Wait:        client.WaitOptions{PollInterval: 10 * time.Millisecond},
+		})
+		if err != nil {
+			t.Fatalf("DeleteAll() unexpected error: %v", err)
+		}
+
+		if !deleted["cluster-1"] || !deleted["cluster-2"] {
+			t.Errorf("DeleteAll() did not delete all matched clusters: %v", deleted)
+		}
+		if deleted["keep-me"] {
+			t.Error("DeleteAll() deleted a cluster rejected by the filter")
+		}
+	})
+
+	t.Run("aggregates delete errors", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			switch r.Method {
+			case http.MethodGet:
+				w.Header().Set("Content-Type", "application/json")
+				w.Write([]byte(`{"results": [{"id": "cluster-1"}, {"id": "cluster-2"}]}`))
+			case http.MethodDelete:
+				w.WriteHeader(http.StatusBadRequest)
+			}
+		}))
+		defer server.Close()
+
+		err := testClient(server.URL).Clusters().DeleteAll(context.Background(), DeleteAllOptions{
+			Wait: client.WaitOptions{PollInterval: 10 * time.Millisecond},
+		})
+		if err == nil {
+			t.Fatal("DeleteAll() expected error, got nil")
+		}
+		if !strings.Contains(err.Error(), "cluster-1") || !strings.Contains(err.Error(), "cluster-2") {
+			t.Errorf("DeleteAll() error should mention every failed cluster, got: %v", err)
+		}
+	})
+
+	t.Run("context cancelled while waiting", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			switch r.Method {
+			case http.MethodGet:
+				w.Header().Set("Content-Type", "application/json")
+				w.Write([]byte(`{"results": [{"id": "cluster-1"}]}`))
+			case http.MethodDelete:
+				w.WriteHeader(http.StatusNoContent)
+			}
+		}))
+		defer server.Close()
+
+		ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+		defer cancel()
+
+		err := testClient(server.URL).Clusters().DeleteAll(ctx, DeleteAllOptions{
+			Wait: client.WaitOptions{PollInterval: 10 * time.Millisecond},
+		})
+		if !errors.Is(err, context.DeadlineExceeded) {
+			t.Errorf("DeleteAll() error = %v, want context.DeadlineExceeded", err)
+		}
+	})
+
+	t.Run("wait timeout", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			switch r.Method {
+			case http.MethodGet:
+				w.Header().Set("Content-Type", "application/json")
+				w.Write([]byte(`{"results": [{"id": "cluster-1"}]}`))
+			case http.MethodDelete:
+				w.WriteHeader(http.StatusNoContent)
+			}
+		}))
+		defer server.Close()
+
+		err := testClient(server.URL).Clusters().DeleteAll(context.Background(), DeleteAllOptions{
+			Wait: client.WaitOptions{PollInterval: 10 * time.Millisecond, Timeout: 50 * time.Millisecond},
+		})
+		if !errors.Is(err, context.DeadlineExceeded) {
+			t.Errorf("DeleteAll() error = %v, want context.DeadlineExceeded", err)
+		}
+	})
+}
+
+func TestClusterService_DeleteAllWithResult(t *testing.T) {
+	newServer := func(deletes *atomic.Int32) *httptest.Server {
+		return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			switch r.Method {
+			case http.MethodGet:
+				w.Header().Set("Content-Type", "application/json")
+				if deletes.Load() > 0 {
+					w.Write([]byte(`{"results": [{"id": "keep-me", "name": "keep-me"}]}`))
+					return
+				}
+				w.Write([]byte(`{"results": [{"id": "cluster-1", "name": "cluster-1"}, {"id": "keep-me", "name": "keep-me"}, {"id": "cluster-2", "name": "cluster-2"}]}`))
+			case http.MethodDelete:
+				deletes.Add(1)
+				w.WriteHeader(http.StatusNoContent)
+			}
+		}))
+	}
+	opts := DeleteAllOptions{
+		Filter:      func(c ClusterList) bool { return strings.HasPrefix(c.Name, "cluster-") },
+		Concurrency: 1,
+		Wait:        client.WaitOptions{PollInterval: 10 * time.Millisecond},
+	}
+
+	t.Run("dry run deletes nothing", func(t *testing.T) {
+		var deletes atomic.Int32
+		server := newServer(&deletes)
+		defer server.Close()
+
+		dryRun := opts
+		dryRun.DryRun = true
+		result, err := testClient(server.URL).Clusters().DeleteAllWithResult(context.Background(), dryRun)
+		if err != nil {
+			t.Fatalf("DeleteAllWithResult() unexpected error: %v", err)
+		}
+		if n := deletes.Load(); n != 0 {
+			t.Errorf("DeleteAllWithResult() sent %d delete requests in dry run", n)
+		}
+		if !reflect.DeepEqual(result.Planned, []string{"cluster-1", "cluster-2"}) {
+			t.Errorf("Planned = %v, want [cluster-1 cluster-2]", result.Planned)
+		}
+		if len(result.Deleted) != 0 {
+			t.Errorf("Deleted = %v, want none", result.Deleted)
+		}
+	})
+
+	t.Run("reports deleted clusters", func(t *testing.T) {
+		var deletes atomic.Int32
+		server := newServer(&deletes)
+		defer server.Close()
+
+		result, err := testClient(server.URL).Clusters().DeleteAllWithResult(context.Background(), opts)
+		if err != nil {
+			t.Fatalf("DeleteAllWithResult() unexpected error: %v", err)
+		}
+		if n := deletes.Load(); n != 2 {
+			t.Errorf("DeleteAllWithResult() sent %d delete requests, want 2", n)
+		}
+		if !reflect.DeepEqual(result.Deleted, []string{"cluster-1", "cluster-2"}) {
+			t.Errorf("Deleted = %v, want [cluster-1 cluster-2]", result.Deleted)
+		}
+	})
+
+	t.Run("cancellation keeps the deletions made so far", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		release := make(chan struct{})
+
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			switch r.Method {
+			case http.MethodGet:
+				w.Header().Set("Content-Type", "application/json")
+				var clusters []string
+				for i := 1; i <= 10; i++ {
+					clusters = append(clusters, fmt.Sprintf(`{"id": "cluster-%d", "name": "cluster-%d"}`, i, i))
+				}
+				w.Write([]byte(`{"results": [` + strings.Join(clusters, ",") + `]}`))
+			case http.MethodDelete:
+				if strings.HasSuffix(r.URL.Path, "/cluster-2") {
+					// Cancel mid-run and hold the response until the run is over.
+					cancel()
+					<-release
+					return
+				}
+				w.WriteHeader(http.StatusNoContent)
+			}
+		}))
+		defer server.Close()
+
+		result, err := testClient(server.URL).Clusters().DeleteAllWithResult(ctx, opts)
+		close(release)
+		if !errors.Is(err, context.Canceled) {
+			t.Fatalf("DeleteAllWithResult() error = %v, want context.Canceled", err)
+		}
+		if len(result.Planned) != 10 {
+			t.Errorf("Planned = %v, want 10 clusters", result.Planned)
+		}
+		if !reflect.DeepEqual(result.Deleted, []string{"cluster-1"}) {
+			t.Errorf("Deleted = %v, want [cluster-1]", result.Deleted)
+		}
+	})
+}
+
+func TestClusterService_GetNotFound(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name         string
+		statusCode   int
+		response     string
+		wantNotFound bool
+	}{
+		{name: "not found", statusCode: http.StatusNotFound, response: `{"message": "not found"}`, wantNotFound: true},
+		{name: "not found without body", statusCode: http.StatusNotFound, wantNotFound: true},
+		{name: "forbidden", statusCode: http.StatusForbidden, response: `{"message": "forbidden"}`},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(tt.statusCode)
+				w.Write([]byte(tt.response))
+			}))
+			defer server.Close()
+
+			_, err := testClient(server.URL).Clusters().Get(context.Background(), "res-1")
+			if err == nil {
+				t.Fatal("Get() error = nil, want an error")
+			}
+			if got := client.IsNotFound(err); got != tt.wantNotFound {
+				t.Errorf("IsNotFound(%v) = %v, want %v", err, got, tt.wantNotFound)
+			}
+		})
+	}
+}
+
+func TestClusterService_GetOrNil(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name       string
+		statusCode int
+		response   string
+		wantNil    bool
+		wantErr    bool
+	}{
+		{name: "exists", statusCode: http.StatusOK, response: `{"id": "cluster-1"}`},
+		{name: "not found", statusCode: http.StatusNotFound, response: `{"message": "not found"}`, wantNil: true},
+		{name: "forbidden", statusCode: http.StatusForbidden, response: `{"message": "forbidden"}`, wantNil: true, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(tt.statusCode)
+				w.Write([]byte(tt.response))
+			}))
+			defer server.Close()
+
+			got, err := testClient(server.URL).Clusters().GetOrNil(context.Background(), "cluster-1")
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("GetOrNil() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if (got == nil) != tt.wantNil {
+				t.Fatalf("GetOrNil() = %v, wantNil %v", got, tt.wantNil)
+			}
+			if got != nil && got.ID != "cluster-1" {
+				t.Errorf("GetOrNil() ID = %s, want cluster-1", got.ID)
+			}
+		})
+	}
+}
+
+func TestClusterRequest_Validate(t *testing.T) {
+	t.Parallel()
+
+	err := ClusterRequest{
+		NodePools: &[]CreateNodePoolRequest{
+			{Name: "pool", Flavor: "gp1.small", Replicas: 1},
+			{Replicas: -1},
+		},
+	}.Validate()
+
+	var errs client.ValidationErrors
+	if !errors.As(err, &errs) {
+		t.Fatalf("Validate() error = %T, want client.ValidationErrors", err)
+	}
+	want := []string{"name", "node_pools[1].name", "node_pools[1].flavor", "node_pools[1].replicas"}
+	if len(errs) != len(want) {
+		t.Fatalf("Validate() returned %d problems, want %d: %v", len(errs), len(want), err)
+	}
+	for i, field := range want {
+		if errs[i].Field != field {
+			t.Errorf("problem %d field = %q, want %q", i, errs[i].Field, field)
+		}
+	}
+
+	if err := (ClusterRequest{Name: "cluster"}).Validate(); err != nil {
+		t.Errorf("Validate() error = %v, want nil", err)
+	}
+}
+
+func TestClusterService_UpdateInvalidCIDR(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+	}))
+	defer server.Close()
+
+	_, err := testClient(server.URL).Clusters().Update(context.Background(), "cluster-123", PatchClusterRequest{
+		AllowedCIDRs: &[]string{"192.168.0.0/24", "192.168.1.0"},
+	})
+
+	var validationErr *client.ValidationError
+	if !errors.As(err, &validationErr) || validationErr.Field != "allowed_cidrs[1]" {
+		t.Errorf("expected ValidationError on allowed_cidrs[1], got %v", err)
+	}
+}
+
+func TestClusterRequest_ValidateCIDRs(t *testing.T) {
+	t.Parallel()
+
+	err := ClusterRequest{
+		Name:             "cluster",
+		AllowedCIDRs:     &[]string{"10.0.0.0/8"},
+		ServicesIpV4CIDR: strPtr("10.96.0.1/12"),
+		ClusterIPv4CIDR:  strPtr("172.16.0.0/16"),
+	}.Validate()
+
+	var errs client.ValidationErrors
+	if !errors.As(err, &errs) || len(errs) != 1 || errs[0].Field != "services_ipv4_cidr" {
+		t.Errorf("Validate() error = %v, want a single services_ipv4_cidr problem", err)
+	}
+}
@@ -7,10 +7,18 @@ import (
 type (
 	// ListOptions provides options for listing resources
 	ListOptions struct {
-		Limit  *int
-		Offset *int
-		Sort   *string
-		Expand []string
+		Limit  *int     `json:"_limit,omitempty"`
+		Offset *int     `json:"_offset,omitempty"`
+		Sort   *string  `json:"_sort,omitempty"`
+		Expand []string `json:"expand,omitempty"`
+		// Tags filters the results to resources carrying all the given tags.
+		// The filter is applied server-side through the "tags" query parameter.
+		Tags []string `json:"tags,omitempty"`
+		// IfNoneMatch makes the request conditional on the ETag of a previous
+		// list. When nothing changed, List returns an error matching
+		// client.ErrNotModified and the previous list is still current.
+		// Only Flavors().List sends it.
+		IfNoneMatch string `json:"-"`
 	}
 
 	// MessageState represents a status message
@@ -62,6 +70,7 @@ type (
 		InstanceTemplate  InstanceTemplate  `json:"instance_template"`
 		Replicas          int               `json:"replicas"`
 		Labels            map[string]string `json:"labels,omitempty"`
+		Tags              *[]string         `json:"tags,omitempty"`
 		Taints            *[]Taint          `json:"taints,omitempty"`
 		SecurityGroups    *[]string         `json:"security_groups,omitempty"`
 		CreatedAt         *time.Time        `json:"created_at"`
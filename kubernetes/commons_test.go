@@ -1,10 +1,16 @@
 package kubernetes
 
 import (
+	"bytes"
+	"encoding/gob"
+	"encoding/json"
 	"net/http"
+	"reflect"
+	"testing"
 	"time"
 
 	"github.com/MagaluCloud/mgc-sdk-go/client"
+	"github.com/MagaluCloud/mgc-sdk-go/helpers"
 )
 
 func testClient(baseURL string) *KubernetesClient {
@@ -22,3 +28,44 @@ func intPtr(i int) *int {
 func strPtr(s string) *string {
 	return &s
 }
+
+func TestListOptions_Serialization(t *testing.T) {
+	t.Parallel()
+
+	opts := ListOptions{
+		Limit:  helpers.IntPtr(10),
+		Offset: helpers.IntPtr(20),
+		Sort:   helpers.StrPtr("name:asc"),
+		Expand: []string{"node_pools"},
+		Tags:   []string{"prod"},
+	}
+
+	data, err := json.Marshal(opts)
+	if err != nil {
+		t.Fatalf("json.Marshal() error = %v", err)
+	}
+	want := `{"_limit":10,"_offset":20,"_sort":"name:asc","expand":["node_pools"],"tags":["prod"]}`
+	if string(data) != want {
+		t.Errorf("json.Marshal() = %s, want %s", data, want)
+	}
+
+	var fromJSON ListOptions
+	if err := json.Unmarshal(data, &fromJSON); err != nil {
+		t.Fatalf("json.Unmarshal() error = %v", err)
+	}
+	if !reflect.DeepEqual(fromJSON, opts) {
+		t.Errorf("JSON round trip = %+v, want %+v", fromJSON, opts)
+	}
+
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(opts); err != nil {
+		t.Fatalf("gob Encode() error = %v", err)
+	}
+	var fromGob ListOptions
+	if err := gob.NewDecoder(&buf).Decode(&fromGob); err != nil {
+		t.Fatalf("gob Decode() error = %v", err)
+	}
+	if !reflect.DeepEqual(fromGob, opts) {
+		t.Errorf("gob round trip = %+v, want %+v", fromGob, opts)
+	}
+}
@@ -26,6 +26,9 @@ type (
 	FlavorsAvailable struct {
 		NodePool     []Flavor `json:"nodepool"`
 		ControlPlane []Flavor `json:"controlplane"`
+		// ETag identifies this version of the flavors. Pass it as
+		// ListOptions.IfNoneMatch to detect changes on the next call.
+		ETag string `json:"-"`
 	}
 
 	// flavorService implements the FlavorService interface
@@ -50,7 +53,8 @@ func (s *flavorService) List(ctx context.Context, opts ListOptions) (*FlavorsAva
 		query.Add("expand", strings.Join(opts.Expand, ","))
 	}
 
-	response, err := mgc_http.ExecuteSimpleRequestWithRespBody[FlavorList](ctx, s.client.newRequest, s.client.GetConfig(), http.MethodGet, "/v1/flavors", nil, query)
+	cond := &mgc_http.Conditional{IfNoneMatch: opts.IfNoneMatch}
+	response, err := mgc_http.ExecuteSimpleRequestWithRespBody[FlavorList](mgc_http.WithConditional(ctx, cond), s.client.newRequest, s.client.GetConfig(), http.MethodGet, "/v1/flavors", nil, query)
 	if err != nil {
 		return nil, err
 	}
@@ -59,5 +63,7 @@ func (s *flavorService) List(ctx context.Context, opts ListOptions) (*FlavorsAva
 		return nil, errors.New("no flavors available")
 	}
 
-	return &response.Results[0], nil
+	flavors := &response.Results[0]
+	flavors.ETag = cond.ETag
+	return flavors, nil
 }
@@ -7,6 +7,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/MagaluCloud/mgc-sdk-go/client"
 	"github.com/MagaluCloud/mgc-sdk-go/helpers"
 )
 
@@ -197,3 +198,31 @@ func TestFlavorService_List_EdgeCases(t *testing.T) {
 		})
 	}
 }
+
+func TestFlavorService_ListConditional(t *testing.T) {
+	const etag = `"flavors-v1"`
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("If-None-Match") == etag {
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("ETag", etag)
+		w.Write([]byte(`{"results": [{"nodepool": [{"name": "cloud-k8s.gp1.small"}], "controlplane": []}]}`))
+	}))
+	defer server.Close()
+
+	svc := testClient(server.URL).Flavors()
+	flavors, err := svc.List(context.Background(), ListOptions{})
+	if err != nil {
+		t.Fatalf("List() unexpected error: %v", err)
+	}
+	if flavors.ETag != etag {
+		t.Errorf("ETag = %s, want %s", flavors.ETag, etag)
+	}
+
+	_, err = svc.List(context.Background(), ListOptions{IfNoneMatch: flavors.ETag})
+	if !client.IsNotModified(err) {
+		t.Errorf("List() error = %v, want a not modified error", err)
+	}
+}
@@ -0,0 +1,99 @@
+package kubernetes
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+)
+
+const (
+	// DefaultExecCredentialCommand is the credential helper invoked by kubeconfigs
+	// from GetExecKubeConfig. It is built from cmd/mgc-kube-credential and must be
+	// on the PATH of the machine running kubectl.
+	DefaultExecCredentialCommand = "mgc-kube-credential"
+
+	// ExecCredentialAPIVersion is the client authentication API version used by
+	// the exec credential plugin.
+	ExecCredentialAPIVersion = "client.authentication.k8s.io/v1"
+)
+
+type (
+	// ExecConfig is the exec credential plugin block of a kubeconfig user
+	ExecConfig struct {
+		APIVersion      string   `yaml:"apiVersion"`
+		Command         string   `yaml:"command"`
+		Args            []string `yaml:"args,omitempty"`
+		InteractiveMode string   `yaml:"interactiveMode"`
+	}
+
+	// ExecCredential is the document written to stdout by an exec credential plugin
+	ExecCredential struct {
+		APIVersion string               `json:"apiVersion"`
+		Kind       string               `json:"kind"`
+		Status     ExecCredentialStatus `json:"status"`
+	}
+
+	// ExecCredentialStatus holds the PEM-encoded client certificate and key
+	ExecCredentialStatus struct {
+		ClientCertificateData string `json:"clientCertificateData"`
+		ClientKeyData         string `json:"clientKeyData"`
+	}
+)
+
+// GetExecKubeConfig retrieves the kubeconfig for a cluster with the embedded
+// client credentials replaced by an exec plugin block. kubectl then runs
+// DefaultExecCredentialCommand to fetch the credentials from the API each time
+// they are needed, so no long-lived credentials are stored in the file.
+// The helper authenticates with the API key from the MGC_API_KEY environment variable.
+func (s *clusterService) GetExecKubeConfig(ctx context.Context, clusterID string) (*KubeConfig, error) {
+	kubeconfig, err := s.GetKubeConfig(ctx, clusterID)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range kubeconfig.Users {
+		user := &kubeconfig.Users[i].User
+		user.ClientCertificateData = ""
+		user.ClientKeyData = ""
+		user.Exec = &ExecConfig{
+			APIVersion:      ExecCredentialAPIVersion,
+			Command:         DefaultExecCredentialCommand,
+			Args:            []string{"--cluster-id", clusterID, "--base-url", s.client.GetConfig().BaseURL.String()},
+			InteractiveMode: "Never",
+		}
+	}
+
+	return kubeconfig, nil
+}
+
+// GetExecCredential retrieves the client credentials of a cluster in the format
+// expected from an exec credential plugin.
+func (s *clusterService) GetExecCredential(ctx context.Context, clusterID string) (*ExecCredential, error) {
+	kubeconfig, err := s.GetKubeConfig(ctx, clusterID)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(kubeconfig.Users) == 0 {
+		return nil, fmt.Errorf("kubeconfig for cluster %s has no users", clusterID)
+	}
+	user := kubeconfig.Users[0].User
+
+	cert, err := base64.StdEncoding.DecodeString(user.ClientCertificateData)
+	if err != nil {
+		return nil, fmt.Errorf("error decoding client certificate: %w", err)
+	}
+	key, err := base64.StdEncoding.DecodeString(user.ClientKeyData)
+	if err != nil {
+		return nil, fmt.Errorf("error decoding client key: %w", err)
+	}
+
+	return &ExecCredential{
+		APIVersion: ExecCredentialAPIVersion,
+		Kind:       "ExecCredential",
+		Status: ExecCredentialStatus{
+			ClientCertificateData: string(cert),
+			ClientKeyData:         string(key),
+		},
+	}, nil
+}
@@ -6,6 +6,7 @@ import (
 	"net/http"
 	"net/url"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/MagaluCloud/mgc-sdk-go/client"
@@ -86,10 +87,14 @@ type (
 		Results []NodeResponse `json:"results"`
 	}
 
-	// PatchNodePoolRequest represents the request payload for updating a node pool
+	// PatchNodePoolRequest represents the request payload for updating a node pool.
+	// Taints and Tags replace the current values when set; pass a pointer to an
+	// empty slice to clear them.
 	PatchNodePoolRequest struct {
 		Replicas  *int       `json:"replicas,omitempty"`
 		AutoScale *AutoScale `json:"auto_scale,omitempty"`
+		Taints    *[]Taint   `json:"taints,omitempty"`
+		Tags      *[]string  `json:"tags,omitempty"`
 	}
 
 	// nodePoolService implements the NodePoolService interface
@@ -135,6 +140,9 @@ func (s *nodePoolService) List(ctx context.Context, clusterID string, opts ListO
 	if opts.Sort != nil {
 		query.Add("_sort", *opts.Sort)
 	}
+	if len(opts.Tags) > 0 {
+		query.Add("tags", strings.Join(opts.Tags, ","))
+	}
 
 	resp, err := mgc_http.ExecuteSimpleRequestWithRespBody[NodePoolList](ctx, s.client.newRequest,
 		s.client.GetConfig(), http.MethodGet, fmt.Sprintf("/v1alpha0/clusters/%s/node-pools", clusterID), nil, query)
@@ -145,12 +153,38 @@ func (s *nodePoolService) List(ctx context.Context, clusterID string, opts ListO
 	return resp.Results, nil
 }
 
+// Validate checks the required fields of the request without calling the API.
+// All problems found are returned together as client.ValidationErrors.
+func (r CreateNodePoolRequest) Validate() error {
+	var errs client.ValidationErrors
+	validateNodePool(&errs, "", r)
+	return errs.ErrOrNil()
+}
+
+// validateNodePool records the problems of a node pool request, prefixing
+// field names so pools nested in a ClusterRequest can be told apart.
+func validateNodePool(errs *client.ValidationErrors, prefix string, r CreateNodePoolRequest) {
+	if r.Name == "" {
+		errs.Add(prefix+"name", utils.CannotBeEmpty)
+	}
+	if r.Flavor == "" {
+		errs.Add(prefix+"flavor", utils.CannotBeEmpty)
+	}
+	if r.Replicas < 0 {
+		errs.Add(prefix+"replicas", "cannot be negative")
+	}
+}
+
 // Create creates a new node pool in a cluster
 func (s *nodePoolService) Create(ctx context.Context, clusterID string, req CreateNodePoolRequest) (*NodePool, error) {
 	if clusterID == "" {
 		return nil, &client.ValidationError{Field: clusterIdField, Message: utils.CannotBeEmpty}
 	}
 
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+
 	return mgc_http.ExecuteSimpleRequestWithRespBody[NodePool](ctx, s.client.newRequest,
 		s.client.GetConfig(), http.MethodPost,
 		fmt.Sprintf("/v0/clusters/%s/node_pools", clusterID), req, nil)
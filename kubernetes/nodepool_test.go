@@ -264,6 +264,32 @@ func TestNodePoolService_Delete(t *testing.T) {
 	}
 }
 
+func TestNodePoolService_List_TagsFilter(t *testing.T) {
+	var gotTags string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotTags = r.URL.Query().Get("tags")
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"results": [{"id": "pool1", "name": "gpu-pool", "tags": ["ai", "gpu"]}]}`))
+	}))
+	defer server.Close()
+
+	client := testClient(server.URL)
+	result, err := client.Nodepools().List(context.Background(), "cluster-123", ListOptions{
+		Tags: []string{"ai", "gpu"},
+	})
+	if err != nil {
+		t.Fatalf("List() unexpected error: %v", err)
+	}
+
+	if gotTags != "ai,gpu" {
+		t.Errorf("List() tags query = %q, want %q", gotTags, "ai,gpu")
+	}
+
+	if len(result) != 1 || result[0].Tags == nil || len(*result[0].Tags) != 2 {
+		t.Errorf("List() tags not decoded: %+v", result)
+	}
+}
+
 func TestNodePoolService_List_InvalidOptions(t *testing.T) {
 	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		w.WriteHeader(http.StatusBadRequest)
@@ -499,6 +525,63 @@ func TestNodePoolService_Update(t *testing.T) {
 	}
 }
 
+func TestNodePoolService_Update_TaintsAndTags(t *testing.T) {
+	tests := []struct {
+		name        string
+		request     PatchNodePoolRequest
+		wantPayload string
+	}{
+		{
+			name: "set taints and tags",
+			request: PatchNodePoolRequest{
+				Taints: &[]Taint{{Key: "gpu", Value: "true", Effect: "NoSchedule"}},
+				Tags:   &[]string{"ai"},
+			},
+			wantPayload: `{"taints":[{"key":"gpu","value":"true","effect":"NoSchedule"}],"tags":["ai"]}`,
+		},
+		{
+			name: "clear taints with empty slice",
+			request: PatchNodePoolRequest{
+				Taints: &[]Taint{},
+			},
+			wantPayload: `{"taints":[]}`,
+		},
+		{
+			name: "leave taints untouched when nil",
+			request: PatchNodePoolRequest{
+				Replicas: helpers.IntPtr(2),
+			},
+			wantPayload: `{"replicas":2}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var gotPayload string
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				body, _ := io.ReadAll(r.Body)
+				gotPayload = string(body)
+				w.Header().Set("Content-Type", "application/json")
+				w.Write([]byte(`{"id": "pool-456", "taints": [{"key": "gpu", "value": "true", "effect": "NoSchedule"}]}`))
+			}))
+			defer server.Close()
+
+			result, err := testClient(server.URL).Nodepools().Update(context.Background(), "cluster-123", "pool-456", tt.request)
+			if err != nil {
+				t.Fatalf("Update() unexpected error: %v", err)
+			}
+
+			if gotPayload != tt.wantPayload {
+				t.Errorf("Update() payload = %s, want %s", gotPayload, tt.wantPayload)
+			}
+
+			if result.Taints == nil || len(*result.Taints) != 1 {
+				t.Errorf("Update() should return the updated pool, got %+v", result)
+			}
+		})
+	}
+}
+
 func TestNodePoolService_Get(t *testing.T) {
 	tests := []struct {
 		name       string
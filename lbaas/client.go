@@ -27,6 +27,12 @@ func New(core *client.CoreClient) *LbaasClient {
 	return &LbaasClient{CoreClient: core}
 }
 
+// NewDefault creates a new instance of LbaasClient using the client installed
+// with client.SetDefault. If no default client is set, returns nil.
+func NewDefault() *LbaasClient {
+	return New(client.Default())
+}
+
 // newRequest creates a new HTTP request for the load balancer API
 func (c *LbaasClient) newRequest(ctx context.Context, method, path string, body any) (*http.Request, error) {
 	return mgc_http.NewRequest(c.GetConfig(), ctx, method, DefaultBasePath+path, &body)
@@ -1,5 +1,10 @@
 package lbaas
 
+import (
+	"encoding/json"
+	"fmt"
+)
+
 // AclActionType represents the action type for ACL rules
 type AclActionType string
 
@@ -52,6 +57,16 @@ const (
 	LoadBalancerStatusCanceled LoadBalancerStatus = "canceled"
 	LoadBalancerStatusDeleted  LoadBalancerStatus = "deleted"
 	LoadBalancerStatusInactive LoadBalancerStatus = "inactive"
+	// LoadBalancerStatusUnknown is used when the API reports a status this
+	// version of the SDK does not recognize.
+	LoadBalancerStatusUnknown LoadBalancerStatus = "unknown"
+)
+
+// LoadBalancerType represents the type of a load balancer
+type LoadBalancerType string
+
+const (
+	LoadBalancerTypeProxy LoadBalancerType = "proxy"
 )
 
 // LoadBalancerVisibility represents the visibility of a load balancer
@@ -77,3 +92,270 @@ const (
 	ListenerProtocolTCP ListenerProtocol = "tcp"
 	ListenerProtocolTLS ListenerProtocol = "tls"
 )
+
+// String returns the string representation of the action type.
+func (a AclActionType) String() string {
+	return string(a)
+}
+
+// IsValid reports whether the action type is one of the known values.
+func (a AclActionType) IsValid() bool {
+	switch a {
+	case AclActionTypeAllow, AclActionTypeDeny, AclActionTypeDenyUnspecified:
+		return true
+	default:
+		return false
+	}
+}
+
+// ParseAclActionType converts a string into an AclActionType, returning an
+// error if the value is not a known action type.
+func ParseAclActionType(s string) (AclActionType, error) {
+	a := AclActionType(s)
+	if !a.IsValid() {
+		return "", fmt.Errorf("invalid acl action type: %s", s)
+	}
+	return a, nil
+}
+
+// String returns the string representation of the ethernet type.
+func (e AclEtherType) String() string {
+	return string(e)
+}
+
+// IsValid reports whether the ethernet type is one of the known values.
+func (e AclEtherType) IsValid() bool {
+	switch e {
+	case AclEtherTypeIPv4, AclEtherTypeIPv6:
+		return true
+	default:
+		return false
+	}
+}
+
+// ParseAclEtherType converts a string into an AclEtherType, returning an
+// error if the value is not a known ethernet type.
+func ParseAclEtherType(s string) (AclEtherType, error) {
+	e := AclEtherType(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid acl ether type: %s", s)
+	}
+	return e, nil
+}
+
+// String returns the string representation of the balance algorithm.
+func (b BackendBalanceAlgorithm) String() string {
+	return string(b)
+}
+
+// IsValid reports whether the balance algorithm is one of the known values.
+func (b BackendBalanceAlgorithm) IsValid() bool {
+	switch b {
+	case BackendBalanceAlgorithmRoundRobin:
+		return true
+	default:
+		return false
+	}
+}
+
+// ParseBackendBalanceAlgorithm converts a string into a BackendBalanceAlgorithm,
+// returning an error if the value is not a known algorithm.
+func ParseBackendBalanceAlgorithm(s string) (BackendBalanceAlgorithm, error) {
+	b := BackendBalanceAlgorithm(s)
+	if !b.IsValid() {
+		return "", fmt.Errorf("invalid backend balance algorithm: %s", s)
+	}
+	return b, nil
+}
+
+// String returns the string representation of the backend type.
+func (b BackendType) String() string {
+	return string(b)
+}
+
+// IsValid reports whether the backend type is one of the known values.
+func (b BackendType) IsValid() bool {
+	switch b {
+	case BackendTypeInstance, BackendTypeRaw:
+		return true
+	default:
+		return false
+	}
+}
+
+// ParseBackendType converts a string into a BackendType, returning an error
+// if the value is not a known backend type.
+func ParseBackendType(s string) (BackendType, error) {
+	b := BackendType(s)
+	if !b.IsValid() {
+		return "", fmt.Errorf("invalid backend type: %s", s)
+	}
+	return b, nil
+}
+
+// String returns the string representation of the health check protocol.
+func (p HealthCheckProtocol) String() string {
+	return string(p)
+}
+
+// IsValid reports whether the health check protocol is one of the known values.
+func (p HealthCheckProtocol) IsValid() bool {
+	switch p {
+	case HealthCheckProtocolTCP, HealthCheckProtocolHTTP:
+		return true
+	default:
+		return false
+	}
+}
+
+// ParseHealthCheckProtocol converts a string into a HealthCheckProtocol,
+// returning an error if the value is not a known protocol.
+func ParseHealthCheckProtocol(s string) (HealthCheckProtocol, error) {
+	p := HealthCheckProtocol(s)
+	if !p.IsValid() {
+		return "", fmt.Errorf("invalid health check protocol: %s", s)
+	}
+	return p, nil
+}
+
+// String returns the string representation of the load balancer status.
+func (s LoadBalancerStatus) String() string {
+	return string(s)
+}
+
+// IsValid reports whether the load balancer status is one of the known values.
+func (s LoadBalancerStatus) IsValid() bool {
+	switch s {
+	case LoadBalancerStatusCreating, LoadBalancerStatusUpdating, LoadBalancerStatusDeleting,
+		LoadBalancerStatusRunning, LoadBalancerStatusFailed, LoadBalancerStatusCanceled,
+		LoadBalancerStatusDeleted, LoadBalancerStatusInactive:
+		return true
+	default:
+		return false
+	}
+}
+
+// UnmarshalJSON decodes a load balancer status, mapping values not known to
+// the SDK to LoadBalancerStatusUnknown instead of failing.
+func (s *LoadBalancerStatus) UnmarshalJSON(data []byte) error {
+	var raw string
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+	status := LoadBalancerStatus(raw)
+	if !status.IsValid() {
+		status = LoadBalancerStatusUnknown
+	}
+	*s = status
+	return nil
+}
+
+// ParseLoadBalancerStatus converts a string into a LoadBalancerStatus,
+// returning an error if the value is not a known status.
+func ParseLoadBalancerStatus(s string) (LoadBalancerStatus, error) {
+	status := LoadBalancerStatus(s)
+	if !status.IsValid() {
+		return "", fmt.Errorf("invalid load balancer status: %s", s)
+	}
+	return status, nil
+}
+
+// String returns the string representation of the load balancer type.
+func (t LoadBalancerType) String() string {
+	return string(t)
+}
+
+// IsValid reports whether the load balancer type is one of the known values.
+func (t LoadBalancerType) IsValid() bool {
+	switch t {
+	case LoadBalancerTypeProxy:
+		return true
+	default:
+		return false
+	}
+}
+
+// ParseLoadBalancerType converts a string into a LoadBalancerType,
+// returning an error if the value is not a known type.
+func ParseLoadBalancerType(s string) (LoadBalancerType, error) {
+	t := LoadBalancerType(s)
+	if !t.IsValid() {
+		return "", fmt.Errorf("invalid load balancer type: %s", s)
+	}
+	return t, nil
+}
+
+// String returns the string representation of the visibility.
+func (v LoadBalancerVisibility) String() string {
+	return string(v)
+}
+
+// IsValid reports whether the visibility is one of the known values.
+func (v LoadBalancerVisibility) IsValid() bool {
+	switch v {
+	case LoadBalancerVisibilityInternal, LoadBalancerVisibilityExternal:
+		return true
+	default:
+		return false
+	}
+}
+
+// ParseLoadBalancerVisibility converts a string into a LoadBalancerVisibility,
+// returning an error if the value is not a known visibility.
+func ParseLoadBalancerVisibility(s string) (LoadBalancerVisibility, error) {
+	v := LoadBalancerVisibility(s)
+	if !v.IsValid() {
+		return "", fmt.Errorf("invalid load balancer visibility: %s", s)
+	}
+	return v, nil
+}
+
+// String returns the string representation of the ACL protocol.
+func (p AclProtocol) String() string {
+	return string(p)
+}
+
+// IsValid reports whether the ACL protocol is one of the known values.
+func (p AclProtocol) IsValid() bool {
+	switch p {
+	case AclProtocolTCP, AclProtocolTLS:
+		return true
+	default:
+		return false
+	}
+}
+
+// ParseAclProtocol converts a string into an AclProtocol, returning an error
+// if the value is not a known protocol.
+func ParseAclProtocol(s string) (AclProtocol, error) {
+	p := AclProtocol(s)
+	if !p.IsValid() {
+		return "", fmt.Errorf("invalid acl protocol: %s", s)
+	}
+	return p, nil
+}
+
+// String returns the string representation of the listener protocol.
+func (p ListenerProtocol) String() string {
+	return string(p)
+}
+
+// IsValid reports whether the listener protocol is one of the known values.
+func (p ListenerProtocol) IsValid() bool {
+	switch p {
+	case ListenerProtocolTCP, ListenerProtocolTLS:
+		return true
+	default:
+		return false
+	}
+}
+
+// ParseListenerProtocol converts a string into a ListenerProtocol, returning
+// an error if the value is not a known protocol.
+func ParseListenerProtocol(s string) (ListenerProtocol, error) {
+	p := ListenerProtocol(s)
+	if !p.IsValid() {
+		return "", fmt.Errorf("invalid listener protocol: %s", s)
+	}
+	return p, nil
+}
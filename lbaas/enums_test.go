@@ -0,0 +1,162 @@
+package lbaas
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestEnums_IsValid(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name  string
+		valid bool
+		got   bool
+	}{
+		{"acl action allow", true, AclActionTypeAllow.IsValid()},
+		{"acl action unknown", false, AclActionType("allow").IsValid()},
+		{"acl ether type ipv6", true, AclEtherTypeIPv6.IsValid()},
+		{"acl ether type unknown", false, AclEtherType("ipv4").IsValid()},
+		{"balance algorithm round robin", true, BackendBalanceAlgorithmRoundRobin.IsValid()},
+		{"balance algorithm unknown", false, BackendBalanceAlgorithm("least_conn").IsValid()},
+		{"backend type raw", true, BackendTypeRaw.IsValid()},
+		{"backend type unknown", false, BackendType("").IsValid()},
+		{"health check protocol http", true, HealthCheckProtocolHTTP.IsValid()},
+		{"health check protocol unknown", false, HealthCheckProtocol("udp").IsValid()},
+		{"load balancer status inactive", true, LoadBalancerStatusInactive.IsValid()},
+		{"load balancer status unknown", false, LoadBalancerStatus("stopped").IsValid()},
+		{"load balancer type proxy", true, LoadBalancerTypeProxy.IsValid()},
+		{"load balancer type unknown", false, LoadBalancerType("application").IsValid()},
+		{"visibility internal", true, LoadBalancerVisibilityInternal.IsValid()},
+		{"visibility unknown", false, LoadBalancerVisibility("private").IsValid()},
+		{"acl protocol tls", true, AclProtocolTLS.IsValid()},
+		{"acl protocol unknown", false, AclProtocol("udp").IsValid()},
+		{"listener protocol tcp", true, ListenerProtocolTCP.IsValid()},
+		{"listener protocol unknown", false, ListenerProtocol("http").IsValid()},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			assertEqual(t, tt.valid, tt.got)
+		})
+	}
+}
+
+func TestEnums_Parse(t *testing.T) {
+	t.Parallel()
+
+	t.Run("valid values", func(t *testing.T) {
+		t.Parallel()
+
+		action, err := ParseAclActionType("DENY")
+		assertNoError(t, err)
+		assertEqual(t, AclActionTypeDeny, action)
+
+		etherType, err := ParseAclEtherType("IPv4")
+		assertNoError(t, err)
+		assertEqual(t, AclEtherTypeIPv4, etherType)
+
+		algorithm, err := ParseBackendBalanceAlgorithm("round_robin")
+		assertNoError(t, err)
+		assertEqual(t, BackendBalanceAlgorithmRoundRobin, algorithm)
+
+		backendType, err := ParseBackendType("instance")
+		assertNoError(t, err)
+		assertEqual(t, BackendTypeInstance, backendType)
+
+		hcProtocol, err := ParseHealthCheckProtocol("tcp")
+		assertNoError(t, err)
+		assertEqual(t, HealthCheckProtocolTCP, hcProtocol)
+
+		status, err := ParseLoadBalancerStatus("running")
+		assertNoError(t, err)
+		assertEqual(t, LoadBalancerStatusRunning, status)
+
+		lbType, err := ParseLoadBalancerType("proxy")
+		assertNoError(t, err)
+		assertEqual(t, LoadBalancerTypeProxy, lbType)
+
+		visibility, err := ParseLoadBalancerVisibility("external")
+		assertNoError(t, err)
+		assertEqual(t, LoadBalancerVisibilityExternal, visibility)
+
+		aclProtocol, err := ParseAclProtocol("tcp")
+		assertNoError(t, err)
+		assertEqual(t, AclProtocolTCP, aclProtocol)
+
+		listenerProtocol, err := ParseListenerProtocol("tls")
+		assertNoError(t, err)
+		assertEqual(t, ListenerProtocolTLS, listenerProtocol)
+	})
+
+	t.Run("invalid values", func(t *testing.T) {
+		t.Parallel()
+
+		parsers := map[string]func(string) error{
+			"acl action":        func(s string) error { _, err := ParseAclActionType(s); return err },
+			"acl ether type":    func(s string) error { _, err := ParseAclEtherType(s); return err },
+			"balance algorithm": func(s string) error { _, err := ParseBackendBalanceAlgorithm(s); return err },
+			"backend type":      func(s string) error { _, err := ParseBackendType(s); return err },
+			"health check":      func(s string) error { _, err := ParseHealthCheckProtocol(s); return err },
+			"status":            func(s string) error { _, err := ParseLoadBalancerStatus(s); return err },
+			"type":              func(s string) error { _, err := ParseLoadBalancerType(s); return err },
+			"visibility":        func(s string) error { _, err := ParseLoadBalancerVisibility(s); return err },
+			"acl protocol":      func(s string) error { _, err := ParseAclProtocol(s); return err },
+			"listener protocol": func(s string) error { _, err := ParseListenerProtocol(s); return err },
+		}
+
+		for name, parse := range parsers {
+			if err := parse("bogus"); err == nil {
+				t.Errorf("%s: expected error for invalid value", name)
+			}
+		}
+	})
+
+	t.Run("string", func(t *testing.T) {
+		t.Parallel()
+		assertEqual(t, "running", LoadBalancerStatusRunning.String())
+		assertEqual(t, "tls", ListenerProtocolTLS.String())
+	})
+}
+
+func TestLoadBalancerStatus_UnmarshalJSON(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		input   string
+		want    LoadBalancerStatus
+		wantErr bool
+	}{
+		{name: "known status", input: `"running"`, want: LoadBalancerStatusRunning},
+		{name: "unknown status", input: `"hibernating"`, want: LoadBalancerStatusUnknown},
+		{name: "empty status", input: `""`, want: LoadBalancerStatusUnknown},
+		{name: "not a string", input: `42`, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var got LoadBalancerStatus
+			err := json.Unmarshal([]byte(tt.input), &got)
+			if tt.wantErr {
+				assertError(t, err)
+				return
+			}
+			assertNoError(t, err)
+			assertEqual(t, tt.want, got)
+		})
+	}
+
+	t.Run("inside response", func(t *testing.T) {
+		t.Parallel()
+
+		var lb NetworkLoadBalancerResponse
+		assertNoError(t, json.Unmarshal([]byte(`{"id":"lb-1","status":"migrating"}`), &lb))
+		assertEqual(t, LoadBalancerStatusUnknown, lb.Status)
+	})
+}
@@ -2,8 +2,11 @@ package lbaas
 
 import (
 	"context"
+	"fmt"
 	"net/http"
 
+	"github.com/MagaluCloud/mgc-sdk-go/client"
+	"github.com/MagaluCloud/mgc-sdk-go/helpers"
 	mgc_http "github.com/MagaluCloud/mgc-sdk-go/internal/http"
 )
 
@@ -22,12 +25,12 @@ type (
 	// NetworkAclResponse is the persisted form of an ACL rule, as returned by
 	// the API.
 	NetworkAclResponse struct {
-		ID             string       `json:"id"`
-		Name           *string      `json:"name,omitempty"`
-		Ethertype      AclEtherType `json:"ethertype"`
-		Protocol       AclProtocol  `json:"protocol"`
-		RemoteIPPrefix string       `json:"remote_ip_prefix"`
-		Action         string       `json:"action"`
+		ID             string        `json:"id"`
+		Name           *string       `json:"name,omitempty"`
+		Ethertype      AclEtherType  `json:"ethertype"`
+		Protocol       AclProtocol   `json:"protocol"`
+		RemoteIPPrefix string        `json:"remote_ip_prefix"`
+		Action         AclActionType `json:"action"`
 	}
 
 	UpdateNetworkACLRequest struct {
@@ -49,6 +52,10 @@ type (
 
 // Create creates a new network ACL rule
 func (s *networkACLService) Create(ctx context.Context, lbID string, req CreateNetworkACLRequest) (string, error) {
+	if err := helpers.ValidateCIDR(req.RemoteIPPrefix); err != nil {
+		return "", &client.ValidationError{Field: "remote_ip_prefix", Message: err.Error()}
+	}
+
 	path := urlNetworkLoadBalancer(&lbID, acls)
 	body := CreateNetworkACLRequest{
 		Name:           req.Name,
@@ -89,6 +96,10 @@ func (s *networkACLService) Delete(ctx context.Context, lbID, aclID string) erro
 
 // Replace updates the network ACL rules for a load balancer
 func (s *networkACLService) Replace(ctx context.Context, lbID string, req UpdateNetworkACLRequest) error {
+	if err := validateACLs(req.Acls); err != nil {
+		return err
+	}
+
 	path := urlNetworkLoadBalancer(&lbID, acls)
 
 	httpReq, err := s.client.newRequest(ctx, http.MethodPut, path, req)
@@ -99,3 +110,13 @@ func (s *networkACLService) Replace(ctx context.Context, lbID string, req Update
 	_, err = mgc_http.Do[any](s.client.GetConfig(), ctx, httpReq, nil)
 	return err
 }
+
+// validateACLs checks that every ACL rule has a valid remote IP prefix.
+func validateACLs(acls []CreateNetworkACLRequest) error {
+	for i, acl := range acls {
+		if err := helpers.ValidateCIDR(acl.RemoteIPPrefix); err != nil {
+			return &client.ValidationError{Field: fmt.Sprintf("acls[%d].remote_ip_prefix", i), Message: err.Error()}
+		}
+	}
+	return nil
+}
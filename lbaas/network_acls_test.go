@@ -297,3 +297,34 @@ func TestNetworkACLService_Replace_NewRequestError(t *testing.T) {
 		t.Error("expected error due to canceled context, got nil")
 	}
 }
+
+func TestNetworkACLService_InvalidRemoteIPPrefix(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+	}))
+	defer server.Close()
+
+	svc := testACLClient(server.URL)
+
+	_, err := svc.Create(context.Background(), "lb-123", CreateNetworkACLRequest{
+		Ethertype:      "IPv4",
+		Protocol:       "TCP",
+		RemoteIPPrefix: "192.168.1.300/24",
+		Action:         "allow",
+	})
+	if validationErr, ok := err.(*client.ValidationError); !ok || validationErr.Field != "remote_ip_prefix" {
+		t.Errorf("Create() expected ValidationError on remote_ip_prefix, got %v", err)
+	}
+
+	err = svc.Replace(context.Background(), "lb-123", UpdateNetworkACLRequest{
+		Acls: []CreateNetworkACLRequest{
+			{Ethertype: "IPv4", Protocol: "TCP", RemoteIPPrefix: "10.0.0.0/8", Action: "allow"},
+			{Ethertype: "IPv4", Protocol: "TCP", RemoteIPPrefix: "10.0.0.0", Action: "deny"},
+		},
+	})
+	if validationErr, ok := err.(*client.ValidationError); !ok || validationErr.Field != "acls[1].remote_ip_prefix" {
+		t.Errorf("Replace() expected ValidationError on acls[1].remote_ip_prefix, got %v", err)
+	}
+}
@@ -2,9 +2,11 @@ package lbaas
 
 import (
 	"context"
+	"fmt"
 	"net/http"
 	"time"
 
+	"github.com/MagaluCloud/mgc-sdk-go/client"
 	"github.com/MagaluCloud/mgc-sdk-go/helpers"
 	mgc_http "github.com/MagaluCloud/mgc-sdk-go/internal/http"
 )
@@ -12,6 +14,9 @@ import (
 const backends = "backends"
 
 type (
+	// NetworkBackendInstanceTargetRequest represents a backend target.
+	// For BackendTypeInstance backends set NicID with the network interface ID of the instance;
+	// for BackendTypeRaw backends set IPAddress. Setting both fields is rejected.
 	NetworkBackendInstanceTargetRequest struct {
 		NicID     *string `json:"nic_id,omitempty"`
 		IPAddress *string `json:"ip_address,omitempty"`
@@ -71,6 +76,8 @@ type (
 		List(ctx context.Context, lbID string, options ListNetworkLoadBalancerRequest) (NetworkPaginatedBackendResponse, error)
 		ListAll(ctx context.Context, lbID string) ([]NetworkBackendResponse, error)
 		Update(ctx context.Context, lbID, backendID string, req UpdateNetworkBackendRequest) (string, error)
+		AddTargets(ctx context.Context, lbID, backendID string, targets []NetworkBackendInstanceTargetRequest) ([]NetworkBackedTarget, error)
+		RemoveTargets(ctx context.Context, lbID, backendID string, targets []NetworkBackendInstanceTargetRequest) ([]NetworkBackedTarget, error)
 	}
 
 	// networkBackendService implements the NetworkBackendService interface
@@ -81,6 +88,12 @@ type (
 
 // Create creates a new network backend
 func (s *networkBackendService) Create(ctx context.Context, lbID string, req CreateBackendRequest) (string, error) {
+	if req.Targets != nil {
+		if err := validateBackendTargets(req.TargetsType, *req.Targets); err != nil {
+			return "", err
+		}
+	}
+
 	path := urlNetworkLoadBalancer(&lbID, backends)
 
 	httpReq, err := s.client.newRequest(ctx, http.MethodPost, path, req)
@@ -199,3 +212,133 @@ func (s *networkBackendService) Update(ctx context.Context, lbID, backendID stri
 	}
 	return result.ID, nil
 }
+
+// AddTargets registers new targets in a backend without replacing the existing ones
+// and returns the resulting target list. The targets type of the backend is kept.
+func (s *networkBackendService) AddTargets(ctx context.Context, lbID, backendID string, targets []NetworkBackendInstanceTargetRequest) ([]NetworkBackedTarget, error) {
+	if len(targets) == 0 {
+		return nil, &client.ValidationError{Field: "targets", Message: "at least one target is required"}
+	}
+
+	backend, err := s.Get(ctx, lbID, backendID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := validateBackendTargets(backend.TargetsType, targets); err != nil {
+		return nil, err
+	}
+
+	_, err = s.client.NetworkBackendTargets().Create(ctx, lbID, backendID, CreateNetworkBackendTargetRequest{
+		HealthCheckID: backend.HealthCheckID,
+		TargetsType:   backend.TargetsType,
+		Targets:       targets,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return s.listTargets(ctx, lbID, backendID)
+}
+
+// RemoveTargets removes the given targets from a backend and returns the remaining target list.
+// Targets are matched by NIC ID for instance backends and by IP address for raw backends,
+// and additionally by port when it is set.
+func (s *networkBackendService) RemoveTargets(ctx context.Context, lbID, backendID string, targets []NetworkBackendInstanceTargetRequest) ([]NetworkBackedTarget, error) {
+	if len(targets) == 0 {
+		return nil, &client.ValidationError{Field: "targets", Message: "at least one target is required"}
+	}
+
+	backend, err := s.Get(ctx, lbID, backendID)
+	if err != nil {
+		return nil, err
+	}
+
+	targetIDs := make([]string, 0, len(targets))
+	for _, target := range targets {
+		targetID, ok := findBackendTarget(backend.Targets, target)
+		if !ok {
+			return nil, &client.ValidationError{Field: "targets", Message: fmt.Sprintf("target %s not found in backend %s", describeTarget(target), backendID)}
+		}
+		targetIDs = append(targetIDs, targetID)
+	}
+
+	for _, targetID := range targetIDs {
+		if err := s.client.NetworkBackendTargets().Delete(ctx, lbID, backendID, targetID); err != nil {
+			return nil, err
+		}
+	}
+
+	return s.listTargets(ctx, lbID, backendID)
+}
+
+// listTargets returns the current targets of a backend
+func (s *networkBackendService) listTargets(ctx context.Context, lbID, backendID string) ([]NetworkBackedTarget, error) {
+	backend, err := s.Get(ctx, lbID, backendID)
+	if err != nil {
+		return nil, err
+	}
+	return backend.Targets, nil
+}
+
+// findBackendTarget returns the ID of the registered target matching the requested one
+func findBackendTarget(registered []NetworkBackedTarget, target NetworkBackendInstanceTargetRequest) (string, bool) {
+	for _, candidate := range registered {
+		if target.NicID != nil && (candidate.NicID == nil || *candidate.NicID != *target.NicID) {
+			continue
+		}
+		if target.IPAddress != nil && (candidate.IPAddress == nil || *candidate.IPAddress != *target.IPAddress) {
+			continue
+		}
+		if target.NicID == nil && target.IPAddress == nil {
+			continue
+		}
+		if target.Port != 0 && (candidate.Port == nil || *candidate.Port != target.Port) {
+			continue
+		}
+		return candidate.ID, true
+	}
+	return "", false
+}
+
+// describeTarget returns a human readable identifier for a target request
+func describeTarget(target NetworkBackendInstanceTargetRequest) string {
+	switch {
+	case target.NicID != nil:
+		return fmt.Sprintf("nic %s:%d", *target.NicID, target.Port)
+	case target.IPAddress != nil:
+		return fmt.Sprintf("%s:%d", *target.IPAddress, target.Port)
+	default:
+		return fmt.Sprintf("port %d", target.Port)
+	}
+}
+
+// validateBackendTargets checks that every target matches the backend targets type:
+// instance backends accept only NIC IDs and raw backends accept only IP addresses.
+func validateBackendTargets(targetsType BackendType, targets []NetworkBackendInstanceTargetRequest) error {
+	for i, target := range targets {
+		field := fmt.Sprintf("targets[%d]", i)
+
+		if target.NicID != nil && target.IPAddress != nil {
+			return &client.ValidationError{Field: field, Message: "nic_id and ip_address are mutually exclusive"}
+		}
+
+		switch targetsType {
+		case BackendTypeInstance:
+			if target.NicID == nil || *target.NicID == "" {
+				return &client.ValidationError{Field: field + ".nic_id", Message: "is required for instance targets"}
+			}
+		case BackendTypeRaw:
+			if target.IPAddress == nil || *target.IPAddress == "" {
+				return &client.ValidationError{Field: field + ".ip_address", Message: "is required for raw targets"}
+			}
+		default:
+			return &client.ValidationError{Field: "targets_type", Message: fmt.Sprintf("unsupported targets type %q", targetsType)}
+		}
+
+		if target.Port <= 0 || target.Port > 65535 {
+			return &client.ValidationError{Field: field + ".port", Message: "must be between 1 and 65535"}
+		}
+	}
+	return nil
+}
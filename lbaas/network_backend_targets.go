@@ -32,6 +32,10 @@ type (
 
 // Create adds new targets to a backend
 func (s *networkBackendTargetService) Create(ctx context.Context, lbID, backendID string, req CreateNetworkBackendTargetRequest) (string, error) {
+	if err := validateBackendTargets(req.TargetsType, req.Targets); err != nil {
+		return "", err
+	}
+
 	path := urlNetworkLoadBalancer(&lbID, backends, backendID, targets)
 
 	httpReq, err := s.client.newRequest(ctx, http.MethodPost, path, req)
@@ -51,6 +55,10 @@ func (s *networkBackendTargetService) Create(ctx context.Context, lbID, backendI
 
 // Replace replaces all targets in a backend
 func (s *networkBackendTargetService) Replace(ctx context.Context, lbID, backendID string, req CreateNetworkBackendTargetRequest) (string, error) {
+	if err := validateBackendTargets(req.TargetsType, req.Targets); err != nil {
+		return "", err
+	}
+
 	path := urlNetworkLoadBalancer(&lbID, backends, backendID, targets)
 
 	httpReq, err := s.client.newRequest(ctx, http.MethodPut, path, req)
@@ -2,11 +2,14 @@ package lbaas
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"io"
 	"net/http"
 	"net/http/httptest"
 	"strconv"
 	"strings"
+	"sync"
 	"testing"
 
 	"github.com/MagaluCloud/mgc-sdk-go/client"
@@ -62,7 +65,6 @@ func TestNetworkBackendService_Create(t *testing.T) {
 				CloseConnectionsOnHostHealthFailure: boolPtr(true),
 				Targets: &[]NetworkBackendInstanceTargetRequest{
 					{
-						NicID:     stringPtr("nic-123"),
 						IPAddress: stringPtr("192.168.1.10"),
 						Port:      8080,
 					},
@@ -790,6 +792,195 @@ func TestNetworkBackendService_Delete(t *testing.T) {
 	}
 }
 
+func TestNetworkBackendService_AddTargets(t *testing.T) {
+	t.Parallel()
+
+	var mu sync.Mutex
+	var posted string
+	registered := `{"id": "t-1", "ip_address": "10.0.0.1", "port": 80, "created_at": "2023-01-01T00:00:00Z", "updated_at": "2023-01-01T00:00:00Z"}`
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.Method == http.MethodPost && strings.HasSuffix(r.URL.Path, "/targets"):
+			body, _ := io.ReadAll(r.Body)
+			posted = string(body)
+			registered += `, {"id": "t-2", "ip_address": "10.0.0.2", "port": 80, "created_at": "2023-01-01T00:00:00Z", "updated_at": "2023-01-01T00:00:00Z"}`
+			w.Write([]byte(`{"id": "backend-123"}`))
+		case r.Method == http.MethodGet:
+			w.Write([]byte(`{"id": "backend-123", "targets_type": "raw", "targets": [` + registered + `]}`))
+		default:
+			w.WriteHeader(http.StatusMethodNotAllowed)
+		}
+	}))
+	defer server.Close()
+
+	client := testBackendClient(server.URL)
+	result, err := client.AddTargets(context.Background(), "lb-123", "backend-123", []NetworkBackendInstanceTargetRequest{
+		{IPAddress: stringPtr("10.0.0.2"), Port: 80},
+	})
+
+	assertNoError(t, err)
+	assertEqual(t, 2, len(result))
+	assertEqual(t, true, strings.Contains(posted, `"targets_type":"raw"`))
+	assertEqual(t, true, strings.Contains(posted, `"ip_address":"10.0.0.2"`))
+}
+
+func TestNetworkBackendService_RemoveTargets(t *testing.T) {
+	t.Parallel()
+
+	targetsJSON := `[
+		{"id": "t-1", "nic_id": "nic-1", "port": 80, "created_at": "2023-01-01T00:00:00Z", "updated_at": "2023-01-01T00:00:00Z"},
+		{"id": "t-2", "nic_id": "nic-2", "port": 80, "created_at": "2023-01-01T00:00:00Z", "updated_at": "2023-01-01T00:00:00Z"}
+	]`
+
+	tests := []struct {
+		name        string
+		targets     []NetworkBackendInstanceTargetRequest
+		wantDeleted []string
+		wantErr     bool
+	}{
+		{
+			name:        "remove instance target by nic",
+			targets:     []NetworkBackendInstanceTargetRequest{{NicID: stringPtr("nic-2"), Port: 80}},
+			wantDeleted: []string{"t-2"},
+		},
+		{
+			name:    "unknown target",
+			targets: []NetworkBackendInstanceTargetRequest{{NicID: stringPtr("nic-9"), Port: 80}},
+			wantErr: true,
+		},
+		{
+			name:    "no targets",
+			targets: nil,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var mu sync.Mutex
+			var deleted []string
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				mu.Lock()
+				defer mu.Unlock()
+				switch r.Method {
+				case http.MethodDelete:
+					deleted = append(deleted, r.URL.Path[strings.LastIndex(r.URL.Path, "/")+1:])
+					w.WriteHeader(http.StatusNoContent)
+				case http.MethodGet:
+					w.Header().Set("Content-Type", "application/json")
+					w.Write([]byte(`{"id": "backend-123", "targets_type": "instance", "targets": ` + targetsJSON + `}`))
+				}
+			}))
+			defer server.Close()
+
+			client := testBackendClient(server.URL)
+			_, err := client.RemoveTargets(context.Background(), "lb-123", "backend-123", tt.targets)
+
+			if tt.wantErr {
+				assertError(t, err)
+				assertEqual(t, 0, len(deleted))
+				return
+			}
+
+			assertNoError(t, err)
+			assertEqual(t, fmt.Sprint(tt.wantDeleted), fmt.Sprint(deleted))
+		})
+	}
+}
+
+func TestValidateBackendTargets(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name        string
+		targetsType BackendType
+		targets     []NetworkBackendInstanceTargetRequest
+		wantField   string
+	}{
+		{
+			name:        "valid instance targets",
+			targetsType: BackendTypeInstance,
+			targets:     []NetworkBackendInstanceTargetRequest{{NicID: stringPtr("nic-1"), Port: 80}},
+		},
+		{
+			name:        "valid raw targets",
+			targetsType: BackendTypeRaw,
+			targets:     []NetworkBackendInstanceTargetRequest{{IPAddress: stringPtr("10.0.0.1"), Port: 80}},
+		},
+		{
+			name:        "both nic and ip set",
+			targetsType: BackendTypeInstance,
+			targets:     []NetworkBackendInstanceTargetRequest{{NicID: stringPtr("nic-1"), IPAddress: stringPtr("10.0.0.1"), Port: 80}},
+			wantField:   "targets[0]",
+		},
+		{
+			name:        "instance target without nic",
+			targetsType: BackendTypeInstance,
+			targets:     []NetworkBackendInstanceTargetRequest{{IPAddress: stringPtr("10.0.0.1"), Port: 80}},
+			wantField:   "targets[0].nic_id",
+		},
+		{
+			name:        "raw target without ip",
+			targetsType: BackendTypeRaw,
+			targets:     []NetworkBackendInstanceTargetRequest{{Port: 80}, {NicID: stringPtr("nic-1"), Port: 80}},
+			wantField:   "targets[0].ip_address",
+		},
+		{
+			name:        "invalid port",
+			targetsType: BackendTypeRaw,
+			targets:     []NetworkBackendInstanceTargetRequest{{IPAddress: stringPtr("10.0.0.1"), Port: 0}},
+			wantField:   "targets[0].port",
+		},
+		{
+			name:        "unknown targets type",
+			targetsType: BackendType("vm"),
+			targets:     []NetworkBackendInstanceTargetRequest{{IPAddress: stringPtr("10.0.0.1"), Port: 80}},
+			wantField:   "targets_type",
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			err := validateBackendTargets(tt.targetsType, tt.targets)
+
+			if tt.wantField == "" {
+				assertNoError(t, err)
+				return
+			}
+
+			var validationErr *client.ValidationError
+			if !errors.As(err, &validationErr) {
+				t.Fatalf("expected *client.ValidationError, got %v", err)
+			}
+			assertEqual(t, tt.wantField, validationErr.Field)
+		})
+	}
+}
+
+func TestNetworkBackendService_Create_InvalidTargets(t *testing.T) {
+	t.Parallel()
+
+	client := testBackendClient("http://dummy-url")
+	_, err := client.Create(context.Background(), "lb-123", CreateBackendRequest{
+		Name:             "test-backend",
+		BalanceAlgorithm: BackendBalanceAlgorithmRoundRobin,
+		TargetsType:      BackendTypeInstance,
+		Targets: &[]NetworkBackendInstanceTargetRequest{
+			{IPAddress: stringPtr("10.0.0.1"), Port: 80},
+		},
+	})
+
+	assertError(t, err)
+}
+
 func TestNetworkBackendService_Create_NewRequestError(t *testing.T) {
 	t.Parallel()
 
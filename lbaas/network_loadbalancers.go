@@ -3,10 +3,12 @@ package lbaas
 import (
 	"context"
 	"errors"
+	"fmt"
 	"net/http"
 	"strconv"
 	"time"
 
+	"github.com/MagaluCloud/mgc-sdk-go/client"
 	"github.com/MagaluCloud/mgc-sdk-go/helpers"
 	mgc_http "github.com/MagaluCloud/mgc-sdk-go/internal/http"
 )
@@ -49,7 +51,7 @@ type (
 	CreateNetworkLoadBalancerRequest struct {
 		Name            string                            `json:"name"`
 		Description     *string                           `json:"description,omitempty"`
-		Type            *string                           `json:"type,omitempty"`
+		Type            *LoadBalancerType                 `json:"type,omitempty"`
 		Visibility      LoadBalancerVisibility            `json:"visibility"`
 		Listeners       []NetworkListenerRequest          `json:"listeners"`
 		Backends        []CreateNetworkBackendRequest     `json:"backends"`
@@ -69,7 +71,7 @@ type (
 
 	// ListNetworkLoadBalancerRequest defines pagination and sorting for listing.
 	// All fields are optional and map to query parameters.
-	// Sort format: "field:direction" (e.g., "created_at:desc").
+	// Sort format: "field:direction" (e.g., "created_at:desc"); build it with SortBy.
 	ListNetworkLoadBalancerRequest struct {
 		Offset *int    `json:"-"`
 		Limit  *int    `json:"-"`
@@ -108,7 +110,7 @@ type (
 		Name                string                          `json:"name"`
 		ProjectType         *string                         `json:"project_type,omitempty"`
 		Description         *string                         `json:"description,omitempty"`
-		Type                string                          `json:"type"`
+		Type                LoadBalancerType                `json:"type"`
 		Visibility          LoadBalancerVisibility          `json:"visibility"`
 		Status              LoadBalancerStatus              `json:"status"`
 		Listeners           []NetworkListenerResponse       `json:"listeners"`
@@ -161,6 +163,8 @@ type (
 		List(ctx context.Context, options ListNetworkLoadBalancerRequest) (NetworkLBPaginatedResponse, error)
 		ListAll(ctx context.Context) ([]NetworkLoadBalancerResponse, error)
 		Update(ctx context.Context, id string, loadBalancer UpdateNetworkLoadBalancerRequest) (string, error)
+		Rename(ctx context.Context, id string, newName string) error
+		Export(ctx context.Context, id string) (CreateNetworkLoadBalancerRequest, error)
 	}
 
 	// networkLoadBalancerService implements the NetworkLoadBalancerService interface.
@@ -172,6 +176,22 @@ type (
 
 // Create creates a new Network Load Balancer and returns its ID.
 func (s *networkLoadBalancerService) Create(ctx context.Context, create CreateNetworkLoadBalancerRequest) (string, error) {
+	if create.Type != nil && !create.Type.IsValid() {
+		return "", &client.ValidationError{Field: "type", Message: fmt.Sprintf("unsupported load balancer type %q", *create.Type)}
+	}
+
+	for _, backend := range create.Backends {
+		if backend.Targets != nil {
+			if err := validateBackendTargets(backend.TargetsType, *backend.Targets); err != nil {
+				return "", err
+			}
+		}
+	}
+
+	if err := validateACLs(create.ACLs); err != nil {
+		return "", err
+	}
+
 	path := urlNetworkLoadBalancer(nil)
 
 	httpReq, err := s.client.newRequest(ctx, http.MethodPost, path, create)
@@ -302,3 +322,140 @@ func (s *networkLoadBalancerService) Update(ctx context.Context, id string, load
 	}
 	return result.ID, nil
 }
+
+// Rename changes the name of a Load Balancer through its update endpoint
+func (s *networkLoadBalancerService) Rename(ctx context.Context, id string, newName string) error {
+	_, err := s.Update(ctx, id, UpdateNetworkLoadBalancerRequest{Name: &newName})
+	return err
+}
+
+// Export reconstructs a CreateNetworkLoadBalancerRequest from an existing Load Balancer,
+// including its listeners, backends, health checks, TLS certificates, and ACLs.
+// The result can be passed to Create to clone the Load Balancer, e.g. into another VPC.
+//
+// Certificate private keys cannot be exported: the returned TLSCertificates carry only
+// name and description, and Certificate/PrivateKey must be re-supplied before Create.
+// The public IP is not exported since it remains attached to the source Load Balancer.
+func (s *networkLoadBalancerService) Export(ctx context.Context, id string) (CreateNetworkLoadBalancerRequest, error) {
+	lb, err := s.Get(ctx, id)
+	if err != nil {
+		return CreateNetworkLoadBalancerRequest{}, err
+	}
+
+	req := CreateNetworkLoadBalancerRequest{
+		Name:         lb.Name,
+		Description:  lb.Description,
+		Visibility:   lb.Visibility,
+		VPCID:        lb.VPCID,
+		SubnetPoolID: lb.SubnetPoolID,
+		Listeners:    make([]NetworkListenerRequest, 0, len(lb.Listeners)),
+		Backends:     make([]CreateNetworkBackendRequest, 0, len(lb.Backends)),
+	}
+	if lb.Type != "" {
+		lbType := lb.Type
+		req.Type = &lbType
+	}
+
+	healthCheckNames := make(map[string]string, len(lb.HealthChecks))
+	for _, hc := range lb.HealthChecks {
+		healthCheckNames[hc.ID] = hc.Name
+		req.HealthChecks = append(req.HealthChecks, CreateNetworkHealthCheckRequest{
+			Name:                    hc.Name,
+			Description:             hc.Description,
+			Protocol:                hc.Protocol,
+			Path:                    hc.Path,
+			Port:                    hc.Port,
+			HealthyStatusCode:       &hc.HealthyStatusCode,
+			IntervalSeconds:         &hc.IntervalSeconds,
+			TimeoutSeconds:          &hc.TimeoutSeconds,
+			InitialDelaySeconds:     &hc.InitialDelaySeconds,
+			HealthyThresholdCount:   &hc.HealthyThresholdCount,
+			UnhealthyThresholdCount: &hc.UnhealthyThresholdCount,
+		})
+	}
+
+	backendNames := make(map[string]string, len(lb.Backends))
+	for _, backend := range lb.Backends {
+		backendNames[backend.ID] = backend.Name
+
+		exported := CreateNetworkBackendRequest{
+			Name:                                backend.Name,
+			Description:                         backend.Description,
+			BalanceAlgorithm:                    backend.BalanceAlgorithm,
+			PanicThreshold:                      backend.PanicThreshold,
+			TargetsType:                         backend.TargetsType,
+			CloseConnectionsOnHostHealthFailure: backend.CloseConnectionsOnHostHealthFailure,
+		}
+
+		if backend.HealthCheckID != nil {
+			name, ok := healthCheckNames[*backend.HealthCheckID]
+			if !ok {
+				return CreateNetworkLoadBalancerRequest{}, fmt.Errorf("backend %s references unknown health check %s", backend.Name, *backend.HealthCheckID)
+			}
+			exported.HealthCheckName = &name
+		}
+
+		if len(backend.Targets) > 0 {
+			targets := make([]NetworkBackendInstanceTargetRequest, 0, len(backend.Targets))
+			for _, target := range backend.Targets {
+				exportedTarget := NetworkBackendInstanceTargetRequest{
+					NicID:     target.NicID,
+					IPAddress: target.IPAddress,
+				}
+				if target.Port != nil {
+					exportedTarget.Port = *target.Port
+				}
+				targets = append(targets, exportedTarget)
+			}
+			exported.Targets = &targets
+		}
+
+		req.Backends = append(req.Backends, exported)
+	}
+
+	certificateNames := make(map[string]string, len(lb.TLSCertificates))
+	for _, cert := range lb.TLSCertificates {
+		certificateNames[cert.ID] = cert.Name
+		req.TLSCertificates = append(req.TLSCertificates, CreateNetworkCertificateRequest{
+			Name:        cert.Name,
+			Description: cert.Description,
+		})
+	}
+
+	for _, listener := range lb.Listeners {
+		backendName, ok := backendNames[listener.BackendID]
+		if !ok {
+			return CreateNetworkLoadBalancerRequest{}, fmt.Errorf("listener %s references unknown backend %s", listener.Name, listener.BackendID)
+		}
+
+		exported := NetworkListenerRequest{
+			BackendName: backendName,
+			Name:        listener.Name,
+			Description: listener.Description,
+			Protocol:    listener.Protocol,
+			Port:        listener.Port,
+		}
+
+		if listener.TLSCertificateID != nil {
+			name, ok := certificateNames[*listener.TLSCertificateID]
+			if !ok {
+				return CreateNetworkLoadBalancerRequest{}, fmt.Errorf("listener %s references unknown certificate %s", listener.Name, *listener.TLSCertificateID)
+			}
+			exported.TLSCertificateName = &name
+		}
+
+		req.Listeners = append(req.Listeners, exported)
+	}
+
+	for _, acl := range lb.ACLs {
+		req.ACLs = append(req.ACLs, CreateNetworkACLRequest{
+			Name:           acl.Name,
+			Ethertype:      acl.Ethertype,
+			Action:         acl.Action,
+			Protocol:       acl.Protocol,
+			RemoteIPPrefix: acl.RemoteIPPrefix,
+		})
+	}
+
+	return req, nil
+}
@@ -6,12 +6,14 @@ import (
 	"fmt"
 	"net/http"
 	"net/http/httptest"
+	"reflect"
 	"strconv"
 	"strings"
 	"testing"
 	"time"
 
 	"github.com/MagaluCloud/mgc-sdk-go/client"
+	"github.com/MagaluCloud/mgc-sdk-go/helpers"
 )
 
 // Helper functions
@@ -799,6 +801,25 @@ func TestNetworkLoadBalancerService_Update(t *testing.T) {
 	}
 }
 
+var _ helpers.Renamer = (NetworkLoadBalancerService)(nil)
+
+func TestNetworkLoadBalancerService_Rename(t *testing.T) {
+	t.Parallel()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assertEqual(t, "/load-balancer/v0beta1/network-load-balancers/lb-123", r.URL.Path)
+		assertEqual(t, http.MethodPut, r.Method)
+		var req UpdateNetworkLoadBalancerRequest
+		assertNoError(t, json.NewDecoder(r.Body).Decode(&req))
+		assertEqual(t, "renamed-lb", *req.Name)
+		assertEqual(t, true, req.Description == nil)
+		w.Write([]byte(`{"id": "lb-123"}`))
+	}))
+	defer server.Close()
+
+	err := testLoadBalancerClient(server.URL).Rename(context.Background(), "lb-123", "renamed-lb")
+	assertNoError(t, err)
+}
+
 func TestNetworkLoadBalancerService_Delete(t *testing.T) {
 	t.Parallel()
 	tests := []struct {
@@ -914,6 +935,100 @@ func boolPtr(b bool) *bool {
 	return &b
 }
 
+func TestNetworkLoadBalancerService_Export(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name       string
+		response   string
+		statusCode int
+		wantErr    bool
+	}{
+		{
+			name: "full load balancer",
+			response: `{
+				"id": "lb-123",
+				"name": "test-lb",
+				"type": "proxy",
+				"visibility": "external",
+				"status": "running",
+				"vpc_id": "vpc-123",
+				"public_ip": {"id": "pip-123", "ip_address": "200.0.0.1", "external_id": "ext-1"},
+				"health_checks": [{"id": "hc-1", "name": "web-hc", "protocol": "http", "path": "/health", "port": 80, "healthy_status_code": 200, "interval_seconds": 30}],
+				"backends": [{"id": "be-1", "name": "web", "balance_algorithm": "round_robin", "targets_type": "instance", "health_check_id": "hc-1",
+					"targets": [{"id": "t-1", "nic_id": "nic-1", "port": 8080}]}],
+				"tls_certificates": [{"id": "cert-1", "name": "web-cert"}],
+				"listeners": [{"id": "l-1", "name": "https", "backend_id": "be-1", "tls_certificate_id": "cert-1", "protocol": "tls", "port": 443}],
+				"acls": [{"id": "acl-1", "name": "allow-all", "ethertype": "IPv4", "protocol": "tcp", "remote_ip_prefix": "0.0.0.0/0", "action": "ALLOW"}]
+			}`,
+			statusCode: http.StatusOK,
+		},
+		{
+			name: "listener referencing unknown backend",
+			response: `{
+				"id": "lb-123",
+				"name": "test-lb",
+				"listeners": [{"id": "l-1", "name": "http", "backend_id": "be-missing", "protocol": "tcp", "port": 80}]
+			}`,
+			statusCode: http.StatusOK,
+			wantErr:    true,
+		},
+		{
+			name:       "load balancer not found",
+			response:   `{"error": "not found"}`,
+			statusCode: http.StatusNotFound,
+			wantErr:    true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assertEqual(t, "/load-balancer/v0beta1/network-load-balancers/lb-123", r.URL.Path)
+				assertEqual(t, http.MethodGet, r.Method)
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(tt.statusCode)
+				w.Write([]byte(tt.response))
+			}))
+			defer server.Close()
+
+			client := testLoadBalancerClient(server.URL)
+			req, err := client.Export(context.Background(), "lb-123")
+
+			if tt.wantErr {
+				assertError(t, err)
+				return
+			}
+
+			assertNoError(t, err)
+			assertEqual(t, "test-lb", req.Name)
+			assertEqual(t, LoadBalancerTypeProxy, *req.Type)
+			assertEqual(t, LoadBalancerVisibilityExternal, req.Visibility)
+			assertEqual(t, "vpc-123", req.VPCID)
+			assertEqual(t, true, req.PublicIPID == nil)
+
+			assertEqual(t, 1, len(req.Backends))
+			assertEqual(t, "web-hc", *req.Backends[0].HealthCheckName)
+			assertEqual(t, "nic-1", *(*req.Backends[0].Targets)[0].NicID)
+			assertEqual(t, int64(8080), (*req.Backends[0].Targets)[0].Port)
+
+			assertEqual(t, 1, len(req.Listeners))
+			assertEqual(t, "web", req.Listeners[0].BackendName)
+			assertEqual(t, "web-cert", *req.Listeners[0].TLSCertificateName)
+
+			assertEqual(t, 1, len(req.TLSCertificates))
+			assertEqual(t, "", req.TLSCertificates[0].PrivateKey)
+
+			assertEqual(t, 1, len(req.HealthChecks))
+			assertEqual(t, "/health", *req.HealthChecks[0].Path)
+
+			assertEqual(t, 1, len(req.ACLs))
+			assertEqual(t, AclActionTypeAllow, req.ACLs[0].Action)
+		})
+	}
+}
+
 func TestNetworkLoadBalancerService_ContextCancellation(t *testing.T) {
 	t.Parallel()
 
@@ -995,6 +1110,77 @@ func TestNetworkLoadBalancer_PublicIPJSONTag(t *testing.T) {
 	assertEqual(t, true, decoded.PublicIP == nil)
 }
 
+func TestCreateNetworkLoadBalancerRequest_JSONRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	lbType := LoadBalancerTypeProxy
+	original := CreateNetworkLoadBalancerRequest{
+		Name:        "test-lb",
+		Description: stringPtr("round trip"),
+		Type:        &lbType,
+		Visibility:  LoadBalancerVisibilityExternal,
+		VPCID:       "vpc-123",
+		PublicIPID:  stringPtr("pip-123"),
+		Listeners: []NetworkListenerRequest{
+			{Name: "https", BackendName: "web", TLSCertificateName: stringPtr("web-cert"), Protocol: ListenerProtocolTLS, Port: 443},
+		},
+		Backends: []CreateNetworkBackendRequest{
+			{
+				Name:                                "web",
+				HealthCheckName:                     stringPtr("web-hc"),
+				BalanceAlgorithm:                    BackendBalanceAlgorithmRoundRobin,
+				PanicThreshold:                      floatPtr(50),
+				TargetsType:                         BackendTypeRaw,
+				Targets:                             &[]NetworkBackendInstanceTargetRequest{{IPAddress: stringPtr("10.0.0.1"), Port: 80}},
+				CloseConnectionsOnHostHealthFailure: boolPtr(false),
+			},
+		},
+		HealthChecks: []CreateNetworkHealthCheckRequest{
+			{Name: "web-hc", Protocol: HealthCheckProtocolHTTP, Path: stringPtr("/health"), Port: 80, HealthyStatusCode: intPtr(200)},
+		},
+		TLSCertificates: []CreateNetworkCertificateRequest{
+			{Name: "web-cert", Certificate: "Y2VydA==", PrivateKey: "a2V5"},
+		},
+		ACLs: []CreateNetworkACLRequest{
+			{Name: stringPtr("allow"), Ethertype: AclEtherTypeIPv4, Action: AclActionTypeAllow, Protocol: AclProtocolTCP, RemoteIPPrefix: "0.0.0.0/0"},
+		},
+	}
+
+	data, err := json.Marshal(original)
+	assertNoError(t, err)
+
+	var decoded CreateNetworkLoadBalancerRequest
+	assertNoError(t, json.Unmarshal(data, &decoded))
+
+	if !reflect.DeepEqual(original, decoded) {
+		t.Errorf("round trip mismatch:\noriginal: %+v\ndecoded:  %+v", original, decoded)
+	}
+
+	again, err := json.Marshal(decoded)
+	assertNoError(t, err)
+	assertEqual(t, string(data), string(again))
+}
+
+func TestNetworkLoadBalancerService_Create_InvalidType(t *testing.T) {
+	t.Parallel()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Error("request should not be sent for an unknown load balancer type")
+	}))
+	defer server.Close()
+
+	lbType := LoadBalancerType("prxy")
+	client := testLoadBalancerClient(server.URL)
+	_, err := client.Create(context.Background(), CreateNetworkLoadBalancerRequest{
+		Name:       "test-lb",
+		Type:       &lbType,
+		Visibility: LoadBalancerVisibilityExternal,
+		VPCID:      "vpc-123",
+	})
+
+	assertError(t, err)
+	assertEqual(t, true, strings.Contains(err.Error(), "type"))
+}
+
 func TestNetworkLoadBalancerService_Create_NewRequestError(t *testing.T) {
 	t.Parallel()
 
@@ -1096,3 +1282,38 @@ func TestNetworkLoadBalancerService_Delete_NewRequestError(t *testing.T) {
 		t.Error("expected error due to canceled context, got nil")
 	}
 }
+
+func TestNetworkLoadBalancerService_GetNotFound(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name         string
+		statusCode   int
+		response     string
+		wantNotFound bool
+	}{
+		{name: "not found", statusCode: http.StatusNotFound, response: `{"message": "not found"}`, wantNotFound: true},
+		{name: "not found without body", statusCode: http.StatusNotFound, wantNotFound: true},
+		{name: "forbidden", statusCode: http.StatusForbidden, response: `{"message": "forbidden"}`},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(tt.statusCode)
+				w.Write([]byte(tt.response))
+			}))
+			defer server.Close()
+
+			_, err := testLoadBalancerClient(server.URL).Get(context.Background(), "res-1")
+			if err == nil {
+				t.Fatal("Get() error = nil, want an error")
+			}
+			if got := client.IsNotFound(err); got != tt.wantNotFound {
+				t.Errorf("IsNotFound(%v) = %v, want %v", err, got, tt.wantNotFound)
+			}
+		})
+	}
+}
@@ -0,0 +1,18 @@
+package lbaas
+
+import "github.com/MagaluCloud/mgc-sdk-go/helpers"
+
+// SortField is a field that load balancer resource listings can be sorted by.
+type SortField string
+
+const (
+	SortFieldName      SortField = "name"
+	SortFieldCreatedAt SortField = "created_at"
+	SortFieldUpdatedAt SortField = "updated_at"
+)
+
+// SortBy builds the value of a Sort option from a typed field and order,
+// e.g. SortBy(SortFieldCreatedAt, helpers.SortDescending).
+func SortBy(field SortField, order helpers.SortOrder) *string {
+	return helpers.SortBy(string(field), order)
+}
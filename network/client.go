@@ -30,6 +30,12 @@ func New(core *client.CoreClient) *NetworkClient {
 	return client
 }
 
+// NewDefault creates a new instance of NetworkClient using the client installed
+// with client.SetDefault. If no default client is set, returns nil.
+func NewDefault() *NetworkClient {
+	return New(client.Default())
+}
+
 // newRequest creates a new HTTP request with the network API base path
 func (c *NetworkClient) newRequest(ctx context.Context, method, path string, body any) (*http.Request, error) {
 	return mgc_http.NewRequest(c.GetConfig(), ctx, method, DefaultBasePath+path, &body)
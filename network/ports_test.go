@@ -3,6 +3,7 @@ package network
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
 	"net/http/httptest"
@@ -36,6 +37,14 @@ func assertNoError(t *testing.T, err error) {
 	}
 }
 
+func assertValidationField(t *testing.T, err error, field string) {
+	t.Helper()
+	var validationErr *client.ValidationError
+	if !errors.As(err, &validationErr) || validationErr.Field != field {
+		t.Errorf("Expected ValidationError on %s but got: %v", field, err)
+	}
+}
+
 func testClient(baseURL string) PortService {
 	httpClient := &http.Client{}
 	core := client.NewMgcClient(client.WithAPIKey("test-api-key"),
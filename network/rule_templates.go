@@ -0,0 +1,135 @@
+package network
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"slices"
+
+	"github.com/MagaluCloud/mgc-sdk-go/client"
+	"github.com/MagaluCloud/mgc-sdk-go/helpers"
+	"github.com/MagaluCloud/mgc-sdk-go/internal/utils"
+)
+
+// AnyIPv4 is the CIDR matching every IPv4 source. Templates have no default
+// source, so pass it explicitly to open a template to the whole internet.
+const AnyIPv4 = "0.0.0.0/0"
+
+type (
+	// TemplatePort represents a port range opened by a security group template
+	TemplatePort struct {
+		Protocol    string
+		Min         int
+		Max         int
+		Description string
+	}
+
+	// SecurityGroupTemplate represents a reusable set of ingress rules.
+	// Use NewSecurityGroupTemplate to build a custom one.
+	SecurityGroupTemplate struct {
+		name  string
+		ports []TemplatePort
+	}
+)
+
+// NewSecurityGroupTemplate creates a template opening the given ports
+func NewSecurityGroupTemplate(name string, ports ...TemplatePort) SecurityGroupTemplate {
+	return SecurityGroupTemplate{name: name, ports: slices.Clone(ports)}
+}
+
+// Name returns the name of the template
+func (t SecurityGroupTemplate) Name() string {
+	return t.name
+}
+
+// Ports returns a copy of the port ranges opened by the template
+func (t SecurityGroupTemplate) Ports() []TemplatePort {
+	return slices.Clone(t.ports)
+}
+
+// Prebuilt security group templates for common workloads
+var (
+	SecurityGroupTemplateSSH = NewSecurityGroupTemplate("ssh",
+		TemplatePort{Protocol: "tcp", Min: 22, Max: 22, Description: "Allow SSH access"},
+	)
+	SecurityGroupTemplateWebServer = NewSecurityGroupTemplate("web-server",
+		TemplatePort{Protocol: "tcp", Min: 80, Max: 80, Description: "Allow HTTP access"},
+		TemplatePort{Protocol: "tcp", Min: 443, Max: 443, Description: "Allow HTTPS access"},
+	)
+	SecurityGroupTemplatePostgreSQL = NewSecurityGroupTemplate("postgresql",
+		TemplatePort{Protocol: "tcp", Min: 5432, Max: 5432, Description: "Allow PostgreSQL access"},
+	)
+	SecurityGroupTemplateMySQL = NewSecurityGroupTemplate("mysql",
+		TemplatePort{Protocol: "tcp", Min: 3306, Max: 3306, Description: "Allow MySQL access"},
+	)
+)
+
+// SecurityGroupTemplates returns the prebuilt templates indexed by name. Each
+// call returns a new map.
+func SecurityGroupTemplates() map[string]SecurityGroupTemplate {
+	templates := make(map[string]SecurityGroupTemplate)
+	for _, t := range []SecurityGroupTemplate{
+		SecurityGroupTemplateSSH,
+		SecurityGroupTemplateWebServer,
+		SecurityGroupTemplatePostgreSQL,
+		SecurityGroupTemplateMySQL,
+	} {
+		templates[t.name] = t
+	}
+	return templates
+}
+
+// Rules builds the ingress rules of the template allowing traffic from sourceCIDR.
+// sourceCIDR is required; use AnyIPv4 to allow any IPv4 source. The ethertype
+// follows the CIDR family.
+func (t SecurityGroupTemplate) Rules(sourceCIDR string) ([]RuleCreateRequest, error) {
+	if sourceCIDR == "" {
+		return nil, &client.ValidationError{Field: "remote_ip_prefix", Message: utils.CannotBeEmpty}
+	}
+	if err := helpers.ValidateCIDR(sourceCIDR); err != nil {
+		return nil, &client.ValidationError{Field: "remote_ip_prefix", Message: err.Error()}
+	}
+
+	etherType := "IPv4"
+	if _, ipNet, _ := net.ParseCIDR(sourceCIDR); ipNet.IP.To4() == nil {
+		etherType = "IPv6"
+	}
+
+	rules := make([]RuleCreateRequest, 0, len(t.ports))
+	for _, port := range t.ports {
+		rules = append(rules, RuleCreateRequest{
+			Direction:      helpers.StrPtr("ingress"),
+			PortRangeMin:   helpers.IntPtr(port.Min),
+			PortRangeMax:   helpers.IntPtr(port.Max),
+			Protocol:       helpers.StrPtr(port.Protocol),
+			RemoteIPPrefix: helpers.StrPtr(sourceCIDR),
+			EtherType:      etherType,
+			Description:    helpers.StrPtr(port.Description),
+		})
+	}
+	return rules, nil
+}
+
+// ApplyTemplate creates the rules of a template in a security group, allowing
+// traffic from sourceCIDR. Rules are created in order and the IDs of the created
+// rules are returned, including when a later rule fails.
+func (s *ruleService) ApplyTemplate(ctx context.Context, securityGroupID string, template SecurityGroupTemplate, sourceCIDR string) ([]string, error) {
+	if securityGroupID == "" {
+		return nil, &client.ValidationError{Field: "securityGroupID", Message: utils.CannotBeEmpty}
+	}
+
+	rules, err := template.Rules(sourceCIDR)
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make([]string, 0, len(rules))
+	for i, rule := range rules {
+		id, err := s.Create(ctx, securityGroupID, rule)
+		if err != nil {
+			return ids, fmt.Errorf("template %s rule %d: %w", template.name, i, err)
+		}
+		ids = append(ids, id)
+	}
+	return ids, nil
+}
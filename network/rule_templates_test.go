@@ -0,0 +1,165 @@
+package network
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+)
+
+func TestSecurityGroupTemplate_Rules(t *testing.T) {
+	tests := []struct {
+		name          string
+		template      SecurityGroupTemplate
+		sourceCIDR    string
+		wantPorts     []int
+		wantPrefix    string
+		wantEtherType string
+	}{
+		{
+			name:          "any IPv4 source",
+			template:      SecurityGroupTemplateWebServer,
+			sourceCIDR:    AnyIPv4,
+			wantPorts:     []int{80, 443},
+			wantPrefix:    AnyIPv4,
+			wantEtherType: "IPv4",
+		},
+		{
+			name:          "restricted IPv4 source",
+			template:      SecurityGroupTemplateSSH,
+			sourceCIDR:    "10.0.0.0/8",
+			wantPorts:     []int{22},
+			wantPrefix:    "10.0.0.0/8",
+			wantEtherType: "IPv4",
+		},
+		{
+			name:          "IPv6 source",
+			template:      SecurityGroupTemplatePostgreSQL,
+			sourceCIDR:    "2001:db8::/32",
+			wantPorts:     []int{5432},
+			wantPrefix:    "2001:db8::/32",
+			wantEtherType: "IPv6",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rules, err := tt.template.Rules(tt.sourceCIDR)
+			assertNoError(t, err)
+			assertEqual(t, len(tt.wantPorts), len(rules))
+			for i, rule := range rules {
+				assertEqual(t, "ingress", *rule.Direction)
+				assertEqual(t, "tcp", *rule.Protocol)
+				assertEqual(t, tt.wantPorts[i], *rule.PortRangeMin)
+				assertEqual(t, tt.wantPorts[i], *rule.PortRangeMax)
+				assertEqual(t, tt.wantPrefix, *rule.RemoteIPPrefix)
+				assertEqual(t, tt.wantEtherType, rule.EtherType)
+			}
+		})
+	}
+}
+
+func TestSecurityGroupTemplate_RulesInvalidSource(t *testing.T) {
+	_, err := SecurityGroupTemplateSSH.Rules("10.0.0.1/8")
+	assertValidationField(t, err, "remote_ip_prefix")
+
+	for _, template := range SecurityGroupTemplates() {
+		_, err := template.Rules("")
+		assertValidationField(t, err, "remote_ip_prefix")
+	}
+}
+
+func TestSecurityGroupTemplates(t *testing.T) {
+	templates := SecurityGroupTemplates()
+	assertEqual(t, 4, len(templates))
+	for name, template := range templates {
+		assertEqual(t, name, template.Name())
+		if len(template.Ports()) == 0 {
+			t.Errorf("template %s has no ports", name)
+		}
+	}
+
+	delete(templates, "ssh")
+	SecurityGroupTemplateSSH.Ports()[0].Min = 2222
+	if _, ok := SecurityGroupTemplates()["ssh"]; !ok {
+		t.Error("deleting from the returned map changed the prebuilt templates")
+	}
+	assertEqual(t, 22, SecurityGroupTemplateSSH.Ports()[0].Min)
+}
+
+func TestNewSecurityGroupTemplate(t *testing.T) {
+	ports := []TemplatePort{{Protocol: "tcp", Min: 6379, Max: 6379, Description: "Allow Redis access"}}
+	template := NewSecurityGroupTemplate("redis", ports...)
+	ports[0].Min = 1
+
+	rules, err := template.Rules("10.0.0.0/8")
+	assertNoError(t, err)
+	assertEqual(t, 1, len(rules))
+	assertEqual(t, 6379, *rules[0].PortRangeMin)
+}
+
+func TestRuleService_ApplyTemplate(t *testing.T) {
+	var created atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assertEqual(t, "/network/v0/security_groups/sg1/rules", r.URL.Path)
+		assertEqual(t, http.MethodPost, r.Method)
+
+		var req RuleCreateRequest
+		assertNoError(t, json.NewDecoder(r.Body).Decode(&req))
+		assertEqual(t, "192.168.0.0/16", *req.RemoteIPPrefix)
+
+		n := created.Add(1)
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		json.NewEncoder(w).Encode(RuleCreateResponse{ID: fmt.Sprintf("rule-%d", n)})
+	}))
+	defer server.Close()
+
+	ids, err := testRulesClient(server.URL).ApplyTemplate(context.Background(), "sg1", SecurityGroupTemplateWebServer, "192.168.0.0/16")
+	assertNoError(t, err)
+	assertEqual(t, 2, len(ids))
+	assertEqual(t, "rule-1", ids[0])
+	assertEqual(t, "rule-2", ids[1])
+}
+
+func TestRuleService_ApplyTemplatePartialFailure(t *testing.T) {
+	var calls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if calls.Add(1) > 1 {
+			w.WriteHeader(http.StatusConflict)
+			w.Write([]byte(`{"error": "rule already exists"}`))
+			return
+		}
+		w.WriteHeader(http.StatusCreated)
+		w.Write([]byte(`{"id": "rule-1"}`))
+	}))
+	defer server.Close()
+
+	ids, err := testRulesClient(server.URL).ApplyTemplate(context.Background(), "sg1", SecurityGroupTemplateWebServer, AnyIPv4)
+	assertError(t, err)
+	assertEqual(t, true, strings.Contains(err.Error(), "web-server rule 1"))
+	assertEqual(t, 1, len(ids))
+	assertEqual(t, "rule-1", ids[0])
+}
+
+func TestRuleService_ApplyTemplateValidation(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+	}))
+	defer server.Close()
+
+	svc := testRulesClient(server.URL)
+	_, err := svc.ApplyTemplate(context.Background(), "", SecurityGroupTemplateSSH, "")
+	assertValidationField(t, err, "securityGroupID")
+
+	_, err = svc.ApplyTemplate(context.Background(), "sg1", SecurityGroupTemplateSSH, "not-a-cidr")
+	assertValidationField(t, err, "remote_ip_prefix")
+
+	_, err = svc.ApplyTemplate(context.Background(), "sg1", SecurityGroupTemplatePostgreSQL, "")
+	assertValidationField(t, err, "remote_ip_prefix")
+}
@@ -5,6 +5,8 @@ import (
 	"fmt"
 	"net/http"
 
+	"github.com/MagaluCloud/mgc-sdk-go/client"
+	"github.com/MagaluCloud/mgc-sdk-go/helpers"
 	mgc_http "github.com/MagaluCloud/mgc-sdk-go/internal/http"
 	"github.com/MagaluCloud/mgc-sdk-go/internal/utils"
 )
@@ -55,6 +57,7 @@ type RuleService interface {
 	Get(ctx context.Context, id string) (*RuleResponse, error)
 	Create(ctx context.Context, securityGroupID string, req RuleCreateRequest) (string, error)
 	Delete(ctx context.Context, id string) error
+	ApplyTemplate(ctx context.Context, securityGroupID string, template SecurityGroupTemplate, sourceCIDR string) ([]string, error)
 }
 
 // ruleService implements the RuleService interface
@@ -94,6 +97,12 @@ func (s *ruleService) Get(ctx context.Context, id string) (*RuleResponse, error)
 
 // Create creates a new rule in a security group
 func (s *ruleService) Create(ctx context.Context, securityGroupID string, req RuleCreateRequest) (string, error) {
+	if req.RemoteIPPrefix != nil {
+		if err := helpers.ValidateCIDR(*req.RemoteIPPrefix); err != nil {
+			return "", &client.ValidationError{Field: "remote_ip_prefix", Message: err.Error()}
+		}
+	}
+
 	result, err := mgc_http.ExecuteSimpleRequestWithRespBody[RuleCreateResponse](
 		ctx,
 		s.client.newRequest,
@@ -251,6 +251,20 @@ func TestRuleService_Create(t *testing.T) {
 	}
 }
 
+func TestRuleService_CreateInvalidRemoteIPPrefix(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+	}))
+	defer server.Close()
+
+	_, err := testRulesClient(server.URL).Create(context.Background(), "sg1", RuleCreateRequest{
+		Direction:      helpers.StrPtr("ingress"),
+		RemoteIPPrefix: helpers.StrPtr("192.168.0.1/24"),
+		EtherType:      "IPv4",
+	})
+	assertValidationField(t, err, "remote_ip_prefix")
+}
+
 func TestRuleService_Delete(t *testing.T) {
 	tests := []struct {
 		name       string
@@ -0,0 +1,18 @@
+package network
+
+import "github.com/MagaluCloud/mgc-sdk-go/helpers"
+
+// SortField is a field that VPC, subnet pool and NAT gateway listings can be sorted by.
+type SortField string
+
+const (
+	SortFieldName      SortField = "name"
+	SortFieldCreatedAt SortField = "created_at"
+	SortFieldUpdated   SortField = "updated"
+)
+
+// SortBy builds the value of a Sort option from a typed field and order,
+// e.g. SortBy(SortFieldCreatedAt, helpers.SortDescending).
+func SortBy(field SortField, order helpers.SortOrder) *string {
+	return helpers.SortBy(string(field), order)
+}
@@ -2,9 +2,13 @@ package network
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"net/http"
+	"net/netip"
 
+	"github.com/MagaluCloud/mgc-sdk-go/client"
+	"github.com/MagaluCloud/mgc-sdk-go/helpers"
 	mgc_http "github.com/MagaluCloud/mgc-sdk-go/internal/http"
 	"github.com/MagaluCloud/mgc-sdk-go/internal/utils"
 )
@@ -37,20 +41,25 @@ type (
 		DHCPPools      []DHCPPoolStr `json:"dhcp_pools"`
 	}
 
-	// DHCPPoolStr represents a DHCP pool configuration
+	// DHCPPoolStr represents a DHCP pool configuration, an inclusive range of
+	// addresses handed out to ports in a subnet
 	DHCPPoolStr struct {
 		Start string `json:"start"`
 		End   string `json:"end"`
 	}
 
-	// SubnetCreateRequest represents parameters for creating a new subnet
+	// SubnetCreateRequest represents parameters for creating a new subnet,
+	// GatewayIP and DHCPPools must fall within CIDRBlock
 	SubnetCreateRequest struct {
-		Name           string    `json:"name"`
-		Description    *string   `json:"description,omitempty"`
-		CIDRBlock      string    `json:"cidr_block"`
-		IPVersion      int       `json:"ip_version"`
-		DNSNameservers *[]string `json:"dns_nameservers,omitempty"`
-		SubnetPoolID   *string   `json:"subnetpool_id,omitempty"`
+		Name           string        `json:"name"`
+		Description    *string       `json:"description,omitempty"`
+		CIDRBlock      string        `json:"cidr_block"`
+		IPVersion      int           `json:"ip_version"`
+		DNSNameservers *[]string     `json:"dns_nameservers,omitempty"`
+		SubnetPoolID   *string       `json:"subnetpool_id,omitempty"`
+		GatewayIP      *string       `json:"gateway_ip,omitempty"`
+		EnableDHCP     *bool         `json:"enable_dhcp,omitempty"`
+		DHCPPools      []DHCPPoolStr `json:"dhcp_pools,omitempty"`
 	}
 
 	// SubnetCreateOptions represents additional options for subnet creation
@@ -60,7 +69,10 @@ type (
 
 	// SubnetPatchRequest represents parameters for updating a subnet
 	SubnetPatchRequest struct {
-		DNSNameservers *[]string `json:"dns_nameservers,omitempty"`
+		DNSNameservers *[]string      `json:"dns_nameservers,omitempty"`
+		GatewayIP      *string        `json:"gateway_ip,omitempty"`
+		EnableDHCP     *bool          `json:"enable_dhcp,omitempty"`
+		DHCPPools      *[]DHCPPoolStr `json:"dhcp_pools,omitempty"`
 	}
 
 	// SubnetCreateResponse represents the response after creating a subnet
@@ -74,6 +86,38 @@ type (
 	}
 )
 
+// Validate checks the request without calling the API: the name must be set,
+// CIDRBlock must be a valid prefix of IPVersion, and GatewayIP and
+// DHCPPools must fall within it. All problems found are returned
+// together as client.ValidationErrors.
+func (r SubnetCreateRequest) Validate() error {
+	var errs client.ValidationErrors
+	if r.Name == "" {
+		errs.Add("name", utils.CannotBeEmpty)
+	}
+	if r.IPVersion != 4 && r.IPVersion != 6 {
+		errs.Add("ip_version", "must be 4 or 6")
+	}
+
+	if err := helpers.ValidateCIDR(r.CIDRBlock); err != nil {
+		errs.Add("cidr_block", err.Error())
+		return errs.ErrOrNil()
+	}
+	prefix := netip.MustParsePrefix(r.CIDRBlock)
+	if (r.IPVersion == 4 && !prefix.Addr().Is4()) || (r.IPVersion == 6 && !prefix.Addr().Is6()) {
+		errs.Add("cidr_block", fmt.Sprintf("%s is not an IPv%d block", prefix, r.IPVersion))
+	}
+
+	if err := validateSubnetRanges(r.CIDRBlock, r.GatewayIP, r.DHCPPools); err != nil {
+		var validationErr *client.ValidationError
+		if !errors.As(err, &validationErr) {
+			return err
+		}
+		errs = append(errs, validationErr)
+	}
+	return errs.ErrOrNil()
+}
+
 // SubnetService provides operations for managing subnets
 type SubnetService interface {
 	Get(ctx context.Context, id string) (*SubnetResponseDetail, error)
@@ -114,6 +158,21 @@ func (s *subnetService) Delete(ctx context.Context, id string) error {
 
 // Update modifies subnet properties
 func (s *subnetService) Update(ctx context.Context, id string, req SubnetPatchRequest) (*SubnetResponseId, error) {
+	if req.GatewayIP != nil || req.DHCPPools != nil {
+		subnet, err := s.Get(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+
+		var pools []DHCPPoolStr
+		if req.DHCPPools != nil {
+			pools = *req.DHCPPools
+		}
+		if err := validateSubnetRanges(subnet.CIDRBlock, req.GatewayIP, pools); err != nil {
+			return nil, err
+		}
+	}
+
 	return mgc_http.ExecuteSimpleRequestWithRespBody[SubnetResponseId](
 		ctx,
 		s.client.newRequest,
@@ -124,3 +183,50 @@ func (s *subnetService) Update(ctx context.Context, id string, req SubnetPatchRe
 		nil,
 	)
 }
+
+// validateSubnetRanges checks that the gateway IP and every DHCP pool
+// fall within cidr and that each pool's start does not come after its end
+func validateSubnetRanges(cidr string, gatewayIP *string, pools []DHCPPoolStr) error {
+	if gatewayIP == nil && len(pools) == 0 {
+		return nil
+	}
+
+	prefix, err := netip.ParsePrefix(cidr)
+	if err != nil {
+		return &client.ValidationError{Field: "cidr_block", Message: fmt.Sprintf("invalid CIDR block %q", cidr)}
+	}
+
+	parseInPrefix := func(field, value string) (netip.Addr, error) {
+		addr, err := netip.ParseAddr(value)
+		if err != nil {
+			return netip.Addr{}, &client.ValidationError{Field: field, Message: fmt.Sprintf("invalid IP address %q", value)}
+		}
+		if !prefix.Contains(addr) {
+			return netip.Addr{}, &client.ValidationError{Field: field, Message: fmt.Sprintf("%s is not within %s", addr, prefix)}
+		}
+		return addr, nil
+	}
+
+	if gatewayIP != nil {
+		if _, err := parseInPrefix("gateway_ip", *gatewayIP); err != nil {
+			return err
+		}
+	}
+
+	for i, pool := range pools {
+		field := fmt.Sprintf("dhcp_pools[%d]", i)
+		start, err := parseInPrefix(field+".start", pool.Start)
+		if err != nil {
+			return err
+		}
+		end, err := parseInPrefix(field+".end", pool.End)
+		if err != nil {
+			return err
+		}
+		if end.Less(start) {
+			return &client.ValidationError{Field: field, Message: fmt.Sprintf("start %s is after end %s", start, end)}
+		}
+	}
+
+	return nil
+}
@@ -3,6 +3,7 @@ package network
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
 	"net/http/httptest"
@@ -256,6 +257,90 @@ func TestSubnetService_Update(t *testing.T) {
 	}
 }
 
+func TestValidateSubnetRanges(t *testing.T) {
+	tests := []struct {
+		name      string
+		cidr      string
+		gateway   *string
+		pools     []DHCPPoolStr
+		wantField string
+	}{
+		{name: "nothing to validate", cidr: ""},
+		{
+			name:    "valid gateway and pools",
+			cidr:    "10.0.0.0/24",
+			gateway: helpers.StrPtr("10.0.0.1"),
+			pools:   []DHCPPoolStr{{Start: "10.0.0.10", End: "10.0.0.100"}, {Start: "10.0.0.200", End: "10.0.0.200"}},
+		},
+		{name: "gateway outside cidr", cidr: "10.0.0.0/24", gateway: helpers.StrPtr("10.0.1.1"), wantField: "gateway_ip"},
+		{name: "invalid gateway", cidr: "10.0.0.0/24", gateway: helpers.StrPtr("gateway"), wantField: "gateway_ip"},
+		{
+			name:      "pool end outside cidr",
+			cidr:      "10.0.0.0/24",
+			pools:     []DHCPPoolStr{{Start: "10.0.0.10", End: "10.0.1.10"}},
+			wantField: "dhcp_pools[0].end",
+		},
+		{
+			name:      "pool start after end",
+			cidr:      "10.0.0.0/24",
+			pools:     []DHCPPoolStr{{Start: "10.0.0.10", End: "10.0.0.20"}, {Start: "10.0.0.50", End: "10.0.0.40"}},
+			wantField: "dhcp_pools[1]",
+		},
+		{name: "invalid cidr", cidr: "10.0.0.0", gateway: helpers.StrPtr("10.0.0.1"), wantField: "cidr_block"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateSubnetRanges(tt.cidr, tt.gateway, tt.pools)
+			if tt.wantField == "" {
+				assertNoError(t, err)
+				return
+			}
+			var validationErr *client.ValidationError
+			if !errors.As(err, &validationErr) {
+				t.Fatalf("expected ValidationError, got %v", err)
+			}
+			assertEqual(t, tt.wantField, validationErr.Field)
+		})
+	}
+}
+
+func TestSubnetService_UpdateDHCPPools(t *testing.T) {
+	var patched map[string]any
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.Method {
+		case http.MethodGet:
+			w.Write([]byte(`{"id": "subnet1", "cidr_block": "192.168.0.0/24"}`))
+		case http.MethodPatch:
+			assertNoError(t, json.NewDecoder(r.Body).Decode(&patched))
+			w.Write([]byte(`{"id": "subnet1"}`))
+		}
+	}))
+	defer server.Close()
+
+	svc := testSubnetClient(server.URL)
+
+	_, err := svc.Update(context.Background(), "subnet1", SubnetPatchRequest{
+		GatewayIP:  helpers.StrPtr("192.168.0.1"),
+		EnableDHCP: helpers.BoolPtr(true),
+		DHCPPools:  &[]DHCPPoolStr{{Start: "192.168.0.10", End: "192.168.0.50"}},
+	})
+	assertNoError(t, err)
+	assertEqual(t, "192.168.0.1", patched["gateway_ip"])
+	assertEqual(t, "192.168.0.10", patched["dhcp_pools"].([]any)[0].(map[string]any)["start"])
+	assertEqual(t, true, patched["enable_dhcp"])
+
+	patched = nil
+	_, err = svc.Update(context.Background(), "subnet1", SubnetPatchRequest{
+		DHCPPools: &[]DHCPPoolStr{{Start: "192.168.1.10", End: "192.168.1.50"}},
+	})
+	assertError(t, err)
+	if patched != nil {
+		t.Error("patch request should not be sent when validation fails")
+	}
+}
+
 func testSubnetClient(baseURL string) SubnetService {
 	httpClient := &http.Client{}
 	core := client.NewMgcClient(client.WithAPIKey("test-api-key"),
@@ -271,3 +356,21 @@ func assertEqualSlice(t *testing.T, want, got []string) {
 		assertEqual(t, want[i], got[i])
 	}
 }
+
+func TestSubnetCreateRequest_Validate(t *testing.T) {
+	valid := SubnetCreateRequest{Name: "web", CIDRBlock: "10.0.0.0/24", IPVersion: 4, GatewayIP: helpers.StrPtr("10.0.0.1")}
+	assertNoError(t, valid.Validate())
+
+	err := SubnetCreateRequest{CIDRBlock: "2001:db8::/64", IPVersion: 4, GatewayIP: helpers.StrPtr("10.0.0.1")}.Validate()
+	var errs client.ValidationErrors
+	if !errors.As(err, &errs) {
+		t.Fatalf("Validate() error = %T, want client.ValidationErrors", err)
+	}
+	want := []string{"name", "cidr_block", "gateway_ip"}
+	assertEqual(t, len(want), len(errs))
+	for i, field := range want {
+		if i < len(errs) {
+			assertEqual(t, field, errs[i].Field)
+		}
+	}
+}
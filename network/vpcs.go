@@ -4,9 +4,11 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"net/netip"
 	"net/url"
 	"strconv"
 
+	"github.com/MagaluCloud/mgc-sdk-go/client"
 	mgc_http "github.com/MagaluCloud/mgc-sdk-go/internal/http"
 	"github.com/MagaluCloud/mgc-sdk-go/internal/utils"
 )
@@ -58,9 +60,9 @@ type (
 
 	// ListOptions represents parameters for filtering and pagination
 	ListOptions struct {
-		Limit  *int
-		Offset *int
-		Sort   *string
+		Limit  *int    `json:"_limit,omitempty"`
+		Offset *int    `json:"_offset,omitempty"`
+		Sort   *string `json:"_sort,omitempty"`
 	}
 
 	// CreateVPCResponse represents the response after creating a VPC
@@ -77,6 +79,16 @@ type (
 		Subnets        *[]string `json:"subnets,omitempty"`
 		SecurityGroups *[]string `json:"security_groups_id,omitempty"`
 		IPAddress      *string   `json:"ip_address,omitempty"`
+		// FixedIPs requests deterministic private IPs. Each address must fall
+		// within the CIDR block of its subnet.
+		FixedIPs []FixedIPRequest `json:"fixed_ips,omitempty"`
+	}
+
+	// FixedIPRequest represents a fixed private IP assignment for a new port.
+	// When IPAddress is nil, an address is allocated from the subnet.
+	FixedIPRequest struct {
+		SubnetID  string  `json:"subnet_id"`
+		IPAddress *string `json:"ip_address,omitempty"`
 	}
 
 	// PortCreateOptions represents additional options for port creation
@@ -195,6 +207,8 @@ const (
 type VPCService interface {
 	List(ctx context.Context) ([]VPC, error)
 	Get(ctx context.Context, id string) (*VPC, error)
+	GetMany(ctx context.Context, ids []string) (map[string]*VPC, []error)
+	GetOrNil(ctx context.Context, id string) (*VPC, error)
 	Create(ctx context.Context, req CreateVPCRequest) (string, error)
 	Delete(ctx context.Context, id string) error
 	Rename(ctx context.Context, id string, newName string) error
@@ -242,8 +256,25 @@ func (s *vpcService) Get(ctx context.Context, id string) (*VPC, error) {
 	)
 }
 
+// GetMany retrieves several VPCs concurrently.
+// It returns the VPCs that were found, keyed by ID, and one error per ID
+// that could not be retrieved. Each error is prefixed with its ID.
+func (s *vpcService) GetMany(ctx context.Context, ids []string) (map[string]*VPC, []error) {
+	return utils.GetMany(ctx, ids, utils.DefaultGetManyConcurrency, s.Get)
+}
+
+// GetOrNil retrieves a specific VPC, returning (nil, nil) when it does not
+// exist. Errors are reserved for real failures.
+func (s *vpcService) GetOrNil(ctx context.Context, id string) (*VPC, error) {
+	return utils.NilIfNotFound(s.Get(ctx, id))
+}
+
 // Create provisions a new VPC
 func (s *vpcService) Create(ctx context.Context, req CreateVPCRequest) (string, error) {
+	if req.Name == "" {
+		return "", &client.ValidationError{Field: "name", Message: utils.CannotBeEmpty}
+	}
+
 	result, err := mgc_http.ExecuteSimpleRequestWithRespBody[CreateVPCResponse](
 		ctx,
 		s.client.newRequest,
@@ -309,6 +340,10 @@ func (s *vpcService) ListPorts(ctx context.Context, vpcID string, detailed bool,
 
 // CreatePort creates a new port in a VPC
 func (s *vpcService) CreatePort(ctx context.Context, vpcID string, req PortCreateRequest, opts PortCreateOptions) (string, error) {
+	if err := s.validateFixedIPs(ctx, req.FixedIPs); err != nil {
+		return "", err
+	}
+
 	nreq, err := s.client.newRequest(ctx, http.MethodPost, fmt.Sprintf("/v0/vpcs/%s/ports", vpcID), req)
 	if err != nil {
 		return "", err
@@ -326,6 +361,43 @@ func (s *vpcService) CreatePort(ctx context.Context, vpcID string, req PortCreat
 	return result.ID, nil
 }
 
+// validateFixedIPs checks that each requested fixed IP is a valid address
+// within the CIDR block of its subnet
+func (s *vpcService) validateFixedIPs(ctx context.Context, fixedIPs []FixedIPRequest) error {
+	for i, fixedIP := range fixedIPs {
+		field := fmt.Sprintf("fixed_ips[%d]", i)
+		if fixedIP.SubnetID == "" {
+			return &client.ValidationError{Field: field + ".subnet_id", Message: utils.CannotBeEmpty}
+		}
+		if fixedIP.IPAddress == nil {
+			continue
+		}
+
+		addr, err := netip.ParseAddr(*fixedIP.IPAddress)
+		if err != nil {
+			return &client.ValidationError{Field: field + ".ip_address", Message: fmt.Sprintf("invalid IP address %q", *fixedIP.IPAddress)}
+		}
+
+		subnet, err := s.client.Subnets().Get(ctx, fixedIP.SubnetID)
+		if err != nil {
+			return err
+		}
+
+		prefix, err := netip.ParsePrefix(subnet.CIDRBlock)
+		if err != nil {
+			return fmt.Errorf("subnet %s has invalid CIDR block %q: %w", fixedIP.SubnetID, subnet.CIDRBlock, err)
+		}
+		if !prefix.Contains(addr) {
+			return &client.ValidationError{
+				Field:   field + ".ip_address",
+				Message: fmt.Sprintf("%s is not within subnet %s (%s)", addr, fixedIP.SubnetID, subnet.CIDRBlock),
+			}
+		}
+	}
+
+	return nil
+}
+
 // ListPublicIPs returns all public IPs for a VPC
 func (s *vpcService) ListPublicIPs(ctx context.Context, vpcID string) ([]PublicIPDb, error) {
 	result, err := mgc_http.ExecuteSimpleRequestWithRespBody[PublicIPsList](
@@ -379,6 +451,10 @@ func (s *vpcService) ListSubnets(ctx context.Context, vpcID string) ([]SubnetRes
 
 // CreateSubnet creates a new subnet in a VPC
 func (s *vpcService) CreateSubnet(ctx context.Context, vpcID string, req SubnetCreateRequest, opts SubnetCreateOptions) (string, error) {
+	if err := req.Validate(); err != nil {
+		return "", err
+	}
+
 	nreq, err := s.client.newRequest(ctx, http.MethodPost, fmt.Sprintf("/v0/vpcs/%s/subnets", vpcID), req)
 	if err != nil {
 		return "", err
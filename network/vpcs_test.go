@@ -1,11 +1,15 @@
 package network
 
 import (
+	"bytes"
 	"context"
+	"encoding/gob"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
 	"net/http/httptest"
+	"reflect"
 	"strconv"
 	"strings"
 	"testing"
@@ -24,6 +28,7 @@ func TestVPCService_Create(t *testing.T) {
 		statusCode int
 		wantID     string
 		wantErr    bool
+		wantField  string
 	}{
 		{
 			name: "successful create",
@@ -41,9 +46,8 @@ func TestVPCService_Create(t *testing.T) {
 			request: CreateVPCRequest{
 				Description: helpers.StrPtr("Invalid VPC"),
 			},
-			response:   `{"error": "name is required"}`,
-			statusCode: http.StatusBadRequest,
-			wantErr:    true,
+			wantErr:   true,
+			wantField: "name",
 		},
 	}
 
@@ -70,6 +74,10 @@ func TestVPCService_Create(t *testing.T) {
 			client := testVPCClient(server.URL)
 			id, err := client.Create(context.Background(), tt.request)
 
+			if tt.wantField != "" {
+				assertValidationField(t, err, tt.wantField)
+				return
+			}
 			if tt.wantErr {
 				assertError(t, err)
 				assertEqual(t, true, strings.Contains(err.Error(), strconv.Itoa(tt.statusCode)))
@@ -133,6 +141,8 @@ func TestVPCService_Delete(t *testing.T) {
 	}
 }
 
+var _ helpers.Renamer = (VPCService)(nil)
+
 func TestVPCService_Rename(t *testing.T) {
 	tests := []struct {
 		name       string
@@ -402,6 +412,83 @@ func TestVPCService_CreatePort(t *testing.T) {
 	}
 }
 
+func TestVPCService_CreatePort_FixedIPs(t *testing.T) {
+	tests := []struct {
+		name      string
+		fixedIPs  []FixedIPRequest
+		wantErr   bool
+		wantField string
+		wantPost  bool
+	}{
+		{
+			name:     "address within subnet",
+			fixedIPs: []FixedIPRequest{{SubnetID: "subnet1", IPAddress: helpers.StrPtr("10.0.1.20")}},
+			wantPost: true,
+		},
+		{
+			name:     "subnet only",
+			fixedIPs: []FixedIPRequest{{SubnetID: "subnet1"}},
+			wantPost: true,
+		},
+		{
+			name:      "address outside subnet",
+			fixedIPs:  []FixedIPRequest{{SubnetID: "subnet1", IPAddress: helpers.StrPtr("10.0.2.20")}},
+			wantErr:   true,
+			wantField: "fixed_ips[0].ip_address",
+		},
+		{
+			name:      "invalid address",
+			fixedIPs:  []FixedIPRequest{{SubnetID: "subnet1", IPAddress: helpers.StrPtr("10.0.1.300")}},
+			wantErr:   true,
+			wantField: "fixed_ips[0].ip_address",
+		},
+		{
+			name:      "missing subnet",
+			fixedIPs:  []FixedIPRequest{{SubnetID: "subnet1"}, {IPAddress: helpers.StrPtr("10.0.1.21")}},
+			wantErr:   true,
+			wantField: "fixed_ips[1].subnet_id",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			posted := false
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				switch r.URL.Path {
+				case "/network/v0/subnets/subnet1":
+					w.Write([]byte(`{"id": "subnet1", "cidr_block": "10.0.1.0/24"}`))
+				case "/network/v0/vpcs/vpc1/ports":
+					posted = true
+					var req PortCreateRequest
+					assertNoError(t, json.NewDecoder(r.Body).Decode(&req))
+					assertEqual(t, len(tt.fixedIPs), len(req.FixedIPs))
+					w.WriteHeader(http.StatusCreated)
+					w.Write([]byte(`{"id": "port-new"}`))
+				default:
+					t.Errorf("unexpected request %s", r.URL.Path)
+				}
+			}))
+			defer server.Close()
+
+			_, err := testVPCClient(server.URL).CreatePort(context.Background(), "vpc1", PortCreateRequest{
+				Name:     "db-port",
+				FixedIPs: tt.fixedIPs,
+			}, PortCreateOptions{})
+
+			if tt.wantErr {
+				var validationErr *client.ValidationError
+				if !errors.As(err, &validationErr) || validationErr.Field != tt.wantField {
+					t.Errorf("expected ValidationError on %s, got %v", tt.wantField, err)
+				}
+			} else {
+				assertNoError(t, err)
+			}
+			assertEqual(t, tt.wantPost, posted)
+		})
+	}
+}
+
 func TestVPCService_CreatePort_AdditionalCases(t *testing.T) {
 	tests := []struct {
 		name       string
@@ -707,6 +794,7 @@ func TestVPCService_CreateSubnet(t *testing.T) {
 		statusCode int
 		wantID     string
 		wantErr    bool
+		wantField  string
 	}{
 		{
 			name:  "successful create",
@@ -730,11 +818,11 @@ func TestVPCService_CreateSubnet(t *testing.T) {
 			request: SubnetCreateRequest{
 				Name:      "invalid",
 				CIDRBlock: "invalid",
+				IPVersion: 4,
 			},
-			opts:       SubnetCreateOptions{},
-			response:   `{"error": "invalid CIDR"}`,
-			statusCode: http.StatusBadRequest,
-			wantErr:    true,
+			opts:      SubnetCreateOptions{},
+			wantErr:   true,
+			wantField: "cidr_block",
 		},
 	}
 
@@ -766,6 +854,10 @@ func TestVPCService_CreateSubnet(t *testing.T) {
 			client := testVPCClient(server.URL)
 			id, err := client.CreateSubnet(context.Background(), tt.vpcID, tt.request, tt.opts)
 
+			if tt.wantField != "" {
+				assertValidationField(t, err, tt.wantField)
+				return
+			}
 			if tt.wantErr {
 				assertError(t, err)
 				assertEqual(t, true, strings.Contains(err.Error(), strconv.Itoa(tt.statusCode)))
@@ -778,6 +870,42 @@ func TestVPCService_CreateSubnet(t *testing.T) {
 	}
 }
 
+func TestVPCService_CreateSubnet_DHCPPools(t *testing.T) {
+	var created SubnetCreateRequest
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assertNoError(t, json.NewDecoder(r.Body).Decode(&created))
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		w.Write([]byte(`{"id": "subnet-new"}`))
+	}))
+	defer server.Close()
+
+	svc := testVPCClient(server.URL)
+	req := SubnetCreateRequest{
+		Name:       "db-subnet",
+		CIDRBlock:  "10.10.0.0/24",
+		IPVersion:  4,
+		GatewayIP:  helpers.StrPtr("10.10.0.1"),
+		EnableDHCP: helpers.BoolPtr(false),
+		DHCPPools:  []DHCPPoolStr{{Start: "10.10.0.100", End: "10.10.0.150"}},
+	}
+
+	id, err := svc.CreateSubnet(context.Background(), "vpc1", req, SubnetCreateOptions{})
+	assertNoError(t, err)
+	assertEqual(t, "subnet-new", id)
+	assertEqual(t, "10.10.0.1", *created.GatewayIP)
+	assertEqual(t, false, *created.EnableDHCP)
+	assertEqual(t, "10.10.0.100", created.DHCPPools[0].Start)
+
+	req.DHCPPools = []DHCPPoolStr{{Start: "10.10.1.100", End: "10.10.1.150"}}
+	_, err = svc.CreateSubnet(context.Background(), "vpc1", req, SubnetCreateOptions{})
+	var validationErr *client.ValidationError
+	if !errors.As(err, &validationErr) {
+		t.Fatalf("expected ValidationError, got %v", err)
+	}
+	assertEqual(t, "dhcp_pools[0].start", validationErr.Field)
+}
+
 func TestVPCService_CreateSubnet_AdditionalCases(t *testing.T) {
 	tests := []struct {
 		name       string
@@ -788,6 +916,7 @@ func TestVPCService_CreateSubnet_AdditionalCases(t *testing.T) {
 		statusCode int
 		wantID     string
 		wantErr    bool
+		wantField  string
 	}{
 		{
 			name:  "create IPv6 subnet",
@@ -842,10 +971,9 @@ func TestVPCService_CreateSubnet_AdditionalCases(t *testing.T) {
 				CIDRBlock: "10.0.0.0/24",
 				IPVersion: 5, // Invalid IP version
 			},
-			opts:       SubnetCreateOptions{},
-			response:   `{"error": "invalid IP version: must be 4 or 6"}`,
-			statusCode: http.StatusBadRequest,
-			wantErr:    true,
+			opts:      SubnetCreateOptions{},
+			wantErr:   true,
+			wantField: "ip_version",
 		},
 	}
 
@@ -881,6 +1009,10 @@ func TestVPCService_CreateSubnet_AdditionalCases(t *testing.T) {
 			client := testVPCClient(server.URL)
 			id, err := client.CreateSubnet(context.Background(), tt.vpcID, tt.request, tt.opts)
 
+			if tt.wantField != "" {
+				assertValidationField(t, err, tt.wantField)
+				return
+			}
 			if tt.wantErr {
 				assertError(t, err)
 				assertEqual(t, true, strings.Contains(err.Error(), strconv.Itoa(tt.statusCode)))
@@ -1074,3 +1206,147 @@ func TestVPCService_Get(t *testing.T) {
 		})
 	}
 }
+
+func TestVPCService_GetMany(t *testing.T) {
+	t.Parallel()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		id := strings.TrimPrefix(r.URL.Path, "/network/v0/vpcs/")
+
+		w.Header().Set("Content-Type", "application/json")
+		if id == "missing" {
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"error": "vpc not found"}`))
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprintf(w, `{"id": %q, "name": "vpc-%s"}`, id, id)
+	}))
+	defer server.Close()
+
+	vpcClient := testVPCClient(server.URL)
+	vpcs, errs := vpcClient.GetMany(context.Background(), []string{"vpc1", "vpc2", "missing", "vpc1"})
+
+	assertEqual(t, 2, len(vpcs))
+	assertEqual(t, "vpc-vpc1", *vpcs["vpc1"].Name)
+	assertEqual(t, "vpc-vpc2", *vpcs["vpc2"].Name)
+	assertEqual(t, 1, len(errs))
+
+	var httpErr *client.HTTPError
+	if !errors.As(errs[0], &httpErr) {
+		t.Fatalf("expected HTTPError, got %T", errs[0])
+	}
+	assertEqual(t, http.StatusNotFound, httpErr.StatusCode)
+}
+
+func TestVPCService_GetNotFound(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name         string
+		statusCode   int
+		response     string
+		wantNotFound bool
+	}{
+		{name: "not found", statusCode: http.StatusNotFound, response: `{"message": "not found"}`, wantNotFound: true},
+		{name: "not found without body", statusCode: http.StatusNotFound, wantNotFound: true},
+		{name: "forbidden", statusCode: http.StatusForbidden, response: `{"message": "forbidden"}`},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(tt.statusCode)
+				w.Write([]byte(tt.response))
+			}))
+			defer server.Close()
+
+			_, err := testVPCClient(server.URL).Get(context.Background(), "res-1")
+			if err == nil {
+				t.Fatal("Get() error = nil, want an error")
+			}
+			if got := client.IsNotFound(err); got != tt.wantNotFound {
+				t.Errorf("IsNotFound(%v) = %v, want %v", err, got, tt.wantNotFound)
+			}
+		})
+	}
+}
+
+func TestVPCService_GetOrNil(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name       string
+		statusCode int
+		response   string
+		wantNil    bool
+		wantErr    bool
+	}{
+		{name: "exists", statusCode: http.StatusOK, response: `{"id": "vpc-1"}`},
+		{name: "not found", statusCode: http.StatusNotFound, response: `{"message": "not found"}`, wantNil: true},
+		{name: "forbidden", statusCode: http.StatusForbidden, response: `{"message": "forbidden"}`, wantNil: true, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(tt.statusCode)
+				w.Write([]byte(tt.response))
+			}))
+			defer server.Close()
+
+			got, err := testVPCClient(server.URL).GetOrNil(context.Background(), "vpc-1")
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("GetOrNil() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if (got == nil) != tt.wantNil {
+				t.Fatalf("GetOrNil() = %v, wantNil %v", got, tt.wantNil)
+			}
+			if got != nil && (got.ID == nil || *got.ID != "vpc-1") {
+				t.Errorf("GetOrNil() ID = %v, want vpc-1", got.ID)
+			}
+		})
+	}
+}
+
+func TestListOptions_Serialization(t *testing.T) {
+	t.Parallel()
+
+	opts := ListOptions{
+		Limit:  helpers.IntPtr(10),
+		Offset: helpers.IntPtr(20),
+		Sort:   helpers.StrPtr("name:asc"),
+	}
+
+	data, err := json.Marshal(opts)
+	if err != nil {
+		t.Fatalf("json.Marshal() error = %v", err)
+	}
+	want := `{"_limit":10,"_offset":20,"_sort":"name:asc"}`
+	if string(data) != want {
+		t.Errorf("json.Marshal() = %s, want %s", data, want)
+	}
+
+	var fromJSON ListOptions
+	if err := json.Unmarshal(data, &fromJSON); err != nil {
+		t.Fatalf("json.Unmarshal() error = %v", err)
+	}
+	if !reflect.DeepEqual(fromJSON, opts) {
+		t.Errorf("JSON round trip = %+v, want %+v", fromJSON, opts)
+	}
+
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(opts); err != nil {
+		t.Fatalf("gob Encode() error = %v", err)
+	}
+	var fromGob ListOptions
+	if err := gob.NewDecoder(&buf).Decode(&fromGob); err != nil {
+		t.Fatalf("gob Decode() error = %v", err)
+	}
+	if !reflect.DeepEqual(fromGob, opts) {
+		t.Errorf("gob round trip = %+v, want %+v", fromGob, opts)
+	}
+}
@@ -17,12 +17,24 @@ type LockConfig struct {
 	Unit     *string
 }
 
+// BucketCreateOption customizes how a bucket is created.
+type BucketCreateOption func(*minio.MakeBucketOptions)
+
+// WithBucketObjectLocking creates the bucket with object locking enabled.
+// Object locking can only be enabled when the bucket is created.
+func WithBucketObjectLocking() BucketCreateOption {
+	return func(o *minio.MakeBucketOptions) {
+		o.ObjectLocking = true
+	}
+}
+
 // BucketService provides operations for managing buckets.
 type BucketService interface {
-	Create(ctx context.Context, bucketName string) error
+	Create(ctx context.Context, bucketName string, opts ...BucketCreateOption) error
+	EnsureExists(ctx context.Context, bucketName string, opts ...BucketCreateOption) (bool, error)
 	List(ctx context.Context) ([]Bucket, error)
 	Exists(ctx context.Context, bucketName string) (bool, error)
-	Delete(ctx context.Context, bucketName string, recursive bool) error
+	Delete(ctx context.Context, bucketName string, force bool) error
 	GetPolicy(ctx context.Context, bucketName string) (*Policy, error)
 	SetPolicy(ctx context.Context, bucketName string, policy *Policy) error
 	DeletePolicy(ctx context.Context, bucketName string) error
@@ -36,6 +48,11 @@ type BucketService interface {
 	EnableVersioning(ctx context.Context, bucketName string) error
 	SuspendVersioning(ctx context.Context, bucketName string) error
 	GetVersioningStatus(ctx context.Context, bucketName string) (*BucketVersioningConfiguration, error)
+	SetReplication(ctx context.Context, bucketName string, config ReplicationConfig) error
+	GetReplication(ctx context.Context, bucketName string) (*ReplicationConfig, error)
+	DeleteReplication(ctx context.Context, bucketName string) error
+	Usage(ctx context.Context, bucketName string) (*BucketUsage, error)
+	ListIncompleteUploads(ctx context.Context, bucketName string) ([]IncompleteUpload, error)
 }
 
 // bucketService implements the BucketService interface.
@@ -44,12 +61,41 @@ type bucketService struct {
 }
 
 // Create creates a new bucket.
-func (s *bucketService) Create(ctx context.Context, bucketName string) error {
+func (s *bucketService) Create(ctx context.Context, bucketName string, opts ...BucketCreateOption) error {
 	if bucketName == "" {
 		return &InvalidBucketNameError{Name: bucketName}
 	}
 
-	return s.client.minioClient.MakeBucket(ctx, bucketName, minio.MakeBucketOptions{})
+	makeOpts := minio.MakeBucketOptions{}
+	for _, opt := range opts {
+		opt(&makeOpts)
+	}
+
+	return s.client.minioClient.MakeBucket(ctx, bucketName, makeOpts)
+}
+
+// EnsureExists creates a bucket unless it already exists and reports whether
+// it was created. A bucket that is already owned by the caller is not an
+// error; a name taken by another account still fails.
+func (s *bucketService) EnsureExists(ctx context.Context, bucketName string, opts ...BucketCreateOption) (bool, error) {
+	exists, err := s.Exists(ctx, bucketName)
+	if err != nil {
+		return false, err
+	}
+	if exists {
+		return false, nil
+	}
+
+	err = s.Create(ctx, bucketName, opts...)
+	if err != nil {
+		// Another caller may have created it between Exists and Create.
+		if minio.ToErrorResponse(err).Code == "BucketAlreadyOwnedByYou" {
+			return false, nil
+		}
+		return false, err
+	}
+
+	return true, nil
 }
 
 // List retrieves all buckets.
@@ -79,13 +125,15 @@ func (s *bucketService) Exists(ctx context.Context, bucketName string) (bool, er
 	return s.client.minioClient.BucketExists(ctx, bucketName)
 }
 
-// Delete deletes a bucket.
-func (s *bucketService) Delete(ctx context.Context, bucketName string, recursive bool) error {
+// Delete deletes a bucket. An empty bucket is required unless force is true,
+// in which case the bucket is removed together with all of its objects and
+// object versions.
+func (s *bucketService) Delete(ctx context.Context, bucketName string, force bool) error {
 	if bucketName == "" {
 		return &InvalidBucketNameError{Name: bucketName}
 	}
 
-	if recursive {
+	if force {
 		ctx = WithForceDelete(ctx)
 	}
 
@@ -116,7 +164,9 @@ func (s *bucketService) GetPolicy(ctx context.Context, bucketName string) (*Poli
 	return &policy, nil
 }
 
-// SetPolicy sets the policy of a bucket.
+// SetPolicy sets the policy of a bucket. Resources are sent as full S3 ARNs:
+// bare forms such as "my-bucket/*" are prefixed, and resources that are not
+// S3 ARNs or name another bucket return an InvalidPolicyError.
 func (s *bucketService) SetPolicy(ctx context.Context, bucketName string, policy *Policy) error {
 	if bucketName == "" {
 		return &InvalidBucketNameError{Name: bucketName}
@@ -130,6 +180,11 @@ func (s *bucketService) SetPolicy(ctx context.Context, bucketName string, policy
 		return &InvalidPolicyError{Message: "policy must have at least one statement"}
 	}
 
+	policy, err := canonicalPolicy(bucketName, policy)
+	if err != nil {
+		return err
+	}
+
 	policyStr, err := marshalPolicy(policy)
 	if err != nil {
 		return err
@@ -356,3 +411,39 @@ func (s *bucketService) GetVersioningStatus(ctx context.Context, bucketName stri
 
 	return config, nil
 }
+
+// Usage returns the number of objects in a bucket and their total size.
+// Object storage has no endpoint reporting bucket usage, so this lists every
+// object in the bucket; on large buckets it costs one list request per 1000
+// objects. Only current object versions are counted. Bucket quotas are not
+// supported by the storage API.
+func (s *bucketService) Usage(ctx context.Context, bucketName string) (*BucketUsage, error) {
+	if bucketName == "" {
+		return nil, &InvalidBucketNameError{Name: bucketName}
+	}
+
+	usage := &BucketUsage{}
+	err := listObjects(ctx, s.client.minioClient, bucketName, minio.ListObjectsOptions{
+		Recursive: true,
+	}, func(object minio.ObjectInfo) bool {
+		usage.ObjectCount++
+		usage.TotalBytes += object.Size
+		return true
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return usage, nil
+}
+
+// ListIncompleteUploads returns the multipart uploads in a bucket that were
+// started but neither completed nor aborted. Abandoned uploads keep their parts
+// stored until they are aborted with Objects().AbortMultipartUpload.
+func (s *bucketService) ListIncompleteUploads(ctx context.Context, bucketName string) ([]IncompleteUpload, error) {
+	if bucketName == "" {
+		return nil, &InvalidBucketNameError{Name: bucketName}
+	}
+
+	return listIncompleteUploads(ctx, s.client, bucketName, "")
+}
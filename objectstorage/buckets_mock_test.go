@@ -616,3 +616,212 @@ func TestBucketServiceDelete_WithObjects(t *testing.T) {
 		t.Fatalf("expected bucket to be deleted, but it still exists")
 	}
 }
+
+// TestBucketServiceEnsureExists tests EnsureExists with mock MinIO
+func TestBucketServiceEnsureExists(t *testing.T) {
+	t.Parallel()
+
+	errDenied := minio.ErrorResponse{Code: "AccessDenied", Message: "Access Denied", StatusCode: 403}
+
+	tests := []struct {
+		name        string
+		setup       func(mock *mockMinioClient)
+		opts        []BucketCreateOption
+		wantCreated bool
+		wantErr     bool
+		wantLocking bool
+	}{
+		{
+			name:        "creates missing bucket",
+			setup:       func(mock *mockMinioClient) {},
+			wantCreated: true,
+		},
+		{
+			name:        "creates missing bucket with object locking",
+			setup:       func(mock *mockMinioClient) {},
+			opts:        []BucketCreateOption{WithBucketObjectLocking()},
+			wantCreated: true,
+			wantLocking: true,
+		},
+		{
+			name: "existing bucket is left alone",
+			setup: func(mock *mockMinioClient) {
+				mock.buckets["test-bucket"] = &mockBucket{name: "test-bucket", objects: make(map[string]*mockObject)}
+				mock.makeBucketFunc = func(ctx context.Context, bucketName string, opts minio.MakeBucketOptions) error {
+					t.Error("MakeBucket should not be called for an existing bucket")
+					return nil
+				}
+			},
+			wantCreated: false,
+		},
+		{
+			name: "bucket created concurrently by the caller",
+			setup: func(mock *mockMinioClient) {
+				mock.makeBucketFunc = func(ctx context.Context, bucketName string, opts minio.MakeBucketOptions) error {
+					return minio.ErrorResponse{Code: "BucketAlreadyOwnedByYou", StatusCode: 409}
+				}
+			},
+			wantCreated: false,
+		},
+		{
+			name: "bucket name owned by another account",
+			setup: func(mock *mockMinioClient) {
+				mock.makeBucketFunc = func(ctx context.Context, bucketName string, opts minio.MakeBucketOptions) error {
+					return minio.ErrorResponse{Code: "BucketAlreadyExists", StatusCode: 409}
+				}
+			},
+			wantErr: true,
+		},
+		{
+			name: "exists check fails",
+			setup: func(mock *mockMinioClient) {
+				mock.bucketExistsFunc = func(ctx context.Context, bucketName string) (bool, error) {
+					return false, errDenied
+				}
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mock := newMockMinioClient()
+			tt.setup(mock)
+			var gotLocking bool
+			if mock.makeBucketFunc == nil {
+				mock.makeBucketFunc = func(ctx context.Context, bucketName string, opts minio.MakeBucketOptions) error {
+					gotLocking = opts.ObjectLocking
+					mock.buckets[bucketName] = &mockBucket{name: bucketName, objects: make(map[string]*mockObject)}
+					return nil
+				}
+			}
+
+			core := client.NewMgcClient()
+			osClient, _ := New(core, "minioadmin", "minioadmin", WithMinioClientInterface(mock))
+
+			created, err := osClient.Buckets().EnsureExists(context.Background(), "test-bucket", tt.opts...)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("EnsureExists() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if created != tt.wantCreated {
+				t.Errorf("EnsureExists() created = %v, want %v", created, tt.wantCreated)
+			}
+			if gotLocking != tt.wantLocking {
+				t.Errorf("EnsureExists() object locking = %v, want %v", gotLocking, tt.wantLocking)
+			}
+		})
+	}
+}
+
+// TestBucketServiceEnsureExists_InvalidBucketName tests EnsureExists with an empty name
+func TestBucketServiceEnsureExists_InvalidBucketName(t *testing.T) {
+	t.Parallel()
+
+	core := client.NewMgcClient()
+	osClient, _ := New(core, "minioadmin", "minioadmin", WithMinioClientInterface(newMockMinioClient()))
+
+	_, err := osClient.Buckets().EnsureExists(context.Background(), "")
+	if _, ok := err.(*InvalidBucketNameError); !ok {
+		t.Errorf("EnsureExists() error = %T, want *InvalidBucketNameError", err)
+	}
+}
+
+// TestBucketServiceDelete_ForceFlag tests that only forced deletes are marked for content removal
+func TestBucketServiceDelete_ForceFlag(t *testing.T) {
+	t.Parallel()
+
+	for _, force := range []bool{true, false} {
+		mock := newMockMinioClient()
+		var gotForce bool
+		mock.removeBucketFunc = func(ctx context.Context, bucketName string) error {
+			gotForce = HasForceDelete(ctx)
+			return nil
+		}
+
+		core := client.NewMgcClient()
+		osClient, _ := New(core, "minioadmin", "minioadmin", WithMinioClientInterface(mock))
+		if err := osClient.Buckets().Delete(context.Background(), "test-bucket", force); err != nil {
+			t.Fatalf("Delete() error = %v", err)
+		}
+		if gotForce != force {
+			t.Errorf("Delete(force=%v) marked context as forced = %v", force, gotForce)
+		}
+	}
+}
+
+// TestBucketServiceUsage tests Usage aggregating the objects of a bucket
+func TestBucketServiceUsage(t *testing.T) {
+	t.Parallel()
+	mock := newMockMinioClient()
+	mock.buckets["test-bucket"] = &mockBucket{
+		name:         "test-bucket",
+		creationDate: time.Now(),
+		objects: map[string]*mockObject{
+			"file.txt":      {key: "file.txt", size: 180, lastModified: time.Now()},
+			"test/file.txt": {key: "test/file.txt", size: 20, lastModified: time.Now()},
+			"test/empty":    {key: "test/empty", size: 0, lastModified: time.Now()},
+		},
+	}
+	mock.buckets["empty-bucket"] = &mockBucket{
+		name:         "empty-bucket",
+		creationDate: time.Now(),
+		objects:      make(map[string]*mockObject),
+	}
+
+	core := client.NewMgcClient()
+	osClient, _ := New(core, "minioadmin", "minioadmin", WithMinioClientInterface(mock))
+	svc := osClient.Buckets()
+
+	usage, err := svc.Usage(context.Background(), "test-bucket")
+	if err != nil {
+		t.Fatalf("Usage() error = %v", err)
+	}
+	if usage.ObjectCount != 3 {
+		t.Errorf("Usage() ObjectCount = %d, want 3", usage.ObjectCount)
+	}
+	if usage.TotalBytes != 200 {
+		t.Errorf("Usage() TotalBytes = %d, want 200", usage.TotalBytes)
+	}
+
+	usage, err = svc.Usage(context.Background(), "empty-bucket")
+	if err != nil {
+		t.Fatalf("Usage() error = %v", err)
+	}
+	if usage.ObjectCount != 0 || usage.TotalBytes != 0 {
+		t.Errorf("Usage() on empty bucket = %+v, want zero usage", usage)
+	}
+}
+
+// TestBucketServiceUsage_ListError tests Usage surfacing listing errors
+func TestBucketServiceUsage_ListError(t *testing.T) {
+	t.Parallel()
+	mock := newMockMinioClient()
+	mock.listObjectsFunc = func(ctx context.Context, bucketName string, opts minio.ListObjectsOptions) <-chan minio.ObjectInfo {
+		ch := make(chan minio.ObjectInfo, 1)
+		ch <- minio.ObjectInfo{Err: minio.ErrorResponse{Code: "NoSuchBucket"}}
+		close(ch)
+		return ch
+	}
+
+	core := client.NewMgcClient()
+	osClient, _ := New(core, "minioadmin", "minioadmin", WithMinioClientInterface(mock))
+
+	if _, err := osClient.Buckets().Usage(context.Background(), "missing-bucket"); err == nil {
+		t.Fatal("Usage() expected error, got nil")
+	}
+}
+
+// TestBucketServiceUsage_InvalidBucketName tests Usage with an empty bucket name
+func TestBucketServiceUsage_InvalidBucketName(t *testing.T) {
+	t.Parallel()
+	core := client.NewMgcClient()
+	osClient, _ := New(core, "minioadmin", "minioadmin", WithMinioClientInterface(newMockMinioClient()))
+
+	_, err := osClient.Buckets().Usage(context.Background(), "")
+	if _, ok := err.(*InvalidBucketNameError); !ok {
+		t.Errorf("Usage() error = %T, want *InvalidBucketNameError", err)
+	}
+}
@@ -26,6 +26,14 @@ func TestBucketServiceCreate_InvalidBucketName(t *testing.T) {
 	}
 }
 
+// TestBucketServiceDelete_Signature pins the Delete signature used across the
+// README and examples: a bucket name plus an explicit force flag.
+func TestBucketServiceDelete_Signature(t *testing.T) {
+	t.Parallel()
+
+	var _ func(BucketService, context.Context, string, bool) error = BucketService.Delete
+}
+
 func TestBucketServiceExists_InvalidBucketName(t *testing.T) {
 	t.Parallel()
 
@@ -14,6 +14,7 @@ import (
 type ObjectStorageClient struct {
 	*client.CoreClient
 	minioClient minioClientInterface
+	minioCore   minioCoreInterface
 	endpoint    Endpoint
 }
 
@@ -101,11 +102,25 @@ func New(core *client.CoreClient, accessKey string, secretKey string, opts ...Cl
 		osClient.minioClient = minioClient
 	}
 
+	// Multipart primitives live on minio.Core, which wraps the same client
+	switch mc := osClient.minioClient.(type) {
+	case *minio.Client:
+		osClient.minioCore = minio.Core{Client: mc}
+	case minioCoreInterface:
+		osClient.minioCore = mc
+	}
+
 	osClient.minioClient.SetAppInfo("wrapper", core.GetConfig().UserAgent)
 
 	return osClient, nil
 }
 
+// NewDefault creates a new instance of ObjectStorageClient using the client
+// installed with client.SetDefault. If no default client is set, returns an error.
+func NewDefault(accessKey string, secretKey string, opts ...ClientOption) (*ObjectStorageClient, error) {
+	return New(client.Default(), accessKey, secretKey, opts...)
+}
+
 // NewWithEndpoint creates a new instance of ObjectStorageClient with a specific endpoint.
 // Deprecated: Use New() with WithEndpoint() option instead.
 func NewWithEndpoint(core *client.CoreClient, endpoint Endpoint, accessKey string, secretKey string, opts ...ClientOption) (*ObjectStorageClient, error) {
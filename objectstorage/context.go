@@ -1,16 +1,89 @@
 package objectstorage
 
-import "context"
+import (
+	"context"
+	"io"
+
+	"github.com/minio/minio-go/v7"
+)
 
 type forceDeleteKeyType struct{}
 
 var forceDeleteKey = forceDeleteKeyType{}
 
+// WithForceDelete marks ctx so that bucket deletions made with it also remove
+// the bucket's objects and object versions.
 func WithForceDelete(ctx context.Context) context.Context {
 	return context.WithValue(ctx, forceDeleteKey, true)
 }
 
+// HasForceDelete reports whether ctx was marked with WithForceDelete.
 func HasForceDelete(ctx context.Context) bool {
 	v, ok := ctx.Value(forceDeleteKey).(bool)
 	return ok && v
 }
+
+// contextReader stops reading from r once ctx is done, so uploads from slow
+// or endless sources abort promptly on cancellation.
+type contextReader struct {
+	ctx context.Context
+	r   io.Reader
+}
+
+func (cr *contextReader) Read(p []byte) (int, error) {
+	if err := cr.ctx.Err(); err != nil {
+		return 0, err
+	}
+	return cr.r.Read(p)
+}
+
+// withContext wraps r in a contextReader unless it is an io.Seeker or an
+// io.ReaderAt. minio retries requests only with seekable bodies and uploads
+// ReaderAt bodies in parallel without buffering, and it already passes ctx
+// to every HTTP request, so those readers are passed through unchanged.
+func withContext(ctx context.Context, r io.Reader) io.Reader {
+	switch r.(type) {
+	case io.Seeker, io.ReaderAt:
+		return r
+	}
+	return &contextReader{ctx: ctx, r: r}
+}
+
+// listEntries starts a listing with list and calls fn for each entry it
+// yields until fn returns false or the listing ends. minio lists from a
+// goroutine that runs until the context passed to list is done, so
+// listEntries hands list a context of its own and cancels it on return; the
+// goroutine stops even when fn ends the listing early. The first error found
+// by errOf, or ctx's error once ctx is done, is returned.
+func listEntries[T any](ctx context.Context, list func(context.Context) <-chan T, errOf func(T) error, fn func(T) bool) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	ch := list(ctx)
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case entry, ok := <-ch:
+			if !ok {
+				return nil
+			}
+			if err := errOf(entry); err != nil {
+				return err
+			}
+			if !fn(entry) {
+				return nil
+			}
+		}
+	}
+}
+
+// listObjects calls fn for each object in bucketName matching opts, using
+// listEntries.
+func listObjects(ctx context.Context, mc minioClientInterface, bucketName string, opts minio.ListObjectsOptions, fn func(minio.ObjectInfo) bool) error {
+	return listEntries(ctx, func(ctx context.Context) <-chan minio.ObjectInfo {
+		return mc.ListObjects(ctx, bucketName, opts)
+	}, func(object minio.ObjectInfo) error {
+		return object.Err
+	}, fn)
+}
@@ -0,0 +1,341 @@
+package objectstorage
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/MagaluCloud/mgc-sdk-go/client"
+	"github.com/minio/minio-go/v7"
+	"github.com/minio/minio-go/v7/pkg/credentials"
+)
+
+type ctxMarkerKey struct{}
+
+// endlessReader returns data forever, pausing briefly between reads.
+type endlessReader struct{}
+
+func (endlessReader) Read(p []byte) (int, error) {
+	time.Sleep(time.Millisecond)
+	return copy(p, "data"), nil
+}
+
+func newMockObjectService(t *testing.T, mock *mockMinioClient) ObjectService {
+	t.Helper()
+	osClient, err := New(client.NewMgcClient(), "minioadmin", "minioadmin", WithMinioClientInterface(mock))
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+	return osClient.Objects()
+}
+
+// blockingListing emits the given keys and then blocks until ctx is done
+// without closing the channel, like a slow listing over the network.
+func blockingListing(keys ...string) func(ctx context.Context, bucketName string, opts minio.ListObjectsOptions) <-chan minio.ObjectInfo {
+	return func(ctx context.Context, bucketName string, opts minio.ListObjectsOptions) <-chan minio.ObjectInfo {
+		ch := make(chan minio.ObjectInfo)
+		go func() {
+			for _, key := range keys {
+				select {
+				case ch <- minio.ObjectInfo{Key: key}:
+				case <-ctx.Done():
+					return
+				}
+			}
+			<-ctx.Done()
+		}()
+		return ch
+	}
+}
+
+func TestContextReader(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	reader := &contextReader{ctx: ctx, r: strings.NewReader("hello")}
+
+	buf := make([]byte, 2)
+	if n, err := reader.Read(buf); err != nil || n != 2 {
+		t.Fatalf("Read() = %d, %v; want 2, nil", n, err)
+	}
+
+	cancel()
+	if _, err := reader.Read(buf); !errors.Is(err, context.Canceled) {
+		t.Errorf("Read() after cancel error = %v, want context.Canceled", err)
+	}
+}
+
+func TestObjectServiceUpload_KeepsSeekableBody(t *testing.T) {
+	t.Parallel()
+	mock, svc := newMultipartTestClient(t)
+
+	var body io.Reader
+	mock.putObjectFunc = func(ctx context.Context, bucketName, objectName string, reader io.Reader, objectSize int64, opts minio.PutObjectOptions) (minio.UploadInfo, error) {
+		body = reader
+		return minio.UploadInfo{}, nil
+	}
+
+	if err := svc.Upload(context.Background(), "test-bucket", "a.txt", []byte("hello"), "text/plain"); err != nil {
+		t.Fatalf("Upload() error = %v", err)
+	}
+	if _, ok := body.(io.Seeker); !ok {
+		t.Errorf("PutObject() body = %T, want an io.Seeker so minio can retry", body)
+	}
+
+	if err := svc.UploadStream(context.Background(), "test-bucket", "b.txt", io.LimitReader(strings.NewReader("hello"), 5), 5, "text/plain"); err != nil {
+		t.Fatalf("UploadStream() error = %v", err)
+	}
+	if _, ok := body.(*contextReader); !ok {
+		t.Errorf("PutObject() body = %T, want a contextReader for a plain reader", body)
+	}
+}
+
+func TestObjectService_PassesContextToMinio(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.WithValue(context.Background(), ctxMarkerKey{}, "marker")
+	errStop := errors.New("stop")
+	seen := map[string]bool{}
+	check := func(name string, ctx context.Context) {
+		if ctx.Value(ctxMarkerKey{}) == "marker" {
+			seen[name] = true
+		}
+	}
+
+	mock := newMockMinioClient()
+	mock.putObjectFunc = func(ctx context.Context, bucketName, objectName string, reader io.Reader, objectSize int64, opts minio.PutObjectOptions) (minio.UploadInfo, error) {
+		check("PutObject", ctx)
+		return minio.UploadInfo{}, nil
+	}
+	mock.getObjectFunc = func(ctx context.Context, bucketName, objectName string, opts minio.GetObjectOptions) (*minio.Object, error) {
+		check("GetObject", ctx)
+		return nil, errStop
+	}
+	mock.listObjectsFunc = func(ctx context.Context, bucketName string, opts minio.ListObjectsOptions) <-chan minio.ObjectInfo {
+		check("ListObjects", ctx)
+		ch := make(chan minio.ObjectInfo)
+		close(ch)
+		return ch
+	}
+	mock.removeObjectFunc = func(ctx context.Context, bucketName, objectName string, opts minio.RemoveObjectOptions) error {
+		check("RemoveObject", ctx)
+		return nil
+	}
+	mock.statObjectFunc = func(ctx context.Context, bucketName, objectName string, opts minio.StatObjectOptions) (minio.ObjectInfo, error) {
+		check("StatObject", ctx)
+		return minio.ObjectInfo{}, nil
+	}
+	mock.putObjectRetentionFunc = func(ctx context.Context, bucketName, objectName string, opts minio.PutObjectRetentionOptions) error {
+		check("PutObjectRetention", ctx)
+		return nil
+	}
+	mock.getObjectRetentionFunc = func(ctx context.Context, bucketName, objectName, versionID string) (*minio.RetentionMode, *time.Time, error) {
+		check("GetObjectRetention", ctx)
+		return nil, nil, nil
+	}
+
+	svc := newMockObjectService(t, mock)
+	_ = svc.Upload(ctx, "bucket", "key", []byte("data"), "text/plain")
+	_, _ = svc.Download(ctx, "bucket", "key", nil)
+	_, _ = svc.List(ctx, "bucket", ObjectListOptions{})
+	_ = svc.Delete(ctx, "bucket", "key", nil)
+	_, _ = svc.Metadata(ctx, "bucket", "key")
+	_ = svc.LockObject(ctx, "bucket", "key", time.Now().Add(time.Hour))
+	_, _ = svc.GetObjectLockStatus(ctx, "bucket", "key")
+
+	for _, name := range []string{"PutObject", "GetObject", "ListObjects", "RemoveObject", "StatObject", "PutObjectRetention", "GetObjectRetention"} {
+		if !seen[name] {
+			t.Errorf("%s did not receive the caller's context", name)
+		}
+	}
+}
+
+func TestObjectServiceUploadStream_CanceledMidUpload(t *testing.T) {
+	t.Parallel()
+
+	mock := newMockMinioClient()
+	mock.putObjectFunc = func(ctx context.Context, bucketName, objectName string, reader io.Reader, objectSize int64, opts minio.PutObjectOptions) (minio.UploadInfo, error) {
+		_, err := io.Copy(io.Discard, reader)
+		return minio.UploadInfo{}, err
+	}
+	svc := newMockObjectService(t, mock)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+	err := svc.UploadStream(ctx, "bucket", "key", endlessReader{}, -1, "application/octet-stream")
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("UploadStream() error = %v, want context.DeadlineExceeded", err)
+	}
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Errorf("UploadStream() took %v to abort after cancellation", elapsed)
+	}
+}
+
+func TestObjectServiceListing_CanceledMidListing(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		call func(ctx context.Context, svc ObjectService) error
+	}{
+		{
+			name: "List",
+			call: func(ctx context.Context, svc ObjectService) error {
+				_, err := svc.List(ctx, "bucket", ObjectListOptions{})
+				return err
+			},
+		},
+		{
+			name: "ListAll",
+			call: func(ctx context.Context, svc ObjectService) error {
+				_, err := svc.ListAll(ctx, "bucket", ObjectFilterOptions{})
+				return err
+			},
+		},
+		{
+			name: "ListVersions",
+			call: func(ctx context.Context, svc ObjectService) error {
+				_, err := svc.ListVersions(ctx, "bucket", "key", nil)
+				return err
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mock := newMockMinioClient()
+			mock.listObjectsFunc = blockingListing("key", "key")
+			svc := newMockObjectService(t, mock)
+
+			ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+			defer cancel()
+
+			start := time.Now()
+			err := tt.call(ctx, svc)
+			if !errors.Is(err, context.DeadlineExceeded) {
+				t.Fatalf("%s() error = %v, want context.DeadlineExceeded", tt.name, err)
+			}
+			if elapsed := time.Since(start); elapsed > time.Second {
+				t.Errorf("%s() took %v to abort after cancellation", tt.name, elapsed)
+			}
+		})
+	}
+}
+
+func TestObjectServiceList_StopsListingOnEarlyReturn(t *testing.T) {
+	t.Parallel()
+
+	stopped := make(chan struct{})
+	mock := newMockMinioClient()
+	mock.listObjectsFunc = func(ctx context.Context, bucketName string, opts minio.ListObjectsOptions) <-chan minio.ObjectInfo {
+		ch := make(chan minio.ObjectInfo)
+		go func() {
+			defer close(stopped)
+			for {
+				select {
+				case ch <- minio.ObjectInfo{Key: "key"}:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}()
+		return ch
+	}
+	svc := newMockObjectService(t, mock)
+
+	limit := 2
+	objects, err := svc.List(context.Background(), "bucket", ObjectListOptions{Limit: &limit})
+	if err != nil {
+		t.Fatalf("List() error = %v", err)
+	}
+	if len(objects) != 2 {
+		t.Errorf("List() returned %d objects, want 2", len(objects))
+	}
+
+	select {
+	case <-stopped:
+	case <-time.After(time.Second):
+		t.Error("List() left the listing goroutine running")
+	}
+}
+
+// newHangingObjectService returns an ObjectService backed by a real MinIO
+// client whose server never answers.
+func newHangingObjectService(t *testing.T) ObjectService {
+	t.Helper()
+
+	release := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-r.Context().Done():
+		case <-release:
+		}
+	}))
+	t.Cleanup(server.Close)
+	// Requests with a body are not cancelled on the server side when the
+	// client gives up, so release them before closing the server.
+	t.Cleanup(func() { close(release) })
+
+	minioClient, err := minio.New(strings.TrimPrefix(server.URL, "http://"), &minio.Options{
+		Creds:  credentials.NewStaticV4("minioadmin", "minioadmin", ""),
+		Region: "br-se1",
+	})
+	if err != nil {
+		t.Fatalf("minio.New() error = %v", err)
+	}
+
+	osClient, err := New(client.NewMgcClient(), "minioadmin", "minioadmin", WithMinioClient(minioClient))
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+	return osClient.Objects()
+}
+
+func TestObjectServiceLockMethods_HonorContext(t *testing.T) {
+	t.Parallel()
+	svc := newHangingObjectService(t)
+
+	calls := map[string]func(ctx context.Context) error{
+		"LockObject": func(ctx context.Context) error {
+			return svc.LockObject(ctx, "bucket", "key", time.Now().Add(time.Hour))
+		},
+		"UnlockObject": func(ctx context.Context) error {
+			return svc.UnlockObject(ctx, "bucket", "key")
+		},
+		"GetObjectLockStatus": func(ctx context.Context) error {
+			_, err := svc.GetObjectLockStatus(ctx, "bucket", "key")
+			return err
+		},
+	}
+
+	for name, call := range calls {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+			defer cancel()
+
+			done := make(chan error, 1)
+			go func() { done <- call(ctx) }()
+
+			select {
+			case err := <-done:
+				if !errors.Is(err, context.DeadlineExceeded) {
+					t.Errorf("%s() error = %v, want context.DeadlineExceeded", name, err)
+				}
+			case <-time.After(5 * time.Second):
+				t.Fatalf("%s() did not return after its context expired", name)
+			}
+		})
+	}
+}
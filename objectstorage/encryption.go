@@ -0,0 +1,90 @@
+package objectstorage
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/minio/minio-go/v7"
+	"github.com/minio/minio-go/v7/pkg/encrypt"
+)
+
+// EncryptionType identifies the server-side encryption of an object.
+type EncryptionType string
+
+const (
+	// EncryptionSSES3 encrypts the object with keys managed by the service.
+	EncryptionSSES3 EncryptionType = "SSE-S3"
+	// EncryptionSSEC encrypts the object with a key provided by the caller,
+	// which must be sent again to download the object.
+	EncryptionSSEC EncryptionType = "SSE-C"
+	// EncryptionSSEKMS is reported by Metadata for objects encrypted with a
+	// key management service. Uploads cannot request it.
+	EncryptionSSEKMS EncryptionType = "SSE-KMS"
+)
+
+// CustomerKeySize is the size, in bytes, of an SSE-C customer key (AES-256).
+const CustomerKeySize = 32
+
+// Encryption configures the server-side encryption of an upload.
+type Encryption struct {
+	Type EncryptionType `json:"type"`
+	// CustomerKey is the AES-256 key used with EncryptionSSEC. It must be
+	// CustomerKeySize bytes long and is never stored by the service.
+	CustomerKey []byte `json:"-"`
+}
+
+// serverSide converts the encryption to its minio representation. A nil
+// encryption returns nil, leaving the bucket default in effect.
+func (e *Encryption) serverSide() (encrypt.ServerSide, error) {
+	if e == nil {
+		return nil, nil
+	}
+
+	switch e.Type {
+	case EncryptionSSES3:
+		return encrypt.NewSSE(), nil
+	case EncryptionSSEC:
+		return customerKeyEncryption(e.CustomerKey)
+	default:
+		return nil, &InvalidObjectDataError{Message: "unsupported encryption type " + string(e.Type)}
+	}
+}
+
+// customerKeyEncryption validates an SSE-C key. An empty key returns nil.
+func customerKeyEncryption(key []byte) (encrypt.ServerSide, error) {
+	if len(key) == 0 {
+		return nil, nil
+	}
+
+	sse, err := encrypt.NewSSEC(key)
+	if err != nil {
+		return nil, &InvalidObjectDataError{Message: "encryption customer key must be 32 bytes"}
+	}
+	return sse, nil
+}
+
+// encryptionFromHeader reports the encryption of an object from its response headers.
+func encryptionFromHeader(header http.Header) EncryptionType {
+	if header.Get(encrypt.SseCustomerAlgorithm) != "" {
+		return EncryptionSSEC
+	}
+
+	switch header.Get(encrypt.SseGenericHeader) {
+	case "":
+		return ""
+	case "aws:kms":
+		return EncryptionSSEKMS
+	default:
+		return EncryptionSSES3
+	}
+}
+
+// downloadError turns the rejection of an SSE-C object read without its key
+// into a MissingEncryptionKeyError.
+func downloadError(bucketName string, objectKey string, err error) error {
+	resp := minio.ToErrorResponse(err)
+	if resp.StatusCode == http.StatusBadRequest && strings.Contains(resp.Message, "Server Side Encryption") {
+		return &MissingEncryptionKeyError{Bucket: bucketName, Key: objectKey}
+	}
+	return err
+}
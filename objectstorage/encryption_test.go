@@ -0,0 +1,153 @@
+package objectstorage
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/minio/minio-go/v7"
+	"github.com/minio/minio-go/v7/pkg/encrypt"
+)
+
+func TestObjectServiceUploadEncryption(t *testing.T) {
+	t.Parallel()
+	key := bytes.Repeat([]byte{7}, CustomerKeySize)
+
+	tests := []struct {
+		name       string
+		encryption *Encryption
+		want       EncryptionType
+	}{
+		{name: "not encrypted", encryption: nil, want: ""},
+		{name: "SSE-S3", encryption: &Encryption{Type: EncryptionSSES3}, want: EncryptionSSES3},
+		{name: "SSE-C", encryption: &Encryption{Type: EncryptionSSEC, CustomerKey: key}, want: EncryptionSSEC},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, svc := newMultipartTestClient(t)
+			ctx := context.Background()
+
+			err := svc.UploadWithOptions(ctx, "test-bucket", "secret.txt", []byte("data"), UploadOptions{Encryption: tt.encryption})
+			if err != nil {
+				t.Fatalf("UploadWithOptions() error = %v", err)
+			}
+
+			obj, err := svc.Metadata(ctx, "test-bucket", "secret.txt")
+			if err != nil {
+				t.Fatalf("Metadata() error = %v", err)
+			}
+			if obj.Encryption != tt.want {
+				t.Errorf("Metadata().Encryption = %q, want %q", obj.Encryption, tt.want)
+			}
+		})
+	}
+}
+
+func TestObjectServiceUploadEncryption_Invalid(t *testing.T) {
+	t.Parallel()
+	_, svc := newMultipartTestClient(t)
+	ctx := context.Background()
+
+	for _, encryption := range []*Encryption{
+		{Type: EncryptionSSEC, CustomerKey: []byte("short")},
+		{Type: EncryptionSSEKMS},
+		{Type: "unknown"},
+	} {
+		err := svc.UploadWithOptions(ctx, "test-bucket", "key", []byte("x"), UploadOptions{Encryption: encryption})
+		if _, ok := err.(*InvalidObjectDataError); !ok {
+			t.Errorf("UploadWithOptions(%s) error = %v, want *InvalidObjectDataError", encryption.Type, err)
+		}
+	}
+
+	_, err := svc.Download(ctx, "test-bucket", "key", &DownloadOptions{CustomerKey: []byte("short")})
+	if _, ok := err.(*InvalidObjectDataError); !ok {
+		t.Errorf("Download() error = %v, want *InvalidObjectDataError", err)
+	}
+}
+
+func TestObjectServiceDownloadCustomerKey(t *testing.T) {
+	t.Parallel()
+	mock, svc := newMultipartTestClient(t)
+	key := bytes.Repeat([]byte{7}, CustomerKeySize)
+
+	var gotType encrypt.Type
+	mock.getObjectFunc = func(ctx context.Context, bucketName, objectName string, opts minio.GetObjectOptions) (*minio.Object, error) {
+		if opts.ServerSideEncryption == nil {
+			return nil, minio.ErrorResponse{
+				StatusCode: http.StatusBadRequest,
+				Code:       "InvalidRequest",
+				Message:    "The object was stored using a form of Server Side Encryption. The correct parameters must be provided to retrieve the object.",
+			}
+		}
+		gotType = opts.ServerSideEncryption.Type()
+		return nil, errors.New("stop")
+	}
+
+	_, err := svc.Download(context.Background(), "test-bucket", "secret.txt", nil)
+	var keyErr *MissingEncryptionKeyError
+	if !errors.As(err, &keyErr) || keyErr.Key != "secret.txt" {
+		t.Fatalf("Download() error = %v, want MissingEncryptionKeyError", err)
+	}
+	if !strings.Contains(keyErr.Error(), "CustomerKey") {
+		t.Errorf("MissingEncryptionKeyError.Error() = %q, should mention CustomerKey", keyErr.Error())
+	}
+
+	_, err = svc.DownloadStream(context.Background(), "test-bucket", "secret.txt", &DownloadStreamOptions{})
+	if !errors.As(err, &keyErr) {
+		t.Errorf("DownloadStream() error = %v, want MissingEncryptionKeyError", err)
+	}
+
+	_, _ = svc.DownloadStream(context.Background(), "test-bucket", "secret.txt", &DownloadStreamOptions{CustomerKey: key})
+	if gotType != encrypt.SSEC {
+		t.Errorf("DownloadStream() sent encryption %q, want %q", gotType, encrypt.SSEC)
+	}
+}
+
+func TestEncryptionFromHeader(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name   string
+		header http.Header
+		want   EncryptionType
+	}{
+		{name: "none", header: http.Header{}, want: ""},
+		{name: "SSE-S3", header: http.Header{"X-Amz-Server-Side-Encryption": {"AES256"}}, want: EncryptionSSES3},
+		{name: "SSE-KMS", header: http.Header{"X-Amz-Server-Side-Encryption": {"aws:kms"}}, want: EncryptionSSEKMS},
+		{name: "SSE-C", header: http.Header{"X-Amz-Server-Side-Encryption-Customer-Algorithm": {"AES256"}}, want: EncryptionSSEC},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := encryptionFromHeader(tt.header); got != tt.want {
+				t.Errorf("encryptionFromHeader() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestObjectServiceSetUserMetadata_KeepsEncryption(t *testing.T) {
+	t.Parallel()
+	_, svc := newMultipartTestClient(t)
+	ctx := context.Background()
+
+	err := svc.UploadWithOptions(ctx, "test-bucket", "secret.txt", []byte("data"), UploadOptions{Encryption: &Encryption{Type: EncryptionSSES3}})
+	if err != nil {
+		t.Fatalf("UploadWithOptions() error = %v", err)
+	}
+	if err := svc.SetUserMetadata(ctx, "test-bucket", "secret.txt", map[string]string{"owner": "finance"}); err != nil {
+		t.Fatalf("SetUserMetadata() error = %v", err)
+	}
+
+	obj, err := svc.Metadata(ctx, "test-bucket", "secret.txt")
+	if err != nil {
+		t.Fatalf("Metadata() error = %v", err)
+	}
+	if obj.Encryption != EncryptionSSES3 {
+		t.Errorf("Encryption after SetUserMetadata = %q, want %q", obj.Encryption, EncryptionSSES3)
+	}
+}
@@ -1,6 +1,10 @@
 package objectstorage
 
-import "fmt"
+import (
+	"fmt"
+
+	"github.com/minio/minio-go/v7"
+)
 
 // InvalidBucketNameError is returned when a bucket name is invalid or empty.
 type InvalidBucketNameError struct {
@@ -66,3 +70,57 @@ type ObjectError struct {
 func (e *ObjectError) Error() string {
 	return fmt.Sprintf("object operation %s on %s/%s failed: %s", e.Operation, e.Bucket, e.Key, e.Message)
 }
+
+// InvalidFilterError is returned when an Include or Exclude pattern cannot be compiled.
+type InvalidFilterError struct {
+	Pattern string
+	Message string
+}
+
+// Error returns a string representation of the error.
+func (e *InvalidFilterError) Error() string {
+	return fmt.Sprintf("invalid filter pattern %q: %s", e.Pattern, e.Message)
+}
+
+// InvalidPresignedURLOptionsError is returned when a presigned URL is requested with an unsupported method or expiry.
+type InvalidPresignedURLOptionsError struct {
+	Message string
+}
+
+// Error returns a string representation of the error.
+func (e *InvalidPresignedURLOptionsError) Error() string {
+	return fmt.Sprintf("invalid presigned URL options: %s", e.Message)
+}
+
+// InvalidUploadIDError is returned when a multipart upload ID is empty.
+type InvalidUploadIDError struct {
+	UploadID string
+}
+
+// Error returns a string representation of the error.
+func (e *InvalidUploadIDError) Error() string {
+	return fmt.Sprintf("invalid upload ID: %s", e.UploadID)
+}
+
+// MissingEncryptionKeyError is returned when an object encrypted with a
+// customer key (SSE-C) is downloaded without that key.
+type MissingEncryptionKeyError struct {
+	Bucket string
+	Key    string
+}
+
+// Error returns a string representation of the error.
+func (e *MissingEncryptionKeyError) Error() string {
+	return fmt.Sprintf("object %s/%s is encrypted with a customer key: set CustomerKey in the download options", e.Bucket, e.Key)
+}
+
+// isObjectNotFound reports whether err is the response to a request for an
+// object or object version that does not exist.
+func isObjectNotFound(err error) bool {
+	switch minio.ToErrorResponse(err).Code {
+	case "NoSuchKey", "NoSuchVersion":
+		return true
+	default:
+		return false
+	}
+}
@@ -85,6 +85,36 @@ func TestObjectError(t *testing.T) {
 	}
 }
 
+func TestInvalidFilterError(t *testing.T) {
+	t.Parallel()
+
+	err := &InvalidFilterError{Pattern: "[a-", Message: "syntax error in pattern"}
+	expectedMsg := `invalid filter pattern "[a-": syntax error in pattern`
+	if err.Error() != expectedMsg {
+		t.Errorf("InvalidFilterError.Error() expected %q, got %q", expectedMsg, err.Error())
+	}
+}
+
+func TestInvalidPresignedURLOptionsError(t *testing.T) {
+	t.Parallel()
+
+	err := &InvalidPresignedURLOptionsError{Message: "method \"POST\" is not supported"}
+	expectedMsg := `invalid presigned URL options: method "POST" is not supported`
+	if err.Error() != expectedMsg {
+		t.Errorf("InvalidPresignedURLOptionsError.Error() expected %q, got %q", expectedMsg, err.Error())
+	}
+}
+
+func TestMissingEncryptionKeyError(t *testing.T) {
+	t.Parallel()
+
+	err := &MissingEncryptionKeyError{Bucket: "my-bucket", Key: "secret.txt"}
+	expectedMsg := "object my-bucket/secret.txt is encrypted with a customer key: set CustomerKey in the download options"
+	if err.Error() != expectedMsg {
+		t.Errorf("MissingEncryptionKeyError.Error() expected %q, got %q", expectedMsg, err.Error())
+	}
+}
+
 func TestErrorImplementsInterface(t *testing.T) {
 	t.Parallel()
 
@@ -93,4 +123,7 @@ func TestErrorImplementsInterface(t *testing.T) {
 	var _ error = (*InvalidObjectDataError)(nil)
 	var _ error = (*BucketError)(nil)
 	var _ error = (*ObjectError)(nil)
+	var _ error = (*InvalidFilterError)(nil)
+	var _ error = (*InvalidPresignedURLOptionsError)(nil)
+	var _ error = (*MissingEncryptionKeyError)(nil)
 }
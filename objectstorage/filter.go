@@ -0,0 +1,174 @@
+package objectstorage
+
+import (
+	"path"
+	"regexp"
+	"strings"
+)
+
+// keyMatcher reports whether an object key satisfies a single pattern.
+type keyMatcher func(key string) bool
+
+// keyFilter applies the Include/Exclude rules of ObjectFilterOptions.
+type keyFilter struct {
+	include []keyMatcher
+	exclude []keyMatcher
+}
+
+// newKeyFilter compiles the Include and Exclude patterns of opts. It returns
+// nil when no patterns are set, so callers can skip filtering entirely.
+func newKeyFilter(opts ObjectFilterOptions) (*keyFilter, error) {
+	if len(opts.Include) == 0 && len(opts.Exclude) == 0 {
+		return nil, nil
+	}
+
+	mode := opts.MatchMode
+	if mode == "" {
+		mode = MatchModeGlob
+	}
+
+	include, err := compileMatchers(opts.Include, mode)
+	if err != nil {
+		return nil, err
+	}
+
+	exclude, err := compileMatchers(opts.Exclude, mode)
+	if err != nil {
+		return nil, err
+	}
+
+	return &keyFilter{include: include, exclude: exclude}, nil
+}
+
+// Validate checks that every Include and Exclude pattern compiles under
+// MatchMode, returning an *InvalidFilterError otherwise. ListAll
+// performs the same check, so Validate is only needed to reject a filter
+// before it is used, for example when it is loaded from a configuration file.
+func (o ObjectFilterOptions) Validate() error {
+	_, err := newKeyFilter(o)
+	return err
+}
+
+// Match reports whether key passes the filter. Exclude takes precedence over Include.
+func (f *keyFilter) Match(key string) bool {
+	if f == nil {
+		return true
+	}
+
+	for _, m := range f.exclude {
+		if m(key) {
+			return false
+		}
+	}
+
+	if len(f.include) == 0 {
+		return true
+	}
+
+	for _, m := range f.include {
+		if m(key) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func compileMatchers(patterns []string, mode MatchMode) ([]keyMatcher, error) {
+	matchers := make([]keyMatcher, 0, len(patterns))
+	for _, pattern := range patterns {
+		m, err := compileMatcher(pattern, mode)
+		if err != nil {
+			return nil, err
+		}
+		matchers = append(matchers, m)
+	}
+	return matchers, nil
+}
+
+func compileMatcher(pattern string, mode MatchMode) (keyMatcher, error) {
+	switch mode {
+	case MatchModeSubstring:
+		return func(key string) bool {
+			return strings.Contains(key, pattern)
+		}, nil
+	case MatchModeRegex:
+		re, err := regexp.Compile(pattern)
+		if err != nil {
+			return nil, &InvalidFilterError{Pattern: pattern, Message: err.Error()}
+		}
+		return re.MatchString, nil
+	case MatchModeGlob:
+		return compileGlob(pattern)
+	default:
+		return nil, &InvalidFilterError{Pattern: pattern, Message: "unknown match mode " + string(mode)}
+	}
+}
+
+// compileGlob builds a matcher for a glob pattern. Plain patterns use
+// path.Match directly; patterns containing "**" are translated to a regular
+// expression because path.Match has no recursive wildcard.
+func compileGlob(pattern string) (keyMatcher, error) {
+	if pattern == "" {
+		return nil, &InvalidFilterError{Pattern: pattern, Message: "pattern cannot be empty"}
+	}
+
+	if !strings.Contains(pattern, "**") {
+		if _, err := path.Match(pattern, ""); err != nil {
+			return nil, &InvalidFilterError{Pattern: pattern, Message: err.Error()}
+		}
+
+		baseOnly := !strings.Contains(pattern, "/")
+		return func(key string) bool {
+			target := key
+			if baseOnly {
+				target = path.Base(strings.TrimSuffix(key, "/"))
+			}
+			ok, _ := path.Match(pattern, target)
+			return ok
+		}, nil
+	}
+
+	re, err := globToRegexp(pattern)
+	if err != nil {
+		return nil, &InvalidFilterError{Pattern: pattern, Message: err.Error()}
+	}
+	return re.MatchString, nil
+}
+
+func globToRegexp(pattern string) (*regexp.Regexp, error) {
+	var b strings.Builder
+	b.WriteString("^")
+
+	for i := 0; i < len(pattern); i++ {
+		c := pattern[i]
+		switch {
+		case strings.HasPrefix(pattern[i:], "**/"):
+			b.WriteString("(?:.*/)?")
+			i += 2
+		case strings.HasPrefix(pattern[i:], "**"):
+			b.WriteString(".*")
+			i++
+		case c == '*':
+			b.WriteString("[^/]*")
+		case c == '?':
+			b.WriteString("[^/]")
+		case c == '[':
+			end := strings.IndexByte(pattern[i+1:], ']')
+			if end < 0 {
+				return nil, path.ErrBadPattern
+			}
+			// path.Match and regexp share the "[^...]" negation syntax.
+			b.WriteString("[" + pattern[i+1:i+1+end] + "]")
+			i += end + 1
+		case c == '\\' && i+1 < len(pattern):
+			i++
+			b.WriteString(regexp.QuoteMeta(string(pattern[i])))
+		default:
+			b.WriteString(regexp.QuoteMeta(string(c)))
+		}
+	}
+
+	b.WriteString("$")
+	return regexp.Compile(b.String())
+}
@@ -0,0 +1,201 @@
+package objectstorage
+
+import (
+	"bytes"
+	"context"
+	"encoding/gob"
+	"encoding/json"
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/minio/minio-go/v7"
+)
+
+func TestKeyFilter_Match(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		opts ObjectFilterOptions
+		key  string
+		want bool
+	}{
+		{name: "no patterns", opts: ObjectFilterOptions{}, key: "a/b.txt", want: true},
+		{name: "glob base name", opts: ObjectFilterOptions{Include: []string{"*.log"}}, key: "logs/app.log", want: true},
+		{name: "glob base name no match", opts: ObjectFilterOptions{Include: []string{"*.log"}}, key: "logs/app.txt", want: false},
+		{name: "glob with slash is anchored", opts: ObjectFilterOptions{Include: []string{"logs/*.log"}}, key: "old/logs/app.log", want: false},
+		{name: "glob single star does not cross slash", opts: ObjectFilterOptions{Include: []string{"logs/*"}}, key: "logs/2024/app.log", want: false},
+		{name: "glob double star recursive", opts: ObjectFilterOptions{Include: []string{"tmp/**"}}, key: "tmp/a/b/c.txt", want: true},
+		{name: "glob double star prefix", opts: ObjectFilterOptions{Include: []string{"**/cache/*"}}, key: "cache/x", want: true},
+		{name: "glob double star nested prefix", opts: ObjectFilterOptions{Include: []string{"**/cache/*"}}, key: "a/b/cache/x", want: true},
+		{name: "exclude only", opts: ObjectFilterOptions{Exclude: []string{"tmp/**"}}, key: "tmp/file", want: false},
+		{name: "exclude only keeps others", opts: ObjectFilterOptions{Exclude: []string{"tmp/**"}}, key: "data/file", want: true},
+		{
+			name: "exclude wins over include",
+			opts: ObjectFilterOptions{Include: []string{"*.log"}, Exclude: []string{"tmp/**"}},
+			key:  "tmp/app.log",
+			want: false,
+		},
+		{
+			name: "any include matches",
+			opts: ObjectFilterOptions{Include: []string{"*.txt", "*.log"}},
+			key:  "app.log",
+			want: true,
+		},
+		{
+			name: "substring",
+			opts: ObjectFilterOptions{Include: []string{"backup"}, MatchMode: MatchModeSubstring},
+			key:  "db/backup-2024.tar",
+			want: true,
+		},
+		{
+			name: "regex",
+			opts: ObjectFilterOptions{Include: []string{`^db/\d{4}\.tar$`}, MatchMode: MatchModeRegex},
+			key:  "db/2024.tar",
+			want: true,
+		},
+		{
+			name: "regex no match",
+			opts: ObjectFilterOptions{Include: []string{`^db/\d{4}\.tar$`}, MatchMode: MatchModeRegex},
+			key:  "db/24.tar",
+			want: false,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			f, err := newKeyFilter(tt.opts)
+			if err != nil {
+				t.Fatalf("newKeyFilter() error = %v", err)
+			}
+			if got := f.Match(tt.key); got != tt.want {
+				t.Errorf("Match(%q) = %v, want %v", tt.key, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestKeyFilter_InvalidPatterns(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		opts ObjectFilterOptions
+	}{
+		{name: "bad glob", opts: ObjectFilterOptions{Include: []string{"[a-"}}},
+		{name: "bad recursive glob", opts: ObjectFilterOptions{Include: []string{"**/[a-"}}},
+		{name: "empty glob", opts: ObjectFilterOptions{Exclude: []string{""}}},
+		{name: "bad regex", opts: ObjectFilterOptions{Include: []string{"("}, MatchMode: MatchModeRegex}},
+		{name: "unknown mode", opts: ObjectFilterOptions{Include: []string{"x"}, MatchMode: "fuzzy"}},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			_, err := newKeyFilter(tt.opts)
+			var filterErr *InvalidFilterError
+			if !errors.As(err, &filterErr) {
+				t.Fatalf("expected InvalidFilterError, got %v", err)
+			}
+		})
+	}
+}
+
+func TestObjectFilterOptions_Validate(t *testing.T) {
+	t.Parallel()
+
+	valid := ObjectFilterOptions{Include: []string{"logs/**", "*.csv"}, Exclude: []string{"tmp/**"}}
+	if err := valid.Validate(); err != nil {
+		t.Errorf("Validate() error = %v", err)
+	}
+	if err := (ObjectFilterOptions{}).Validate(); err != nil {
+		t.Errorf("Validate() of empty options error = %v", err)
+	}
+
+	err := ObjectFilterOptions{Exclude: []string{"(a"}, MatchMode: MatchModeRegex}.Validate()
+	var filterErr *InvalidFilterError
+	if !errors.As(err, &filterErr) || filterErr.Pattern != "(a" {
+		t.Errorf("Validate() error = %v, want InvalidFilterError for %q", err, "(a")
+	}
+}
+
+func TestObjectFilterOptions_Serialization(t *testing.T) {
+	t.Parallel()
+
+	opts := ObjectFilterOptions{
+		Prefix:    "logs/",
+		Delimiter: "/",
+		Include:   []string{"*.log"},
+		Exclude:   []string{"tmp/**"},
+		MatchMode: MatchModeGlob,
+	}
+
+	data, err := json.Marshal(opts)
+	if err != nil {
+		t.Fatalf("json.Marshal() error = %v", err)
+	}
+	want := `{"prefix":"logs/","delimiter":"/","include":["*.log"],"exclude":["tmp/**"],"match_mode":"glob"}`
+	if string(data) != want {
+		t.Errorf("json.Marshal() = %s, want %s", data, want)
+	}
+
+	var fromJSON ObjectFilterOptions
+	if err := json.Unmarshal(data, &fromJSON); err != nil {
+		t.Fatalf("json.Unmarshal() error = %v", err)
+	}
+	if !reflect.DeepEqual(fromJSON, opts) {
+		t.Errorf("JSON round trip = %+v, want %+v", fromJSON, opts)
+	}
+
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(opts); err != nil {
+		t.Fatalf("gob Encode() error = %v", err)
+	}
+	var fromGob ObjectFilterOptions
+	if err := gob.NewDecoder(&buf).Decode(&fromGob); err != nil {
+		t.Fatalf("gob Decode() error = %v", err)
+	}
+	if !reflect.DeepEqual(fromGob, opts) {
+		t.Errorf("gob round trip = %+v, want %+v", fromGob, opts)
+	}
+}
+
+func TestObjectServiceListAll_IncludeExclude(t *testing.T) {
+	mock := &mockMinioClient{}
+	mock.listObjectsFunc = func(ctx context.Context, bucketName string, opts minio.ListObjectsOptions) <-chan minio.ObjectInfo {
+		ch := make(chan minio.ObjectInfo, 4)
+		for _, key := range []string{"app.log", "tmp/debug.log", "data/report.csv", "logs/server.log"} {
+			ch <- minio.ObjectInfo{Key: key}
+		}
+		close(ch)
+		return ch
+	}
+	svc := newMockObjectService(t, mock)
+
+	objects, err := svc.ListAll(context.Background(), "bucket", ObjectFilterOptions{
+		Include: []string{"*.log"},
+		Exclude: []string{"tmp/**"},
+	})
+	if err != nil {
+		t.Fatalf("ListAll() error = %v", err)
+	}
+
+	var keys []string
+	for _, o := range objects {
+		keys = append(keys, o.Key)
+	}
+	want := []string{"app.log", "logs/server.log"}
+	if !reflect.DeepEqual(keys, want) {
+		t.Errorf("ListAll() keys = %v, want %v", keys, want)
+	}
+
+	_, err = svc.ListAll(context.Background(), "bucket", ObjectFilterOptions{Include: []string{"[z-"}})
+	var filterErr *InvalidFilterError
+	if !errors.As(err, &filterErr) {
+		t.Errorf("expected InvalidFilterError, got %v", err)
+	}
+}
@@ -0,0 +1,131 @@
+package objectstorage
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"net/http"
+	"strings"
+
+	"github.com/minio/minio-go/v7"
+	"github.com/minio/minio-go/v7/pkg/encrypt"
+)
+
+// MaxUserMetadataSize is the largest combined size, in bytes, of the user
+// metadata keys and values stored with an object.
+const MaxUserMetadataSize = 2048
+
+// userMetadataPrefix is the header prefix of user metadata.
+const userMetadataPrefix = "X-Amz-Meta-"
+
+// storageClassHeader is the header carrying the storage class of an object.
+const storageClassHeader = "X-Amz-Storage-Class"
+
+// SetUserMetadata replaces the user metadata of an object without uploading
+// its content again, by copying the object onto itself. The content type,
+// content encoding, content disposition, content language, cache control,
+// expiry, storage class and SSE-S3 encryption are carried over to the copy.
+// An empty map removes all user metadata. Objects encrypted with SSE-KMS are
+// rejected, since the copy could not be encrypted with the same key context.
+func (s *objectService) SetUserMetadata(ctx context.Context, bucketName string, objectKey string, metadata map[string]string) error {
+	if bucketName == "" {
+		return &InvalidBucketNameError{Name: bucketName}
+	}
+
+	if objectKey == "" {
+		return &InvalidObjectKeyError{Key: objectKey}
+	}
+
+	if err := validateUserMetadata(metadata); err != nil {
+		return err
+	}
+
+	info, err := s.client.minioClient.StatObject(ctx, bucketName, objectKey, minio.StatObjectOptions{})
+	if err != nil {
+		return err
+	}
+
+	// Replacing the metadata replaces every stored header, so carry them over.
+	dst := minio.CopyDestOptions{
+		Bucket:             bucketName,
+		Object:             objectKey,
+		UserMetadata:       metadata,
+		ReplaceMetadata:    true,
+		ContentType:        info.ContentType,
+		ContentEncoding:    info.Metadata.Get("Content-Encoding"),
+		ContentDisposition: info.Metadata.Get("Content-Disposition"),
+		ContentLanguage:    info.Metadata.Get("Content-Language"),
+		CacheControl:       info.Metadata.Get("Cache-Control"),
+		Expires:            info.Expires,
+	}
+
+	switch encryptionFromHeader(info.Metadata) {
+	case EncryptionSSES3:
+		dst.Encryption = encrypt.NewSSE()
+	case EncryptionSSEKMS:
+		return &InvalidObjectDataError{Message: fmt.Sprintf("cannot replace the user metadata of %s/%s: SSE-KMS encryption would not be preserved", bucketName, objectKey)}
+	}
+
+	// CopyDestOptions has no storage class field, but minio sends an
+	// x-amz-storage-class entry of the metadata map as the header itself.
+	if info.StorageClass != "" {
+		dst.UserMetadata = make(map[string]string, len(metadata)+1)
+		maps.Copy(dst.UserMetadata, metadata)
+		dst.UserMetadata[storageClassHeader] = info.StorageClass
+	}
+
+	src := minio.CopySrcOptions{
+		Bucket: bucketName,
+		Object: objectKey,
+	}
+
+	_, err = s.client.minioClient.CopyObject(ctx, dst, src)
+	return err
+}
+
+// validateUserMetadata checks that metadata can be sent as x-amz-meta-* headers.
+func validateUserMetadata(metadata map[string]string) error {
+	size := 0
+	for key, value := range metadata {
+		if key == "" {
+			return &InvalidObjectDataError{Message: "user metadata key cannot be empty"}
+		}
+		for _, r := range key {
+			if !isMetadataKeyRune(r) {
+				return &InvalidObjectDataError{Message: fmt.Sprintf("user metadata key %q may only contain letters, digits, hyphens and underscores", key)}
+			}
+		}
+		for _, r := range value {
+			if r < ' ' || r > '~' {
+				return &InvalidObjectDataError{Message: fmt.Sprintf("user metadata value of %q must be printable ASCII", key)}
+			}
+		}
+		size += len(key) + len(value)
+	}
+
+	if size > MaxUserMetadataSize {
+		return &InvalidObjectDataError{Message: fmt.Sprintf("user metadata is %d bytes, the limit is %d", size, MaxUserMetadataSize)}
+	}
+
+	return nil
+}
+
+func isMetadataKeyRune(r rune) bool {
+	return (r >= 'a' && r <= 'z') || (r >= 'A' && r <= 'Z') || (r >= '0' && r <= '9') || r == '-' || r == '_'
+}
+
+// userMetadataFromHeader extracts the x-amz-meta-* headers of a response,
+// with the prefix removed and keys in lower case.
+func userMetadataFromHeader(header http.Header) map[string]string {
+	var metadata map[string]string
+	for key, values := range header {
+		if len(values) == 0 || !strings.HasPrefix(http.CanonicalHeaderKey(key), userMetadataPrefix) {
+			continue
+		}
+		if metadata == nil {
+			metadata = make(map[string]string)
+		}
+		metadata[strings.ToLower(key[len(userMetadataPrefix):])] = values[0]
+	}
+	return metadata
+}
@@ -0,0 +1,207 @@
+package objectstorage
+
+import (
+	"context"
+	"net/http"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/minio/minio-go/v7"
+)
+
+func TestObjectServiceUserMetadata(t *testing.T) {
+	t.Parallel()
+	mock, svc := newMultipartTestClient(t)
+	ctx := context.Background()
+
+	err := svc.UploadWithOptions(ctx, "test-bucket", "report.pdf", []byte("data"), UploadOptions{
+		ContentType:  "application/pdf",
+		UserMetadata: map[string]string{"owner": "billing", "build_id": "42"},
+	})
+	if err != nil {
+		t.Fatalf("UploadWithOptions() error = %v", err)
+	}
+
+	obj, err := svc.Metadata(ctx, "test-bucket", "report.pdf")
+	if err != nil {
+		t.Fatalf("Metadata() error = %v", err)
+	}
+	want := map[string]string{"owner": "billing", "build_id": "42"}
+	if !reflect.DeepEqual(obj.UserMetadata, want) {
+		t.Errorf("Metadata().UserMetadata = %v, want %v", obj.UserMetadata, want)
+	}
+
+	if err := svc.SetUserMetadata(ctx, "test-bucket", "report.pdf", map[string]string{"owner": "finance"}); err != nil {
+		t.Fatalf("SetUserMetadata() error = %v", err)
+	}
+
+	obj, err = svc.Metadata(ctx, "test-bucket", "report.pdf")
+	if err != nil {
+		t.Fatalf("Metadata() error = %v", err)
+	}
+	if !reflect.DeepEqual(obj.UserMetadata, map[string]string{"owner": "finance"}) {
+		t.Errorf("UserMetadata after SetUserMetadata = %v", obj.UserMetadata)
+	}
+	if obj.ContentType != "application/pdf" {
+		t.Errorf("ContentType after SetUserMetadata = %q, want application/pdf", obj.ContentType)
+	}
+
+	if stored := mock.buckets["test-bucket"].objects["report.pdf"]; stored.size != 4 {
+		t.Errorf("SetUserMetadata() changed the object size to %d", stored.size)
+	}
+}
+
+func TestObjectServiceUserMetadata_Validation(t *testing.T) {
+	t.Parallel()
+	_, svc := newMultipartTestClient(t)
+	ctx := context.Background()
+
+	tests := []struct {
+		name     string
+		metadata map[string]string
+		wantMsg  string
+	}{
+		{name: "empty key", metadata: map[string]string{"": "v"}, wantMsg: "cannot be empty"},
+		{name: "key with space", metadata: map[string]string{"my key": "v"}, wantMsg: "letters, digits"},
+		{name: "non ASCII value", metadata: map[string]string{"city": "São Paulo"}, wantMsg: "printable ASCII"},
+		{name: "too large", metadata: map[string]string{"blob": strings.Repeat("a", MaxUserMetadataSize)}, wantMsg: "the limit is"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := svc.UploadWithOptions(ctx, "test-bucket", "key", []byte("x"), UploadOptions{UserMetadata: tt.metadata})
+			dataErr, ok := err.(*InvalidObjectDataError)
+			if !ok || !strings.Contains(dataErr.Message, tt.wantMsg) {
+				t.Errorf("UploadWithOptions() error = %v, want InvalidObjectDataError containing %q", err, tt.wantMsg)
+			}
+
+			err = svc.SetUserMetadata(ctx, "test-bucket", "key", tt.metadata)
+			if _, ok := err.(*InvalidObjectDataError); !ok {
+				t.Errorf("SetUserMetadata() error = %T, want *InvalidObjectDataError", err)
+			}
+		})
+	}
+
+	if err := svc.SetUserMetadata(ctx, "", "key", nil); err == nil {
+		t.Error("SetUserMetadata() expected error for empty bucket name")
+	}
+	if err := svc.SetUserMetadata(ctx, "test-bucket", "", nil); err == nil {
+		t.Error("SetUserMetadata() expected error for empty object key")
+	}
+}
+
+func TestUserMetadataFromHeader(t *testing.T) {
+	t.Parallel()
+
+	header := http.Header{}
+	header.Set("X-Amz-Meta-Owner", "billing")
+	header.Set("x-amz-meta-build_id", "42")
+	header.Set("Content-Type", "text/plain")
+
+	got := userMetadataFromHeader(header)
+	want := map[string]string{"owner": "billing", "build_id": "42"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("userMetadataFromHeader() = %v, want %v", got, want)
+	}
+
+	if got := userMetadataFromHeader(http.Header{"Content-Type": {"text/plain"}}); got != nil {
+		t.Errorf("userMetadataFromHeader() = %v, want nil", got)
+	}
+}
+
+func TestObjectServiceUploadHeaders(t *testing.T) {
+	t.Parallel()
+	_, svc := newMultipartTestClient(t)
+	ctx := context.Background()
+
+	err := svc.UploadStreamWithOptions(ctx, "test-bucket", "report.pdf", strings.NewReader("data"), 4, UploadOptions{
+		ContentType:        "application/pdf",
+		ContentDisposition: `attachment; filename="report.pdf"`,
+		CacheControl:       "public, max-age=86400",
+	})
+	if err != nil {
+		t.Fatalf("UploadStreamWithOptions() error = %v", err)
+	}
+
+	check := func(step string) {
+		t.Helper()
+		obj, err := svc.Metadata(ctx, "test-bucket", "report.pdf")
+		if err != nil {
+			t.Fatalf("%s: Metadata() error = %v", step, err)
+		}
+		if obj.ContentDisposition != `attachment; filename="report.pdf"` {
+			t.Errorf("%s: ContentDisposition = %q", step, obj.ContentDisposition)
+		}
+		if obj.CacheControl != "public, max-age=86400" {
+			t.Errorf("%s: CacheControl = %q", step, obj.CacheControl)
+		}
+	}
+
+	check("after upload")
+	if err := svc.SetUserMetadata(ctx, "test-bucket", "report.pdf", map[string]string{"owner": "finance"}); err != nil {
+		t.Fatalf("SetUserMetadata() error = %v", err)
+	}
+	check("after SetUserMetadata")
+}
+
+func TestObjectServiceSetUserMetadata_KeepsHeaders(t *testing.T) {
+	t.Parallel()
+	mock, svc := newMultipartTestClient(t)
+	ctx := context.Background()
+
+	expires := time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC)
+	_, err := mock.PutObject(ctx, "test-bucket", "page.html", strings.NewReader("data"), 4, minio.PutObjectOptions{
+		ContentType:     "text/html",
+		ContentEncoding: "gzip",
+		ContentLanguage: "pt-BR",
+		Expires:         expires,
+		StorageClass:    string(StorageClassColdInstant),
+	})
+	if err != nil {
+		t.Fatalf("PutObject() error = %v", err)
+	}
+
+	if err := svc.SetUserMetadata(ctx, "test-bucket", "page.html", map[string]string{"owner": "web"}); err != nil {
+		t.Fatalf("SetUserMetadata() error = %v", err)
+	}
+
+	stored := mock.buckets["test-bucket"].objects["page.html"]
+	want := map[string]string{
+		"Content-Type":        "text/html",
+		"Content-Encoding":    "gzip",
+		"Content-Language":    "pt-BR",
+		"Expires":             expires.Format(http.TimeFormat),
+		"X-Amz-Storage-Class": string(StorageClassColdInstant),
+	}
+	for header, value := range want {
+		if got := stored.headers.Get(header); got != value {
+			t.Errorf("%s after SetUserMetadata = %q, want %q", header, got, value)
+		}
+	}
+	if !reflect.DeepEqual(stored.userMetadata, map[string]string{"owner": "web"}) {
+		t.Errorf("UserMetadata after SetUserMetadata = %v", stored.userMetadata)
+	}
+}
+
+func TestObjectServiceSetUserMetadata_RejectsKMS(t *testing.T) {
+	t.Parallel()
+	mock, svc := newMultipartTestClient(t)
+	ctx := context.Background()
+
+	mock.buckets["test-bucket"].objects["kms.txt"] = &mockObject{
+		key:     "kms.txt",
+		size:    4,
+		headers: http.Header{"X-Amz-Server-Side-Encryption": {"aws:kms"}},
+	}
+	mock.copyObjectFunc = func(ctx context.Context, dst minio.CopyDestOptions, src minio.CopySrcOptions) (minio.UploadInfo, error) {
+		t.Error("CopyObject() called for an SSE-KMS object")
+		return minio.UploadInfo{}, nil
+	}
+
+	err := svc.SetUserMetadata(ctx, "test-bucket", "kms.txt", map[string]string{"owner": "web"})
+	if _, ok := err.(*InvalidObjectDataError); !ok {
+		t.Errorf("SetUserMetadata() error = %T, want *InvalidObjectDataError", err)
+	}
+}
@@ -3,10 +3,13 @@ package objectstorage
 import (
 	"context"
 	"io"
+	"net/url"
 	"time"
 
 	"github.com/minio/minio-go/v7"
 	"github.com/minio/minio-go/v7/pkg/cors"
+	"github.com/minio/minio-go/v7/pkg/replication"
+	"github.com/minio/minio-go/v7/pkg/tags"
 )
 
 // minioClientInterface defines the interface for MinIO client operations
@@ -26,6 +29,9 @@ type minioClientInterface interface {
 	GetBucketVersioning(ctx context.Context, bucketName string) (minio.BucketVersioningConfiguration, error)
 	EnableVersioning(ctx context.Context, bucketName string) error
 	SuspendVersioning(ctx context.Context, bucketName string) error
+	GetBucketReplication(ctx context.Context, bucketName string) (replication.Config, error)
+	SetBucketReplication(ctx context.Context, bucketName string, cfg replication.Config) error
+	RemoveBucketReplication(ctx context.Context, bucketName string) error
 
 	// Object operations
 	PutObject(ctx context.Context, bucketName string, objectName string, reader io.Reader, objectSize int64, opts minio.PutObjectOptions) (minio.UploadInfo, error)
@@ -33,10 +39,28 @@ type minioClientInterface interface {
 	ListObjects(ctx context.Context, bucketName string, opts minio.ListObjectsOptions) <-chan minio.ObjectInfo
 	RemoveObject(ctx context.Context, bucketName string, objectName string, opts minio.RemoveObjectOptions) error
 	StatObject(ctx context.Context, bucketName string, objectName string, opts minio.StatObjectOptions) (minio.ObjectInfo, error)
+	CopyObject(ctx context.Context, dst minio.CopyDestOptions, src minio.CopySrcOptions) (minio.UploadInfo, error)
+	SelectObjectContent(ctx context.Context, bucketName string, objectName string, opts minio.SelectObjectOptions) (*minio.SelectResults, error)
+	GetObjectTagging(ctx context.Context, bucketName string, objectName string, opts minio.GetObjectTaggingOptions) (*tags.Tags, error)
+	PutObjectTagging(ctx context.Context, bucketName string, objectName string, otags *tags.Tags, opts minio.PutObjectTaggingOptions) error
 	PutObjectRetention(ctx context.Context, bucketName string, objectName string, opts minio.PutObjectRetentionOptions) error
 	GetObjectRetention(ctx context.Context, bucketName string, objectName string, versionID string) (*minio.RetentionMode, *time.Time, error)
+	Presign(ctx context.Context, method string, bucketName string, objectName string, expires time.Duration, reqParams url.Values) (*url.URL, error)
+	ListIncompleteUploads(ctx context.Context, bucketName string, objectPrefix string, recursive bool) <-chan minio.ObjectMultipartInfo
 	SetAppInfo(appName string, appVersion string)
 }
 
+// minioCoreInterface defines the low level multipart operations exposed by minio.Core
+type minioCoreInterface interface {
+	NewMultipartUpload(ctx context.Context, bucket string, object string, opts minio.PutObjectOptions) (string, error)
+	PutObjectPart(ctx context.Context, bucket string, object string, uploadID string, partID int, data io.Reader, size int64, opts minio.PutObjectPartOptions) (minio.ObjectPart, error)
+	CompleteMultipartUpload(ctx context.Context, bucket string, object string, uploadID string, parts []minio.CompletePart, opts minio.PutObjectOptions) (minio.UploadInfo, error)
+	AbortMultipartUpload(ctx context.Context, bucket string, object string, uploadID string) error
+	ListObjectParts(ctx context.Context, bucket string, object string, uploadID string, partNumberMarker int, maxParts int) (minio.ListObjectPartsResult, error)
+}
+
 // Ensure *minio.Client implements minioClientInterface
 var _ minioClientInterface = (*minio.Client)(nil)
+
+// Ensure minio.Core implements minioCoreInterface
+var _ minioCoreInterface = minio.Core{}
@@ -2,11 +2,18 @@ package objectstorage
 
 import (
 	"context"
+	"fmt"
 	"io"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
 	"time"
 
 	"github.com/minio/minio-go/v7"
 	"github.com/minio/minio-go/v7/pkg/cors"
+	"github.com/minio/minio-go/v7/pkg/replication"
+	"github.com/minio/minio-go/v7/pkg/tags"
 )
 
 // mockMinioClient is a mock implementation of the MinIO client for testing
@@ -26,13 +33,20 @@ type mockMinioClient struct {
 	getVersioningFunc      func(ctx context.Context, bucketName string) (minio.BucketVersioningConfiguration, error)
 	enableVersioningFunc   func(ctx context.Context, bucketName string) error
 	suspendVersioningFunc  func(ctx context.Context, bucketName string) error
+	setReplicationFunc     func(ctx context.Context, bucketName string, cfg replication.Config) error
 	putObjectFunc          func(ctx context.Context, bucketName string, objectName string, reader io.Reader, objectSize int64, opts minio.PutObjectOptions) (minio.UploadInfo, error)
 	getObjectFunc          func(ctx context.Context, bucketName string, objectName string, opts minio.GetObjectOptions) (*minio.Object, error)
 	listObjectsFunc        func(ctx context.Context, bucketName string, opts minio.ListObjectsOptions) <-chan minio.ObjectInfo
 	removeObjectFunc       func(ctx context.Context, bucketName string, objectName string, opts minio.RemoveObjectOptions) error
 	statObjectFunc         func(ctx context.Context, bucketName string, objectName string, opts minio.StatObjectOptions) (minio.ObjectInfo, error)
+	copyObjectFunc         func(ctx context.Context, dst minio.CopyDestOptions, src minio.CopySrcOptions) (minio.UploadInfo, error)
+	selectFunc             func(ctx context.Context, bucketName string, objectName string, opts minio.SelectObjectOptions) (*minio.SelectResults, error)
+	putObjectTaggingFunc   func(ctx context.Context, bucketName string, objectName string, otags *tags.Tags, opts minio.PutObjectTaggingOptions) error
 	putObjectRetentionFunc func(ctx context.Context, bucketName string, objectName string, opts minio.PutObjectRetentionOptions) error
 	getObjectRetentionFunc func(ctx context.Context, bucketName string, objectName string, versionID string) (*minio.RetentionMode, *time.Time, error)
+	presignFunc            func(ctx context.Context, method string, bucketName string, objectName string, expires time.Duration, reqParams url.Values) (*url.URL, error)
+	uploads                map[string]*mockUpload
+	nextUploadID           int
 	setAppInfoCalls        int
 	lastAppName            string
 	lastAppVersion         string
@@ -44,6 +58,7 @@ type mockBucket struct {
 	policy       string
 	corsConfig   *cors.Config
 	versioning   minio.BucketVersioningConfiguration
+	replication  replication.Config
 	lockConfig   *mockLockConfig
 	objects      map[string]*mockObject
 }
@@ -61,10 +76,20 @@ type mockObject struct {
 	lastModified time.Time
 	etag         string
 	contentType  string
+	headers      http.Header
+	userMetadata map[string]string
+	tags         map[string]string
 	data         []byte
 	retention    *mockObjectRetention
 }
 
+type mockUpload struct {
+	bucket    string
+	key       string
+	initiated time.Time
+	parts     map[int][]byte
+}
+
 type mockObjectRetention struct {
 	mode            *minio.RetentionMode
 	retainUntilDate *time.Time
@@ -260,6 +285,39 @@ func (m *mockMinioClient) SuspendVersioning(ctx context.Context, bucketName stri
 	return nil
 }
 
+// GetBucketReplication mocks the MinIO GetBucketReplication method
+func (m *mockMinioClient) GetBucketReplication(ctx context.Context, bucketName string) (replication.Config, error) {
+	bucket, exists := m.buckets[bucketName]
+	if !exists {
+		return replication.Config{}, fmt.Errorf("bucket %s not found", bucketName)
+	}
+	return bucket.replication, nil
+}
+
+// SetBucketReplication mocks the MinIO SetBucketReplication method
+func (m *mockMinioClient) SetBucketReplication(ctx context.Context, bucketName string, cfg replication.Config) error {
+	if m.setReplicationFunc != nil {
+		return m.setReplicationFunc(ctx, bucketName, cfg)
+	}
+
+	bucket, exists := m.buckets[bucketName]
+	if !exists {
+		return fmt.Errorf("bucket %s not found", bucketName)
+	}
+	bucket.replication = cfg
+	return nil
+}
+
+// RemoveBucketReplication mocks the MinIO RemoveBucketReplication method
+func (m *mockMinioClient) RemoveBucketReplication(ctx context.Context, bucketName string) error {
+	bucket, exists := m.buckets[bucketName]
+	if !exists {
+		return fmt.Errorf("bucket %s not found", bucketName)
+	}
+	bucket.replication = replication.Config{}
+	return nil
+}
+
 // PutObject mocks the MinIO PutObject method
 func (m *mockMinioClient) PutObject(ctx context.Context, bucketName string, objectName string, reader io.Reader, objectSize int64, opts minio.PutObjectOptions) (minio.UploadInfo, error) {
 	if m.putObjectFunc != nil {
@@ -277,6 +335,8 @@ func (m *mockMinioClient) PutObject(ctx context.Context, bucketName string, obje
 		lastModified: time.Now(),
 		etag:         "mock-etag",
 		contentType:  opts.ContentType,
+		headers:      opts.Header(),
+		userMetadata: opts.UserMetadata,
 	}
 
 	return minio.UploadInfo{
@@ -311,13 +371,27 @@ func (m *mockMinioClient) ListObjects(ctx context.Context, bucketName string, op
 			return
 		}
 
-		for _, obj := range bucket.objects {
-			ch <- minio.ObjectInfo{
+		keys := make([]string, 0, len(bucket.objects))
+		for key := range bucket.objects {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+
+		for _, key := range keys {
+			obj := bucket.objects[key]
+			if !strings.HasPrefix(obj.key, opts.Prefix) || obj.key <= opts.StartAfter {
+				continue
+			}
+			select {
+			case <-ctx.Done():
+				return
+			case ch <- minio.ObjectInfo{
 				Key:          obj.key,
 				Size:         obj.size,
 				LastModified: obj.lastModified,
 				ETag:         obj.etag,
 				ContentType:  obj.contentType,
+			}:
 			}
 		}
 	}()
@@ -346,23 +420,111 @@ func (m *mockMinioClient) StatObject(ctx context.Context, bucketName string, obj
 
 	bucket, exists := m.buckets[bucketName]
 	if !exists {
-		return minio.ObjectInfo{}, nil
+		return minio.ObjectInfo{}, minio.ErrorResponse{StatusCode: http.StatusNotFound, Code: "NoSuchBucket", BucketName: bucketName}
 	}
 
 	obj, exists := bucket.objects[objectName]
 	if !exists {
-		return minio.ObjectInfo{}, nil
+		return minio.ObjectInfo{}, minio.ErrorResponse{StatusCode: http.StatusNotFound, Code: "NoSuchKey", BucketName: bucketName, Key: objectName}
 	}
 
+	metadata := make(http.Header)
+	for _, k := range []string{"Content-Encoding", "Content-Disposition", "Content-Language", "Cache-Control", "Expires", "X-Amz-Server-Side-Encryption", "X-Amz-Server-Side-Encryption-Customer-Algorithm"} {
+		if v := obj.headers.Get(k); v != "" {
+			metadata.Set(k, v)
+		}
+	}
+	for k, v := range obj.userMetadata {
+		metadata.Set("X-Amz-Meta-"+k, v)
+	}
+
+	expires, _ := http.ParseTime(obj.headers.Get("Expires"))
+
 	return minio.ObjectInfo{
 		Key:          obj.key,
 		Size:         obj.size,
 		LastModified: obj.lastModified,
 		ETag:         obj.etag,
 		ContentType:  obj.contentType,
+		Expires:      expires,
+		Metadata:     metadata,
+		StorageClass: obj.headers.Get("X-Amz-Storage-Class"),
 	}, nil
 }
 
+// CopyObject mocks the MinIO CopyObject method
+func (m *mockMinioClient) SelectObjectContent(ctx context.Context, bucketName string, objectName string, opts minio.SelectObjectOptions) (*minio.SelectResults, error) {
+	if m.selectFunc != nil {
+		return m.selectFunc(ctx, bucketName, objectName, opts)
+	}
+	return nil, fmt.Errorf("select not supported for %s/%s", bucketName, objectName)
+}
+
+func (m *mockMinioClient) CopyObject(ctx context.Context, dst minio.CopyDestOptions, src minio.CopySrcOptions) (minio.UploadInfo, error) {
+	if m.copyObjectFunc != nil {
+		return m.copyObjectFunc(ctx, dst, src)
+	}
+
+	srcBucket, exists := m.buckets[src.Bucket]
+	if !exists {
+		return minio.UploadInfo{}, fmt.Errorf("bucket %s not found", src.Bucket)
+	}
+	obj, exists := srcBucket.objects[src.Object]
+	if !exists {
+		return minio.UploadInfo{}, fmt.Errorf("object %s not found", src.Object)
+	}
+	dstBucket, exists := m.buckets[dst.Bucket]
+	if !exists {
+		return minio.UploadInfo{}, fmt.Errorf("bucket %s not found", dst.Bucket)
+	}
+
+	copied := *obj
+	copied.key = dst.Object
+	copied.lastModified = time.Now()
+	if dst.ReplaceMetadata {
+		copied.contentType = dst.ContentType
+		copied.headers = make(http.Header)
+		dst.Marshal(copied.headers)
+		copied.userMetadata = userMetadataFromHeader(copied.headers)
+	}
+	dstBucket.objects[dst.Object] = &copied
+
+	return minio.UploadInfo{Bucket: dst.Bucket, Key: dst.Object, ETag: copied.etag, Size: copied.size}, nil
+}
+
+// GetObjectTagging mocks the MinIO GetObjectTagging method
+func (m *mockMinioClient) GetObjectTagging(ctx context.Context, bucketName string, objectName string, opts minio.GetObjectTaggingOptions) (*tags.Tags, error) {
+	bucket, exists := m.buckets[bucketName]
+	if !exists {
+		return nil, fmt.Errorf("bucket %s not found", bucketName)
+	}
+	obj, exists := bucket.objects[objectName]
+	if !exists {
+		return nil, fmt.Errorf("object %s not found", objectName)
+	}
+
+	return tags.NewTags(obj.tags, true)
+}
+
+// PutObjectTagging mocks the MinIO PutObjectTagging method
+func (m *mockMinioClient) PutObjectTagging(ctx context.Context, bucketName string, objectName string, otags *tags.Tags, opts minio.PutObjectTaggingOptions) error {
+	if m.putObjectTaggingFunc != nil {
+		return m.putObjectTaggingFunc(ctx, bucketName, objectName, otags, opts)
+	}
+
+	bucket, exists := m.buckets[bucketName]
+	if !exists {
+		return fmt.Errorf("bucket %s not found", bucketName)
+	}
+	obj, exists := bucket.objects[objectName]
+	if !exists {
+		return fmt.Errorf("object %s not found", objectName)
+	}
+
+	obj.tags = otags.ToMap()
+	return nil
+}
+
 // PutObjectRetention mocks the MinIO PutObjectRetention method
 func (m *mockMinioClient) PutObjectRetention(ctx context.Context, bucketName string, objectName string, opts minio.PutObjectRetentionOptions) error {
 	if m.putObjectRetentionFunc != nil {
@@ -410,3 +572,128 @@ func (m *mockMinioClient) SetAppInfo(appName string, appVersion string) {
 	m.lastAppName = appName
 	m.lastAppVersion = appVersion
 }
+
+// Presign mocks the MinIO Presign method
+func (m *mockMinioClient) Presign(ctx context.Context, method string, bucketName string, objectName string, expires time.Duration, reqParams url.Values) (*url.URL, error) {
+	if m.presignFunc != nil {
+		return m.presignFunc(ctx, method, bucketName, objectName, expires, reqParams)
+	}
+
+	query := url.Values{}
+	for k, v := range reqParams {
+		query[k] = v
+	}
+	query.Set("X-Amz-Expires", fmt.Sprintf("%d", int64(expires.Seconds())))
+	query.Set("X-Mock-Method", method)
+
+	return &url.URL{
+		Scheme:   "https",
+		Host:     "br-se1.magaluobjects.com",
+		Path:     "/" + bucketName + "/" + objectName,
+		RawQuery: query.Encode(),
+	}, nil
+}
+
+// ListIncompleteUploads mocks the MinIO ListIncompleteUploads method
+func (m *mockMinioClient) ListIncompleteUploads(ctx context.Context, bucketName string, objectPrefix string, recursive bool) <-chan minio.ObjectMultipartInfo {
+	ch := make(chan minio.ObjectMultipartInfo)
+	go func() {
+		defer close(ch)
+		for id, upload := range m.uploads {
+			if upload.bucket != bucketName || !strings.HasPrefix(upload.key, objectPrefix) {
+				continue
+			}
+			select {
+			case <-ctx.Done():
+				return
+			case ch <- minio.ObjectMultipartInfo{Key: upload.key, UploadID: id, Initiated: upload.initiated}:
+			}
+		}
+	}()
+	return ch
+}
+
+// NewMultipartUpload mocks the MinIO Core NewMultipartUpload method
+func (m *mockMinioClient) NewMultipartUpload(ctx context.Context, bucket string, object string, opts minio.PutObjectOptions) (string, error) {
+	if _, exists := m.buckets[bucket]; !exists {
+		return "", minio.ErrorResponse{Code: "NoSuchBucket"}
+	}
+	if m.uploads == nil {
+		m.uploads = make(map[string]*mockUpload)
+	}
+	m.nextUploadID++
+	id := fmt.Sprintf("upload-%d", m.nextUploadID)
+	m.uploads[id] = &mockUpload{bucket: bucket, key: object, initiated: time.Now(), parts: make(map[int][]byte)}
+	return id, nil
+}
+
+// PutObjectPart mocks the MinIO Core PutObjectPart method
+func (m *mockMinioClient) PutObjectPart(ctx context.Context, bucket string, object string, uploadID string, partID int, data io.Reader, size int64, opts minio.PutObjectPartOptions) (minio.ObjectPart, error) {
+	upload, exists := m.uploads[uploadID]
+	if !exists {
+		return minio.ObjectPart{}, minio.ErrorResponse{Code: "NoSuchUpload"}
+	}
+	content, err := io.ReadAll(data)
+	if err != nil {
+		return minio.ObjectPart{}, err
+	}
+	upload.parts[partID] = content
+	return minio.ObjectPart{PartNumber: partID, ETag: fmt.Sprintf("etag-%d", partID), Size: int64(len(content)), LastModified: time.Now()}, nil
+}
+
+// ListObjectParts mocks the MinIO Core ListObjectParts method, returning two parts per page
+func (m *mockMinioClient) ListObjectParts(ctx context.Context, bucket string, object string, uploadID string, partNumberMarker int, maxParts int) (minio.ListObjectPartsResult, error) {
+	upload, exists := m.uploads[uploadID]
+	if !exists {
+		return minio.ListObjectPartsResult{}, minio.ErrorResponse{Code: "NoSuchUpload"}
+	}
+
+	numbers := make([]int, 0, len(upload.parts))
+	for n := range upload.parts {
+		if n > partNumberMarker {
+			numbers = append(numbers, n)
+		}
+	}
+	sort.Ints(numbers)
+
+	result := minio.ListObjectPartsResult{UploadID: uploadID}
+	for i, n := range numbers {
+		if i == 2 {
+			result.IsTruncated = true
+			break
+		}
+		result.ObjectParts = append(result.ObjectParts, minio.ObjectPart{PartNumber: n, ETag: fmt.Sprintf("etag-%d", n), Size: int64(len(upload.parts[n]))})
+		result.NextPartNumberMarker = n
+	}
+	return result, nil
+}
+
+// CompleteMultipartUpload mocks the MinIO Core CompleteMultipartUpload method
+func (m *mockMinioClient) CompleteMultipartUpload(ctx context.Context, bucket string, object string, uploadID string, parts []minio.CompletePart, opts minio.PutObjectOptions) (minio.UploadInfo, error) {
+	upload, exists := m.uploads[uploadID]
+	if !exists {
+		return minio.UploadInfo{}, minio.ErrorResponse{Code: "NoSuchUpload"}
+	}
+
+	var data []byte
+	for _, part := range parts {
+		content, ok := upload.parts[part.PartNumber]
+		if !ok {
+			return minio.UploadInfo{}, minio.ErrorResponse{Code: "InvalidPart"}
+		}
+		data = append(data, content...)
+	}
+
+	m.buckets[bucket].objects[object] = &mockObject{key: object, size: int64(len(data)), data: data, lastModified: time.Now()}
+	delete(m.uploads, uploadID)
+	return minio.UploadInfo{Bucket: bucket, Key: object, Size: int64(len(data))}, nil
+}
+
+// AbortMultipartUpload mocks the MinIO Core AbortMultipartUpload method
+func (m *mockMinioClient) AbortMultipartUpload(ctx context.Context, bucket string, object string, uploadID string) error {
+	if _, exists := m.uploads[uploadID]; !exists {
+		return minio.ErrorResponse{Code: "NoSuchUpload"}
+	}
+	delete(m.uploads, uploadID)
+	return nil
+}
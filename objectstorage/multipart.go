@@ -0,0 +1,204 @@
+package objectstorage
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/minio/minio-go/v7"
+)
+
+// InitiateMultipartUpload starts a multipart upload and returns its upload ID.
+// Parts are then sent with UploadPart and assembled with CompleteMultipartUpload.
+func (s *objectService) InitiateMultipartUpload(ctx context.Context, bucketName string, objectKey string, contentType string) (string, error) {
+	if err := s.validateMultipart("InitiateMultipartUpload", bucketName, objectKey); err != nil {
+		return "", err
+	}
+
+	return s.client.minioCore.NewMultipartUpload(ctx, bucketName, objectKey, minio.PutObjectOptions{
+		ContentType: contentType,
+	})
+}
+
+// UploadPart uploads one part of a multipart upload. Part numbers range from 1
+// to MaxMultipartParts; uploading the same number again replaces the part.
+func (s *objectService) UploadPart(ctx context.Context, bucketName string, objectKey string, uploadID string, partNumber int, data io.Reader, size int64) (*UploadedPart, error) {
+	if err := s.validateMultipart("UploadPart", bucketName, objectKey); err != nil {
+		return nil, err
+	}
+
+	if uploadID == "" {
+		return nil, &InvalidUploadIDError{UploadID: uploadID}
+	}
+
+	if partNumber < 1 || partNumber > MaxMultipartParts {
+		return nil, &InvalidObjectDataError{Message: fmt.Sprintf("part number must be between 1 and %d", MaxMultipartParts)}
+	}
+
+	if size <= 0 {
+		return nil, &InvalidObjectDataError{Message: "part size must be greater than zero"}
+	}
+
+	part, err := s.client.minioCore.PutObjectPart(ctx, bucketName, objectKey, uploadID, partNumber, withContext(ctx, data), size, minio.PutObjectPartOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	return &UploadedPart{
+		PartNumber:   part.PartNumber,
+		ETag:         part.ETag,
+		Size:         part.Size,
+		LastModified: part.LastModified,
+	}, nil
+}
+
+// ListParts returns the parts uploaded so far for a multipart upload, which is
+// what a resumable uploader needs to know where to continue from.
+func (s *objectService) ListParts(ctx context.Context, bucketName string, objectKey string, uploadID string) ([]UploadedPart, error) {
+	if err := s.validateMultipart("ListParts", bucketName, objectKey); err != nil {
+		return nil, err
+	}
+
+	if uploadID == "" {
+		return nil, &InvalidUploadIDError{UploadID: uploadID}
+	}
+
+	result := make([]UploadedPart, 0)
+	marker := 0
+	for {
+		page, err := s.client.minioCore.ListObjectParts(ctx, bucketName, objectKey, uploadID, marker, 1000)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, part := range page.ObjectParts {
+			result = append(result, UploadedPart{
+				PartNumber:   part.PartNumber,
+				ETag:         part.ETag,
+				Size:         part.Size,
+				LastModified: part.LastModified,
+			})
+		}
+
+		if !page.IsTruncated || page.NextPartNumberMarker <= marker {
+			break
+		}
+		marker = page.NextPartNumberMarker
+	}
+
+	return result, nil
+}
+
+// CompleteMultipartUpload assembles the given parts into the final object.
+// Parts must be listed in ascending part number order.
+func (s *objectService) CompleteMultipartUpload(ctx context.Context, bucketName string, objectKey string, uploadID string, parts []UploadedPart) error {
+	if err := s.validateMultipart("CompleteMultipartUpload", bucketName, objectKey); err != nil {
+		return err
+	}
+
+	if uploadID == "" {
+		return &InvalidUploadIDError{UploadID: uploadID}
+	}
+
+	if len(parts) == 0 {
+		return &InvalidObjectDataError{Message: "at least one part is required"}
+	}
+
+	completeParts := make([]minio.CompletePart, len(parts))
+	for i, part := range parts {
+		if i > 0 && part.PartNumber <= parts[i-1].PartNumber {
+			return &InvalidObjectDataError{Message: "parts must be in ascending part number order"}
+		}
+		completeParts[i] = minio.CompletePart{
+			PartNumber: part.PartNumber,
+			ETag:       part.ETag,
+		}
+	}
+
+	_, err := s.client.minioCore.CompleteMultipartUpload(ctx, bucketName, objectKey, uploadID, completeParts, minio.PutObjectOptions{})
+	return err
+}
+
+// AbortMultipartUpload cancels a multipart upload and discards its uploaded parts.
+func (s *objectService) AbortMultipartUpload(ctx context.Context, bucketName string, objectKey string, uploadID string) error {
+	if err := s.validateMultipart("AbortMultipartUpload", bucketName, objectKey); err != nil {
+		return err
+	}
+
+	if uploadID == "" {
+		return &InvalidUploadIDError{UploadID: uploadID}
+	}
+
+	return s.client.minioCore.AbortMultipartUpload(ctx, bucketName, objectKey, uploadID)
+}
+
+// ListIncompleteUploads returns the unfinished multipart uploads for an object key.
+func (s *objectService) ListIncompleteUploads(ctx context.Context, bucketName string, objectKey string) ([]IncompleteUpload, error) {
+	if bucketName == "" {
+		return nil, &InvalidBucketNameError{Name: bucketName}
+	}
+
+	if objectKey == "" {
+		return nil, &InvalidObjectKeyError{Key: objectKey}
+	}
+
+	uploads, err := listIncompleteUploads(ctx, s.client, bucketName, objectKey)
+	if err != nil {
+		return nil, err
+	}
+
+	// The listing is prefix based, so drop uploads of longer keys.
+	result := make([]IncompleteUpload, 0, len(uploads))
+	for _, upload := range uploads {
+		if upload.Key == objectKey {
+			result = append(result, upload)
+		}
+	}
+
+	return result, nil
+}
+
+// validateMultipart checks the common arguments of the multipart operations.
+func (s *objectService) validateMultipart(operation string, bucketName string, objectKey string) error {
+	if bucketName == "" {
+		return &InvalidBucketNameError{Name: bucketName}
+	}
+
+	if objectKey == "" {
+		return &InvalidObjectKeyError{Key: objectKey}
+	}
+
+	if s.client.minioCore == nil {
+		return &ObjectError{
+			Operation: operation,
+			Bucket:    bucketName,
+			Key:       objectKey,
+			Message:   "multipart uploads are not supported by the configured client",
+		}
+	}
+
+	return nil
+}
+
+// listIncompleteUploads lists the unfinished multipart uploads under a prefix.
+func listIncompleteUploads(ctx context.Context, c *ObjectStorageClient, bucketName string, prefix string) ([]IncompleteUpload, error) {
+	result := make([]IncompleteUpload, 0)
+	err := listEntries(ctx, func(ctx context.Context) <-chan minio.ObjectMultipartInfo {
+		return c.minioClient.ListIncompleteUploads(ctx, bucketName, prefix, true)
+	}, func(upload minio.ObjectMultipartInfo) error {
+		return upload.Err
+	}, func(upload minio.ObjectMultipartInfo) bool {
+		result = append(result, IncompleteUpload{
+			Key:       upload.Key,
+			UploadID:  upload.UploadID,
+			Initiated: upload.Initiated,
+			Size:      upload.Size,
+		})
+		return true
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
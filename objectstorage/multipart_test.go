@@ -0,0 +1,260 @@
+package objectstorage
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/MagaluCloud/mgc-sdk-go/client"
+	"github.com/minio/minio-go/v7"
+)
+
+func newMultipartTestClient(t *testing.T) (*mockMinioClient, ObjectService) {
+	t.Helper()
+
+	mock := newMockMinioClient()
+	mock.buckets["test-bucket"] = &mockBucket{
+		name:         "test-bucket",
+		creationDate: time.Now(),
+		objects:      make(map[string]*mockObject),
+	}
+
+	core := client.NewMgcClient()
+	osClient, err := New(core, "minioadmin", "minioadmin", WithMinioClientInterface(mock))
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+	return mock, osClient.Objects()
+}
+
+func TestObjectServiceMultipartUpload(t *testing.T) {
+	t.Parallel()
+	mock, svc := newMultipartTestClient(t)
+	ctx := context.Background()
+
+	uploadID, err := svc.InitiateMultipartUpload(ctx, "test-bucket", "big.bin", "application/octet-stream")
+	if err != nil {
+		t.Fatalf("InitiateMultipartUpload() error = %v", err)
+	}
+	if uploadID == "" {
+		t.Fatal("InitiateMultipartUpload() returned empty upload ID")
+	}
+
+	chunks := []string{"first-", "second-", "third"}
+	for i, chunk := range chunks {
+		part, err := svc.UploadPart(ctx, "test-bucket", "big.bin", uploadID, i+1, strings.NewReader(chunk), int64(len(chunk)))
+		if err != nil {
+			t.Fatalf("UploadPart(%d) error = %v", i+1, err)
+		}
+		if part.PartNumber != i+1 || part.ETag == "" {
+			t.Errorf("UploadPart(%d) = %+v", i+1, part)
+		}
+	}
+
+	parts, err := svc.ListParts(ctx, "test-bucket", "big.bin", uploadID)
+	if err != nil {
+		t.Fatalf("ListParts() error = %v", err)
+	}
+	if len(parts) != len(chunks) {
+		t.Fatalf("ListParts() returned %d parts, want %d", len(parts), len(chunks))
+	}
+
+	if err := svc.CompleteMultipartUpload(ctx, "test-bucket", "big.bin", uploadID, parts); err != nil {
+		t.Fatalf("CompleteMultipartUpload() error = %v", err)
+	}
+
+	obj := mock.buckets["test-bucket"].objects["big.bin"]
+	if obj == nil || !bytes.Equal(obj.data, []byte("first-second-third")) {
+		t.Errorf("completed object = %+v, want assembled parts", obj)
+	}
+	if len(mock.uploads) != 0 {
+		t.Errorf("expected upload to be removed after completion, got %d", len(mock.uploads))
+	}
+}
+
+func TestObjectServiceAbortMultipartUpload(t *testing.T) {
+	t.Parallel()
+	mock, svc := newMultipartTestClient(t)
+	ctx := context.Background()
+
+	uploadID, err := svc.InitiateMultipartUpload(ctx, "test-bucket", "big.bin", "")
+	if err != nil {
+		t.Fatalf("InitiateMultipartUpload() error = %v", err)
+	}
+	if _, err := svc.UploadPart(ctx, "test-bucket", "big.bin", uploadID, 1, strings.NewReader("data"), 4); err != nil {
+		t.Fatalf("UploadPart() error = %v", err)
+	}
+
+	if err := svc.AbortMultipartUpload(ctx, "test-bucket", "big.bin", uploadID); err != nil {
+		t.Fatalf("AbortMultipartUpload() error = %v", err)
+	}
+	if len(mock.uploads) != 0 {
+		t.Errorf("expected upload to be removed after abort, got %d", len(mock.uploads))
+	}
+	if _, exists := mock.buckets["test-bucket"].objects["big.bin"]; exists {
+		t.Error("aborted upload should not create the object")
+	}
+}
+
+func TestListIncompleteUploads(t *testing.T) {
+	t.Parallel()
+	mock, svc := newMultipartTestClient(t)
+	ctx := context.Background()
+
+	for _, key := range []string{"big.bin", "big.bin.bak", "other/file"} {
+		if _, err := svc.InitiateMultipartUpload(ctx, "test-bucket", key, ""); err != nil {
+			t.Fatalf("InitiateMultipartUpload(%s) error = %v", key, err)
+		}
+	}
+
+	uploads, err := svc.ListIncompleteUploads(ctx, "test-bucket", "big.bin")
+	if err != nil {
+		t.Fatalf("Objects().ListIncompleteUploads() error = %v", err)
+	}
+	if len(uploads) != 1 || uploads[0].Key != "big.bin" {
+		t.Errorf("Objects().ListIncompleteUploads() = %+v, want only big.bin", uploads)
+	}
+
+	core := client.NewMgcClient()
+	osClient, _ := New(core, "minioadmin", "minioadmin", WithMinioClientInterface(mock))
+	all, err := osClient.Buckets().ListIncompleteUploads(ctx, "test-bucket")
+	if err != nil {
+		t.Fatalf("Buckets().ListIncompleteUploads() error = %v", err)
+	}
+	if len(all) != 3 {
+		t.Errorf("Buckets().ListIncompleteUploads() returned %d uploads, want 3", len(all))
+	}
+
+	if _, err := osClient.Buckets().ListIncompleteUploads(ctx, ""); err == nil {
+		t.Error("Buckets().ListIncompleteUploads() expected error for empty bucket name")
+	}
+}
+
+func TestObjectServiceMultipart_Validation(t *testing.T) {
+	t.Parallel()
+	_, svc := newMultipartTestClient(t)
+	ctx := context.Background()
+
+	tests := []struct {
+		name    string
+		call    func() error
+		wantErr any
+	}{
+		{
+			name: "initiate with empty bucket",
+			call: func() error {
+				_, err := svc.InitiateMultipartUpload(ctx, "", "key", "")
+				return err
+			},
+			wantErr: &InvalidBucketNameError{},
+		},
+		{
+			name: "upload part with empty upload ID",
+			call: func() error {
+				_, err := svc.UploadPart(ctx, "test-bucket", "key", "", 1, strings.NewReader("x"), 1)
+				return err
+			},
+			wantErr: &InvalidUploadIDError{},
+		},
+		{
+			name: "upload part with part number zero",
+			call: func() error {
+				_, err := svc.UploadPart(ctx, "test-bucket", "key", "upload-1", 0, strings.NewReader("x"), 1)
+				return err
+			},
+			wantErr: &InvalidObjectDataError{},
+		},
+		{
+			name: "upload part above the part limit",
+			call: func() error {
+				_, err := svc.UploadPart(ctx, "test-bucket", "key", "upload-1", MaxMultipartParts+1, strings.NewReader("x"), 1)
+				return err
+			},
+			wantErr: &InvalidObjectDataError{},
+		},
+		{
+			name: "complete without parts",
+			call: func() error {
+				return svc.CompleteMultipartUpload(ctx, "test-bucket", "key", "upload-1", nil)
+			},
+			wantErr: &InvalidObjectDataError{},
+		},
+		{
+			name: "complete with unordered parts",
+			call: func() error {
+				return svc.CompleteMultipartUpload(ctx, "test-bucket", "key", "upload-1", []UploadedPart{{PartNumber: 2}, {PartNumber: 1}})
+			},
+			wantErr: &InvalidObjectDataError{},
+		},
+		{
+			name: "abort with empty key",
+			call: func() error {
+				return svc.AbortMultipartUpload(ctx, "test-bucket", "", "upload-1")
+			},
+			wantErr: &InvalidObjectKeyError{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.call()
+			if err == nil {
+				t.Fatal("expected error, got nil")
+			}
+			switch tt.wantErr.(type) {
+			case *InvalidBucketNameError:
+				if _, ok := err.(*InvalidBucketNameError); !ok {
+					t.Errorf("error = %T, want *InvalidBucketNameError", err)
+				}
+			case *InvalidObjectKeyError:
+				if _, ok := err.(*InvalidObjectKeyError); !ok {
+					t.Errorf("error = %T, want *InvalidObjectKeyError", err)
+				}
+			case *InvalidUploadIDError:
+				if _, ok := err.(*InvalidUploadIDError); !ok {
+					t.Errorf("error = %T, want *InvalidUploadIDError", err)
+				}
+			case *InvalidObjectDataError:
+				if _, ok := err.(*InvalidObjectDataError); !ok {
+					t.Errorf("error = %T, want *InvalidObjectDataError", err)
+				}
+			}
+		})
+	}
+}
+
+// coreLessMinioClient hides the multipart methods of the mock
+type coreLessMinioClient struct {
+	minioClientInterface
+}
+
+func TestObjectServiceMultipart_Unsupported(t *testing.T) {
+	t.Parallel()
+
+	core := client.NewMgcClient()
+	osClient, _ := New(core, "minioadmin", "minioadmin", WithMinioClientInterface(coreLessMinioClient{newMockMinioClient()}))
+
+	_, err := osClient.Objects().InitiateMultipartUpload(context.Background(), "test-bucket", "key", "")
+	if _, ok := err.(*ObjectError); !ok {
+		t.Errorf("InitiateMultipartUpload() error = %T, want *ObjectError", err)
+	}
+}
+
+func TestNewWithMinioClientSetsCore(t *testing.T) {
+	t.Parallel()
+
+	mc, err := minio.New("br-se1.magaluobjects.com", &minio.Options{})
+	if err != nil {
+		t.Fatalf("minio.New() error = %v", err)
+	}
+
+	osClient, err := New(client.NewMgcClient(), "minioadmin", "minioadmin", WithMinioClient(mc))
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+	if osClient.minioCore == nil {
+		t.Error("expected multipart core to be set for a MinIO client")
+	}
+}
@@ -3,7 +3,10 @@ package objectstorage
 import (
 	"bytes"
 	"context"
+	"fmt"
 	"io"
+	"net/http"
+	"net/url"
 	"time"
 
 	"github.com/minio/minio-go/v7"
@@ -13,16 +16,32 @@ import (
 type ObjectService interface {
 	Upload(ctx context.Context, bucketName string, objectKey string, data []byte, contentType string) error
 	UploadStream(ctx context.Context, bucketName string, objectKey string, data io.Reader, size int64, contentType string) error
+	UploadWithOptions(ctx context.Context, bucketName string, objectKey string, data []byte, opts UploadOptions) error
+	UploadStreamWithOptions(ctx context.Context, bucketName string, objectKey string, data io.Reader, size int64, opts UploadOptions) error
 	Download(ctx context.Context, bucketName string, objectKey string, opts *DownloadOptions) ([]byte, error)
 	DownloadStream(ctx context.Context, bucketName string, objectKey string, opts *DownloadStreamOptions) (io.Reader, error)
+	Select(ctx context.Context, bucketName string, objectKey string, req SelectRequest) (io.ReadCloser, error)
 	List(ctx context.Context, bucketName string, opts ObjectListOptions) ([]Object, error)
 	ListAll(ctx context.Context, bucketName string, opts ObjectFilterOptions) ([]Object, error)
+	ListAllVersions(ctx context.Context, bucketName string, opts ObjectFilterOptions) ([]ObjectVersion, error)
 	ListVersions(ctx context.Context, bucketName string, objectKey string, opts *ListVersionsOptions) ([]ObjectVersion, error)
 	Delete(ctx context.Context, bucketName string, objectKey string, opts *DeleteOptions) error
 	Metadata(ctx context.Context, bucketName string, objectKey string) (*Object, error)
+	Exists(ctx context.Context, bucketName string, objectKey string, opts *MetadataOptions) (bool, error)
+	SetUserMetadata(ctx context.Context, bucketName string, objectKey string, metadata map[string]string) error
+	GetTags(ctx context.Context, bucketName string, objectKey string) (map[string]string, error)
+	SetTags(ctx context.Context, bucketName string, objectKey string, tags map[string]string) error
+	SetTagsByPrefix(ctx context.Context, bucketName string, prefix string, tags map[string]string, opts *TagByPrefixOptions) (*TagByPrefixResult, error)
 	LockObject(ctx context.Context, bucketName string, objectKey string, retainUntilDate time.Time) error
 	UnlockObject(ctx context.Context, bucketName string, objectKey string) error
 	GetObjectLockStatus(ctx context.Context, bucketName string, objectKey string) (bool, error)
+	GetPresignedURL(ctx context.Context, bucketName string, objectKey string, opts PresignedURLOptions) (*url.URL, error)
+	InitiateMultipartUpload(ctx context.Context, bucketName string, objectKey string, contentType string) (string, error)
+	UploadPart(ctx context.Context, bucketName string, objectKey string, uploadID string, partNumber int, data io.Reader, size int64) (*UploadedPart, error)
+	ListParts(ctx context.Context, bucketName string, objectKey string, uploadID string) ([]UploadedPart, error)
+	CompleteMultipartUpload(ctx context.Context, bucketName string, objectKey string, uploadID string, parts []UploadedPart) error
+	AbortMultipartUpload(ctx context.Context, bucketName string, objectKey string, uploadID string) error
+	ListIncompleteUploads(ctx context.Context, bucketName string, objectKey string) ([]IncompleteUpload, error)
 }
 
 // objectService implements the ObjectService interface.
@@ -32,6 +51,16 @@ type objectService struct {
 
 // Upload uploads an object to a bucket.
 func (s *objectService) Upload(ctx context.Context, bucketName string, objectKey string, data []byte, contentType string) error {
+	return s.UploadWithOptions(ctx, bucketName, objectKey, data, UploadOptions{ContentType: contentType})
+}
+
+// UploadStream uploads an object to a bucket from a reader.
+func (s *objectService) UploadStream(ctx context.Context, bucketName string, objectKey string, data io.Reader, size int64, contentType string) error {
+	return s.UploadStreamWithOptions(ctx, bucketName, objectKey, data, size, UploadOptions{ContentType: contentType})
+}
+
+// UploadWithOptions uploads an object to a bucket with the attributes in opts.
+func (s *objectService) UploadWithOptions(ctx context.Context, bucketName string, objectKey string, data []byte, opts UploadOptions) error {
 	if bucketName == "" {
 		return &InvalidBucketNameError{Name: bucketName}
 	}
@@ -44,15 +73,18 @@ func (s *objectService) Upload(ctx context.Context, bucketName string, objectKey
 		return &InvalidObjectDataError{Message: "object data cannot be empty"}
 	}
 
-	_, err := s.client.minioClient.PutObject(ctx, bucketName, objectKey, bytes.NewReader(data), int64(len(data)), minio.PutObjectOptions{
-		ContentType: contentType,
-	})
+	putOpts, err := opts.putObjectOptions()
+	if err != nil {
+		return err
+	}
+
+	_, err = s.client.minioClient.PutObject(ctx, bucketName, objectKey, opts.reader(ctx, bytes.NewReader(data), int64(len(data))), int64(len(data)), putOpts)
 
 	return err
 }
 
-// UploadStream uploads an object to a bucket from a reader.
-func (s *objectService) UploadStream(ctx context.Context, bucketName string, objectKey string, data io.Reader, size int64, contentType string) error {
+// UploadStreamWithOptions uploads an object to a bucket from a reader with the attributes in opts.
+func (s *objectService) UploadStreamWithOptions(ctx context.Context, bucketName string, objectKey string, data io.Reader, size int64, opts UploadOptions) error {
 	if bucketName == "" {
 		return &InvalidBucketNameError{Name: bucketName}
 	}
@@ -65,13 +97,52 @@ func (s *objectService) UploadStream(ctx context.Context, bucketName string, obj
 		return &InvalidObjectDataError{Message: "object size cannot be zero"}
 	}
 
-	_, err := s.client.minioClient.PutObject(ctx, bucketName, objectKey, data, size, minio.PutObjectOptions{
-		ContentType: contentType,
-	})
+	putOpts, err := opts.putObjectOptions()
+	if err != nil {
+		return err
+	}
+
+	_, err = s.client.minioClient.PutObject(ctx, bucketName, objectKey, opts.reader(ctx, data, size), size, putOpts)
 
 	return err
 }
 
+// reader prepares the upload data for minio: data that cannot seek stops
+// with ctx (see withContext), and o.Progress, when set, receives the
+// progress of reading it.
+func (o UploadOptions) reader(ctx context.Context, data io.Reader, size int64) io.Reader {
+	r := withContext(ctx, data)
+	if o.Progress != nil {
+		r = NewProgressReader(r, size, o.Progress)
+	}
+	return r
+}
+
+// putObjectOptions validates the options and converts them for the MinIO client.
+func (o UploadOptions) putObjectOptions() (minio.PutObjectOptions, error) {
+	if err := validateUserMetadata(o.UserMetadata); err != nil {
+		return minio.PutObjectOptions{}, err
+	}
+
+	if err := o.StorageClass.validate(); err != nil {
+		return minio.PutObjectOptions{}, err
+	}
+
+	sse, err := o.Encryption.serverSide()
+	if err != nil {
+		return minio.PutObjectOptions{}, err
+	}
+
+	return minio.PutObjectOptions{
+		ContentType:          o.ContentType,
+		ContentDisposition:   o.ContentDisposition,
+		CacheControl:         o.CacheControl,
+		UserMetadata:         o.UserMetadata,
+		ServerSideEncryption: sse,
+		StorageClass:         string(o.StorageClass),
+	}, nil
+}
+
 // Download retrieves an object from a bucket and returns its content as bytes.
 func (s *objectService) Download(ctx context.Context, bucketName string, objectKey string, opts *DownloadOptions) ([]byte, error) {
 	if bucketName == "" {
@@ -83,19 +154,24 @@ func (s *objectService) Download(ctx context.Context, bucketName string, objectK
 	}
 
 	getOpts := minio.GetObjectOptions{}
-	if opts != nil && opts.VersionID != "" {
+	if opts != nil {
 		getOpts.VersionID = opts.VersionID
+		sse, err := customerKeyEncryption(opts.CustomerKey)
+		if err != nil {
+			return nil, err
+		}
+		getOpts.ServerSideEncryption = sse
 	}
 
 	object, err := s.client.minioClient.GetObject(ctx, bucketName, objectKey, getOpts)
 	if err != nil {
-		return nil, err
+		return nil, downloadError(bucketName, objectKey, err)
 	}
 	defer object.Close()
 
 	data, err := io.ReadAll(object)
 	if err != nil {
-		return nil, err
+		return nil, downloadError(bucketName, objectKey, err)
 	}
 
 	return data, nil
@@ -112,30 +188,33 @@ func (s *objectService) DownloadStream(ctx context.Context, bucketName string, o
 	}
 
 	getOpts := minio.GetObjectOptions{}
-	if opts != nil && opts.VersionID != "" {
+	if opts != nil {
 		getOpts.VersionID = opts.VersionID
+		sse, err := customerKeyEncryption(opts.CustomerKey)
+		if err != nil {
+			return nil, err
+		}
+		getOpts.ServerSideEncryption = sse
 	}
 
 	object, err := s.client.minioClient.GetObject(ctx, bucketName, objectKey, getOpts)
 	if err != nil {
-		return nil, err
+		return nil, downloadError(bucketName, objectKey, err)
 	}
 
 	return object, nil
 }
 
-// List retrieves a list of objects in a bucket with pagination.
+// maxListKeys is the largest number of keys returned by one list request.
+const maxListKeys = 1000
+
+// List retrieves a list of objects in a bucket with pagination. Only the
+// objects up to the end of the requested page are read from the server.
 func (s *objectService) List(ctx context.Context, bucketName string, opts ObjectListOptions) ([]Object, error) {
 	if bucketName == "" {
 		return nil, &InvalidBucketNameError{Name: bucketName}
 	}
 
-	result := make([]Object, 0)
-	objectCh := s.client.minioClient.ListObjects(ctx, bucketName, minio.ListObjectsOptions{
-		Prefix:    opts.Prefix,
-		Recursive: opts.Delimiter == "",
-	})
-
 	limit := 50
 	offset := 0
 
@@ -147,13 +226,21 @@ func (s *objectService) List(ctx context.Context, bucketName string, opts Object
 		offset = *opts.Offset
 	}
 
-	count := 0
-	for object := range objectCh {
-		if object.Err != nil {
-			return nil, object.Err
-		}
+	result := make([]Object, 0)
+	if limit <= 0 {
+		return result, nil
+	}
 
-		if count >= offset && count < offset+limit {
+	// Reading stops once the page is full, which ends the listing without
+	// fetching the rest of the bucket.
+	count := 0
+	err := listObjects(ctx, s.client.minioClient, bucketName, minio.ListObjectsOptions{
+		Prefix:     opts.Prefix,
+		Recursive:  opts.Delimiter == "",
+		StartAfter: opts.StartAfter,
+		MaxKeys:    min(offset+limit, maxListKeys),
+	}, func(object minio.ObjectInfo) bool {
+		if count >= offset {
 			result = append(result, Object{
 				Key:          object.Key,
 				Size:         object.Size,
@@ -163,10 +250,10 @@ func (s *objectService) List(ctx context.Context, bucketName string, opts Object
 		}
 
 		count++
-
-		if opts.Limit != nil && len(result) >= limit {
-			break
-		}
+		return len(result) < limit
+	})
+	if err != nil {
+		return nil, err
 	}
 
 	return result, nil
@@ -178,23 +265,74 @@ func (s *objectService) ListAll(ctx context.Context, bucketName string, opts Obj
 		return nil, &InvalidBucketNameError{Name: bucketName}
 	}
 
+	filter, err := newKeyFilter(opts)
+	if err != nil {
+		return nil, err
+	}
+
 	result := make([]Object, 0)
-	objectCh := s.client.minioClient.ListObjects(ctx, bucketName, minio.ListObjectsOptions{
-		Prefix:    opts.Prefix,
-		Recursive: opts.Delimiter == "",
+	err = listObjects(ctx, s.client.minioClient, bucketName, minio.ListObjectsOptions{
+		Prefix:     opts.Prefix,
+		Recursive:  opts.Delimiter == "",
+		StartAfter: opts.StartAfter,
+	}, func(object minio.ObjectInfo) bool {
+		if filter.Match(object.Key) {
+			result = append(result, Object{
+				Key:          object.Key,
+				Size:         object.Size,
+				LastModified: object.LastModified,
+				ETag:         object.ETag,
+			})
+		}
+		return true
 	})
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// ListAllVersions retrieves every version of every object in a bucket that
+// passes the filter options, such as for compliance audits or cleanup of old
+// versions. Versions are grouped by key in lexicographic order, newest first.
+// Delete markers are skipped unless opts.IncludeDeleteMarkers is set.
+func (s *objectService) ListAllVersions(ctx context.Context, bucketName string, opts ObjectFilterOptions) ([]ObjectVersion, error) {
+	if bucketName == "" {
+		return nil, &InvalidBucketNameError{Name: bucketName}
+	}
+
+	filter, err := newKeyFilter(opts)
+	if err != nil {
+		return nil, err
+	}
 
-	for object := range objectCh {
-		if object.Err != nil {
-			return nil, object.Err
+	result := make([]ObjectVersion, 0)
+	err = listObjects(ctx, s.client.minioClient, bucketName, minio.ListObjectsOptions{
+		Prefix:       opts.Prefix,
+		Recursive:    opts.Delimiter == "",
+		StartAfter:   opts.StartAfter,
+		WithVersions: true,
+	}, func(object minio.ObjectInfo) bool {
+		if object.IsDeleteMarker && !opts.IncludeDeleteMarkers {
+			return true
 		}
 
-		result = append(result, Object{
-			Key:          object.Key,
-			Size:         object.Size,
-			LastModified: object.LastModified,
-			ETag:         object.ETag,
-		})
+		if filter.Match(object.Key) {
+			result = append(result, ObjectVersion{
+				Key:            object.Key,
+				VersionID:      object.VersionID,
+				Size:           object.Size,
+				LastModified:   object.LastModified,
+				IsDeleteMarker: object.IsDeleteMarker,
+				IsLatest:       object.IsLatest,
+				ETag:           object.ETag,
+			})
+		}
+		return true
+	})
+	if err != nil {
+		return nil, err
 	}
 
 	return result, nil
@@ -234,14 +372,54 @@ func (s *objectService) Metadata(ctx context.Context, bucketName string, objectK
 	}
 
 	return &Object{
-		Key:          info.Key,
-		Size:         info.Size,
-		LastModified: info.LastModified,
-		ETag:         info.ETag,
-		ContentType:  info.ContentType,
+		Key:                info.Key,
+		Size:               info.Size,
+		LastModified:       info.LastModified,
+		ETag:               info.ETag,
+		ContentType:        info.ContentType,
+		ContentDisposition: info.Metadata.Get("Content-Disposition"),
+		CacheControl:       info.Metadata.Get("Cache-Control"),
+		UserMetadata:       userMetadataFromHeader(info.Metadata),
+		Encryption:         encryptionFromHeader(info.Metadata),
+		StorageClass:       StorageClass(info.StorageClass),
 	}, nil
 }
 
+// Exists reports whether an object exists without downloading it. A missing
+// object or version returns false; a missing bucket and other failures
+// return an error.
+func (s *objectService) Exists(ctx context.Context, bucketName string, objectKey string, opts *MetadataOptions) (bool, error) {
+	if bucketName == "" {
+		return false, &InvalidBucketNameError{Name: bucketName}
+	}
+
+	if objectKey == "" {
+		return false, &InvalidObjectKeyError{Key: objectKey}
+	}
+
+	if opts == nil {
+		opts = &MetadataOptions{}
+	}
+
+	sse, err := customerKeyEncryption(opts.CustomerKey)
+	if err != nil {
+		return false, err
+	}
+
+	_, err = s.client.minioClient.StatObject(ctx, bucketName, objectKey, minio.StatObjectOptions{
+		VersionID:            opts.VersionID,
+		ServerSideEncryption: sse,
+	})
+	if err != nil {
+		if isObjectNotFound(err) {
+			return false, nil
+		}
+		return false, err
+	}
+
+	return true, nil
+}
+
 // LockObject applies a retention lock to an object until the specified date.
 func (s *objectService) LockObject(ctx context.Context, bucketName string, objectKey string, retainUntilDate time.Time) error {
 	if bucketName == "" {
@@ -319,12 +497,6 @@ func (s *objectService) ListVersions(ctx context.Context, bucketName string, obj
 		return nil, &InvalidObjectKeyError{Key: objectKey}
 	}
 
-	result := make([]ObjectVersion, 0)
-	objectVersionCh := s.client.minioClient.ListObjects(ctx, bucketName, minio.ListObjectsOptions{
-		Prefix:    objectKey,
-		Recursive: true,
-	})
-
 	limit := 50
 	offset := 0
 
@@ -337,12 +509,12 @@ func (s *objectService) ListVersions(ctx context.Context, bucketName string, obj
 		}
 	}
 
+	result := make([]ObjectVersion, 0)
 	count := 0
-	for objectInfo := range objectVersionCh {
-		if objectInfo.Err != nil {
-			return nil, objectInfo.Err
-		}
-
+	err := listObjects(ctx, s.client.minioClient, bucketName, minio.ListObjectsOptions{
+		Prefix:    objectKey,
+		Recursive: true,
+	}, func(objectInfo minio.ObjectInfo) bool {
 		// Only include versions for the exact object key (not prefixes)
 		if objectInfo.Key == objectKey {
 			if count >= offset && count < offset+limit {
@@ -356,7 +528,47 @@ func (s *objectService) ListVersions(ctx context.Context, bucketName string, obj
 			}
 			count++
 		}
+		return true
+	})
+	if err != nil {
+		return nil, err
 	}
 
 	return result, nil
 }
+
+// GetPresignedURL returns a time-limited URL that allows performing a single
+// request on an object without credentials. GET, PUT, HEAD and DELETE are
+// supported; the method defaults to GET and the expiry to
+// DefaultPresignedURLExpiry.
+func (s *objectService) GetPresignedURL(ctx context.Context, bucketName string, objectKey string, opts PresignedURLOptions) (*url.URL, error) {
+	if bucketName == "" {
+		return nil, &InvalidBucketNameError{Name: bucketName}
+	}
+
+	if objectKey == "" {
+		return nil, &InvalidObjectKeyError{Key: objectKey}
+	}
+
+	method := opts.Method
+	if method == "" {
+		method = http.MethodGet
+	}
+
+	switch method {
+	case http.MethodGet, http.MethodPut, http.MethodHead, http.MethodDelete:
+	default:
+		return nil, &InvalidPresignedURLOptionsError{Message: fmt.Sprintf("method %q is not supported, use GET, PUT, HEAD or DELETE", opts.Method)}
+	}
+
+	expiry := opts.ExpiresIn
+	if expiry == 0 {
+		expiry = DefaultPresignedURLExpiry
+	}
+
+	if expiry < time.Second || expiry > MaxPresignedURLExpiry {
+		return nil, &InvalidPresignedURLOptionsError{Message: fmt.Sprintf("expiry must be between 1s and %s", MaxPresignedURLExpiry)}
+	}
+
+	return s.client.minioClient.Presign(ctx, method, bucketName, objectKey, expiry, nil)
+}
@@ -3,11 +3,17 @@ package objectstorage
 import (
 	"bytes"
 	"context"
+	"errors"
+	"fmt"
 	"io"
+	"net/http"
+	"strings"
+	"sync/atomic"
 	"testing"
 	"time"
 
 	"github.com/MagaluCloud/mgc-sdk-go/client"
+	"github.com/minio/minio-go/v7"
 )
 
 func TestObjectServiceUpload_InvalidBucketName(t *testing.T) {
@@ -1229,3 +1235,376 @@ func TestListVersionsOptions(t *testing.T) {
 func intPtr(v int) *int {
 	return &v
 }
+
+func TestObjectServiceGetPresignedURL(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		bucketName  string
+		objectKey   string
+		opts        PresignedURLOptions
+		wantMethod  string
+		wantExpires string
+		wantErr     bool
+	}{
+		{
+			name:        "defaults to GET",
+			bucketName:  "test-bucket",
+			objectKey:   "file.txt",
+			wantMethod:  "GET",
+			wantExpires: "900",
+		},
+		{
+			name:        "PUT",
+			bucketName:  "test-bucket",
+			objectKey:   "file.txt",
+			opts:        PresignedURLOptions{Method: "PUT", ExpiresIn: time.Hour},
+			wantMethod:  "PUT",
+			wantExpires: "3600",
+		},
+		{
+			name:        "HEAD",
+			bucketName:  "test-bucket",
+			objectKey:   "file.txt",
+			opts:        PresignedURLOptions{Method: "HEAD"},
+			wantMethod:  "HEAD",
+			wantExpires: "900",
+		},
+		{
+			name:        "DELETE",
+			bucketName:  "test-bucket",
+			objectKey:   "file.txt",
+			opts:        PresignedURLOptions{Method: "DELETE", ExpiresIn: 5 * time.Minute},
+			wantMethod:  "DELETE",
+			wantExpires: "300",
+		},
+		{
+			name:       "POST is rejected",
+			bucketName: "test-bucket",
+			objectKey:  "file.txt",
+			opts:       PresignedURLOptions{Method: "POST"},
+			wantErr:    true,
+		},
+		{
+			name:       "expiry too long",
+			bucketName: "test-bucket",
+			objectKey:  "file.txt",
+			opts:       PresignedURLOptions{ExpiresIn: 8 * 24 * time.Hour},
+			wantErr:    true,
+		},
+		{
+			name:       "negative expiry",
+			bucketName: "test-bucket",
+			objectKey:  "file.txt",
+			opts:       PresignedURLOptions{ExpiresIn: -time.Minute},
+			wantErr:    true,
+		},
+		{
+			name:      "empty bucket name",
+			objectKey: "file.txt",
+			wantErr:   true,
+		},
+		{
+			name:       "empty object key",
+			bucketName: "test-bucket",
+			wantErr:    true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			core := client.NewMgcClient()
+			osClient, _ := New(core, "minioadmin", "minioadmin", WithMinioClientInterface(newMockMinioClient()))
+			svc := osClient.Objects()
+
+			u, err := svc.GetPresignedURL(context.Background(), tt.bucketName, tt.objectKey, tt.opts)
+
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("GetPresignedURL() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+
+			if got := u.Query().Get("X-Mock-Method"); got != tt.wantMethod {
+				t.Errorf("GetPresignedURL() method = %s, want %s", got, tt.wantMethod)
+			}
+			if got := u.Query().Get("X-Amz-Expires"); got != tt.wantExpires {
+				t.Errorf("GetPresignedURL() expires = %s, want %s", got, tt.wantExpires)
+			}
+			if u.Path != "/test-bucket/file.txt" {
+				t.Errorf("GetPresignedURL() path = %s, want /test-bucket/file.txt", u.Path)
+			}
+		})
+	}
+}
+
+func TestObjectServiceExists(t *testing.T) {
+	t.Parallel()
+	mock, svc := newMultipartTestClient(t)
+	ctx := context.Background()
+
+	if err := svc.Upload(ctx, "test-bucket", "present.txt", []byte("data"), "text/plain"); err != nil {
+		t.Fatalf("Upload() error = %v", err)
+	}
+
+	exists, err := svc.Exists(ctx, "test-bucket", "present.txt", nil)
+	if err != nil || !exists {
+		t.Errorf("Exists(present.txt) = %v, %v, want true", exists, err)
+	}
+
+	exists, err = svc.Exists(ctx, "test-bucket", "missing.txt", nil)
+	if err != nil || exists {
+		t.Errorf("Exists(missing.txt) = %v, %v, want false", exists, err)
+	}
+
+	if _, err := svc.Exists(ctx, "missing-bucket", "present.txt", nil); err == nil {
+		t.Error("Exists() expected error for a missing bucket")
+	}
+
+	var gotOpts minio.StatObjectOptions
+	mock.statObjectFunc = func(ctx context.Context, bucketName, objectName string, opts minio.StatObjectOptions) (minio.ObjectInfo, error) {
+		gotOpts = opts
+		return minio.ObjectInfo{}, minio.ErrorResponse{StatusCode: http.StatusNotFound, Code: "NoSuchVersion"}
+	}
+	key := bytes.Repeat([]byte{7}, CustomerKeySize)
+	exists, err = svc.Exists(ctx, "test-bucket", "present.txt", &MetadataOptions{VersionID: "v1", CustomerKey: key})
+	if err != nil || exists {
+		t.Errorf("Exists(missing version) = %v, %v, want false", exists, err)
+	}
+	if gotOpts.VersionID != "v1" || gotOpts.ServerSideEncryption == nil {
+		t.Errorf("StatObject() options = %+v, want version and customer key", gotOpts)
+	}
+
+	mock.statObjectFunc = func(ctx context.Context, bucketName, objectName string, opts minio.StatObjectOptions) (minio.ObjectInfo, error) {
+		return minio.ObjectInfo{}, minio.ErrorResponse{StatusCode: http.StatusForbidden, Code: "AccessDenied"}
+	}
+	if _, err := svc.Exists(ctx, "test-bucket", "present.txt", nil); err == nil {
+		t.Error("Exists() expected error for access denied")
+	}
+}
+
+func TestObjectServiceExists_InvalidArguments(t *testing.T) {
+	t.Parallel()
+	_, svc := newMultipartTestClient(t)
+	ctx := context.Background()
+
+	if _, err := svc.Exists(ctx, "", "key", nil); err == nil {
+		t.Error("Exists() expected error for empty bucket name")
+	}
+	if _, err := svc.Exists(ctx, "test-bucket", "", nil); err == nil {
+		t.Error("Exists() expected error for empty object key")
+	}
+	_, err := svc.Exists(ctx, "test-bucket", "key", &MetadataOptions{CustomerKey: []byte("short")})
+	if _, ok := err.(*InvalidObjectDataError); !ok {
+		t.Errorf("Exists() error = %v, want *InvalidObjectDataError", err)
+	}
+}
+
+func TestObjectServiceList_StartAfter(t *testing.T) {
+	t.Parallel()
+	_, svc := newMultipartTestClient(t)
+	ctx := context.Background()
+
+	for _, key := range []string{"e", "a", "d", "b", "c"} {
+		if err := svc.Upload(ctx, "test-bucket", key, []byte("x"), "text/plain"); err != nil {
+			t.Fatalf("Upload(%s) error = %v", key, err)
+		}
+	}
+
+	var pages [][]string
+	startAfter := ""
+	for {
+		objects, err := svc.List(ctx, "test-bucket", ObjectListOptions{Limit: intPtr(2), StartAfter: startAfter})
+		if err != nil {
+			t.Fatalf("List() error = %v", err)
+		}
+		if len(objects) == 0 {
+			break
+		}
+		var page []string
+		for _, object := range objects {
+			page = append(page, object.Key)
+		}
+		pages = append(pages, page)
+		startAfter = objects[len(objects)-1].Key
+	}
+
+	if got, want := fmt.Sprint(pages), "[[a b] [c d] [e]]"; got != want {
+		t.Errorf("pages = %s, want %s", got, want)
+	}
+}
+
+func TestObjectServiceList_StopsAtPageEnd(t *testing.T) {
+	t.Parallel()
+	mock, svc := newMultipartTestClient(t)
+
+	var gotOpts minio.ListObjectsOptions
+	var sent atomic.Int32
+	mock.listObjectsFunc = func(ctx context.Context, bucketName string, opts minio.ListObjectsOptions) <-chan minio.ObjectInfo {
+		gotOpts = opts
+		ch := make(chan minio.ObjectInfo)
+		go func() {
+			defer close(ch)
+			for i := 0; ; i++ {
+				select {
+				case <-ctx.Done():
+					return
+				case ch <- minio.ObjectInfo{Key: fmt.Sprintf("key-%06d", i)}:
+					sent.Add(1)
+				}
+			}
+		}()
+		return ch
+	}
+
+	objects, err := svc.List(context.Background(), "test-bucket", ObjectListOptions{Limit: intPtr(3), Offset: intPtr(2), StartAfter: "key-000000"})
+	if err != nil {
+		t.Fatalf("List() error = %v", err)
+	}
+	if len(objects) != 3 || objects[0].Key != "key-000002" {
+		t.Errorf("List() = %v, want 3 objects from key-000002", objects)
+	}
+	if gotOpts.StartAfter != "key-000000" || gotOpts.MaxKeys != 5 {
+		t.Errorf("ListObjects() options = %+v, want StartAfter key-000000 and MaxKeys 5", gotOpts)
+	}
+	if n := sent.Load(); n > 6 {
+		t.Errorf("List() read %d objects, want reading to stop at the end of the page", n)
+	}
+}
+
+func TestObjectServiceList_CancelsListingAtLimit(t *testing.T) {
+	t.Parallel()
+	mock, svc := newMultipartTestClient(t)
+
+	done := make(chan struct{})
+	mock.listObjectsFunc = func(ctx context.Context, bucketName string, opts minio.ListObjectsOptions) <-chan minio.ObjectInfo {
+		ch := make(chan minio.ObjectInfo)
+		go func() {
+			defer close(done)
+			defer close(ch)
+			for i := 0; i < 1_000_000; i++ {
+				select {
+				case <-ctx.Done():
+					return
+				case ch <- minio.ObjectInfo{Key: fmt.Sprintf("key-%07d", i)}:
+				}
+			}
+			t.Error("listing was drained instead of cancelled")
+		}()
+		return ch
+	}
+
+	objects, err := svc.List(context.Background(), "test-bucket", ObjectListOptions{Limit: intPtr(2)})
+	if err != nil {
+		t.Fatalf("List() error = %v", err)
+	}
+	if len(objects) != 2 {
+		t.Errorf("List() returned %d objects, want 2", len(objects))
+	}
+
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("listing goroutine still running after List returned")
+	}
+}
+
+func TestObjectServiceListAll_StartAfter(t *testing.T) {
+	t.Parallel()
+	_, svc := newMultipartTestClient(t)
+	ctx := context.Background()
+
+	for _, key := range []string{"logs/c.log", "logs/a.log", "logs/b.txt", "logs/d.log", "data/e.log"} {
+		if err := svc.Upload(ctx, "test-bucket", key, []byte("x"), "text/plain"); err != nil {
+			t.Fatalf("Upload(%s) error = %v", key, err)
+		}
+	}
+
+	objects, err := svc.ListAll(ctx, "test-bucket", ObjectFilterOptions{
+		Prefix:     "logs/",
+		Include:    []string{"*.log"},
+		StartAfter: "logs/a.log",
+	})
+	if err != nil {
+		t.Fatalf("ListAll() error = %v", err)
+	}
+
+	var keys []string
+	for _, object := range objects {
+		keys = append(keys, object.Key)
+	}
+	if got, want := fmt.Sprint(keys), "[logs/c.log logs/d.log]"; got != want {
+		t.Errorf("ListAll() keys = %s, want %s", got, want)
+	}
+}
+
+func TestObjectServiceListAllVersions(t *testing.T) {
+	t.Parallel()
+	mock, svc := newMultipartTestClient(t)
+
+	var gotOpts minio.ListObjectsOptions
+	mock.listObjectsFunc = func(ctx context.Context, bucketName string, opts minio.ListObjectsOptions) <-chan minio.ObjectInfo {
+		gotOpts = opts
+		ch := make(chan minio.ObjectInfo, 5)
+		ch <- minio.ObjectInfo{Key: "logs/a.log", VersionID: "v3", IsDeleteMarker: true, IsLatest: true}
+		ch <- minio.ObjectInfo{Key: "logs/a.log", VersionID: "v2", Size: 20}
+		ch <- minio.ObjectInfo{Key: "logs/a.log", VersionID: "v1", Size: 10}
+		ch <- minio.ObjectInfo{Key: "logs/b.txt", VersionID: "v1", IsLatest: true}
+		ch <- minio.ObjectInfo{Key: "logs/c.log", VersionID: "v1", Size: 5, IsLatest: true}
+		close(ch)
+		return ch
+	}
+
+	tests := []struct {
+		name string
+		opts ObjectFilterOptions
+		want string
+	}{
+		{name: "without delete markers", opts: ObjectFilterOptions{Prefix: "logs/", Include: []string{"*.log"}}, want: "logs/a.log@v2 logs/a.log@v1 logs/c.log@v1*"},
+		{name: "with delete markers", opts: ObjectFilterOptions{Prefix: "logs/", Include: []string{"*.log"}, IncludeDeleteMarkers: true}, want: "logs/a.log@v3*(deleted) logs/a.log@v2 logs/a.log@v1 logs/c.log@v1*"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			versions, err := svc.ListAllVersions(context.Background(), "test-bucket", tt.opts)
+			if err != nil {
+				t.Fatalf("ListAllVersions() error = %v", err)
+			}
+			if !gotOpts.WithVersions || gotOpts.Prefix != "logs/" {
+				t.Errorf("ListObjects() options = %+v, want versions under logs/", gotOpts)
+			}
+
+			var got []string
+			for _, v := range versions {
+				entry := v.Key + "@" + v.VersionID
+				if v.IsLatest {
+					entry += "*"
+				}
+				if v.IsDeleteMarker {
+					entry += "(deleted)"
+				}
+				got = append(got, entry)
+			}
+			if strings.Join(got, " ") != tt.want {
+				t.Errorf("ListAllVersions() = %v, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestObjectServiceListAllVersions_InvalidArguments(t *testing.T) {
+	t.Parallel()
+	_, svc := newMultipartTestClient(t)
+
+	if _, err := svc.ListAllVersions(context.Background(), "", ObjectFilterOptions{}); err == nil {
+		t.Error("ListAllVersions() expected error for empty bucket name")
+	}
+
+	_, err := svc.ListAllVersions(context.Background(), "test-bucket", ObjectFilterOptions{Include: []string{"("}, MatchMode: MatchModeRegex})
+	var filterErr *InvalidFilterError
+	if !errors.As(err, &filterErr) {
+		t.Errorf("ListAllVersions() error = %v, want InvalidFilterError", err)
+	}
+}
@@ -0,0 +1,230 @@
+package objectstorage
+
+import (
+	"fmt"
+	"strings"
+)
+
+// PolicyVersion is the bucket policy language version.
+const PolicyVersion = "2012-10-17"
+
+// Effects of a policy statement.
+const (
+	EffectAllow = "Allow"
+	EffectDeny  = "Deny"
+)
+
+// PrincipalAll matches every requester, including anonymous ones.
+const PrincipalAll = "*"
+
+// Common bucket policy actions.
+const (
+	ActionAll                  = "s3:*"
+	ActionGetObject            = "s3:GetObject"
+	ActionPutObject            = "s3:PutObject"
+	ActionDeleteObject         = "s3:DeleteObject"
+	ActionListBucket           = "s3:ListBucket"
+	ActionGetBucketLocation    = "s3:GetBucketLocation"
+	ActionGetObjectTagging     = "s3:GetObjectTagging"
+	ActionPutObjectTagging     = "s3:PutObjectTagging"
+	ActionAbortMultipartUpload = "s3:AbortMultipartUpload"
+)
+
+// resourceARNPrefix prefixes the ARN of buckets and objects in policies.
+const resourceARNPrefix = "arn:aws:s3:::"
+
+// BucketResource returns the policy resource of a bucket, used by bucket
+// level actions such as ActionListBucket.
+func BucketResource(bucketName string) string {
+	return resourceARNPrefix + bucketName
+}
+
+// ObjectResource returns the policy resource of the objects of a bucket
+// matching pattern, such as "*" or "public/*".
+func ObjectResource(bucketName string, pattern string) string {
+	return resourceARNPrefix + bucketName + "/" + pattern
+}
+
+// PolicyBuilder builds a bucket policy statement by statement. The first
+// invalid statement is reported by Build.
+type PolicyBuilder struct {
+	policy Policy
+}
+
+// NewPolicy starts a bucket policy with the current policy version.
+func NewPolicy() *PolicyBuilder {
+	return &PolicyBuilder{policy: Policy{Version: PolicyVersion}}
+}
+
+// WithID sets the policy ID.
+func (b *PolicyBuilder) WithID(id string) *PolicyBuilder {
+	b.policy.Id = id
+	return b
+}
+
+// Allow adds a statement granting actions on resources to principal.
+func (b *PolicyBuilder) Allow(principal any, actions []string, resources ...string) *PolicyBuilder {
+	return b.Statement(Statement{Effect: EffectAllow, Principal: principal, Action: actions, Resource: resources})
+}
+
+// Deny adds a statement refusing actions on resources to principal. Deny
+// statements win over Allow statements.
+func (b *PolicyBuilder) Deny(principal any, actions []string, resources ...string) *PolicyBuilder {
+	return b.Statement(Statement{Effect: EffectDeny, Principal: principal, Action: actions, Resource: resources})
+}
+
+// AllowPublicRead adds a statement letting anyone download the objects of a
+// bucket matching pattern. An empty pattern matches every object.
+func (b *PolicyBuilder) AllowPublicRead(bucketName string, pattern string) *PolicyBuilder {
+	if pattern == "" {
+		pattern = "*"
+	}
+	return b.Allow(PrincipalAll, []string{ActionGetObject}, ObjectResource(bucketName, pattern))
+}
+
+// Statement adds a raw statement, for conditions the builder does not cover.
+func (b *PolicyBuilder) Statement(statement Statement) *PolicyBuilder {
+	b.policy.Statement = append(b.policy.Statement, statement)
+	return b
+}
+
+// Build validates the policy and returns it.
+func (b *PolicyBuilder) Build() (*Policy, error) {
+	policy := b.policy
+	policy.Statement = append([]Statement(nil), b.policy.Statement...)
+	if err := policy.Validate(); err != nil {
+		return nil, err
+	}
+	return &policy, nil
+}
+
+// Validate checks that the policy has a version and at least one statement,
+// and that every statement has an Allow or Deny effect, a principal, actions
+// and resources. Actions must be S3 actions and ARN resources must be S3
+// ARNs.
+func (p *Policy) Validate() error {
+	if p.Version == "" {
+		return &InvalidPolicyError{Message: "policy version cannot be empty"}
+	}
+
+	if len(p.Statement) == 0 {
+		return &InvalidPolicyError{Message: "policy must have at least one statement"}
+	}
+
+	for i, statement := range p.Statement {
+		if err := statement.validate(); err != nil {
+			return &InvalidPolicyError{Message: fmt.Sprintf("statement %d: %s", i, err.Message)}
+		}
+	}
+
+	return nil
+}
+
+// validate checks the required fields of a statement.
+func (s Statement) validate() *InvalidPolicyError {
+	if s.Effect != EffectAllow && s.Effect != EffectDeny {
+		return &InvalidPolicyError{Message: fmt.Sprintf("effect must be %s or %s, got %q", EffectAllow, EffectDeny, s.Effect)}
+	}
+
+	if principals := policyValues(s.Principal); s.Principal == nil || (principals != nil && len(principals) == 0) {
+		return &InvalidPolicyError{Message: "principal cannot be empty"}
+	}
+
+	actions := policyValues(s.Action)
+	if len(actions) == 0 {
+		return &InvalidPolicyError{Message: "action cannot be empty"}
+	}
+	for _, action := range actions {
+		if action != "*" && !strings.HasPrefix(action, "s3:") {
+			return &InvalidPolicyError{Message: fmt.Sprintf("invalid action %q", action)}
+		}
+	}
+
+	resources := policyValues(s.Resource)
+	if len(resources) == 0 {
+		return &InvalidPolicyError{Message: "resource cannot be empty"}
+	}
+	for _, resource := range resources {
+		if resource == "" || (strings.HasPrefix(resource, "arn:") && !strings.HasPrefix(resource, resourceARNPrefix)) {
+			return &InvalidPolicyError{Message: fmt.Sprintf("invalid resource %q", resource)}
+		}
+	}
+
+	return nil
+}
+
+// policyValues returns the strings of a policy field that holds either a
+// string or a list of strings. Other values, such as principal maps,
+// return nil.
+func policyValues(v any) []string {
+	switch v := v.(type) {
+	case string:
+		if v == "" {
+			return []string{}
+		}
+		return []string{v}
+	case []string:
+		return v
+	case []any:
+		values := make([]string, 0, len(v))
+		for _, item := range v {
+			if s, ok := item.(string); ok {
+				values = append(values, s)
+			}
+		}
+		return values
+	default:
+		return nil
+	}
+}
+
+// canonicalPolicy returns a copy of policy whose resources are all full S3
+// ARNs of bucketName. Bare resources such as "my-bucket/*" are prefixed;
+// resources naming another bucket or malformed ARNs are rejected, since the
+// service would otherwise accept a policy that never matches.
+func canonicalPolicy(bucketName string, policy *Policy) (*Policy, error) {
+	canonical := *policy
+	canonical.Statement = make([]Statement, len(policy.Statement))
+
+	for i, statement := range policy.Statement {
+		var err error
+		switch resource := statement.Resource.(type) {
+		case string:
+			statement.Resource, err = canonicalResource(bucketName, resource)
+		case []string, []any:
+			resources := policyValues(resource)
+			canonicalResources := make([]string, len(resources))
+			for j, r := range resources {
+				if canonicalResources[j], err = canonicalResource(bucketName, r); err != nil {
+					break
+				}
+			}
+			statement.Resource = canonicalResources
+		}
+		if err != nil {
+			return nil, &InvalidPolicyError{Message: fmt.Sprintf("statement %d: %v", i, err)}
+		}
+		canonical.Statement[i] = statement
+	}
+
+	return &canonical, nil
+}
+
+// canonicalResource turns a bucket or object resource into its full S3 ARN
+// and checks that it belongs to bucketName.
+func canonicalResource(bucketName string, resource string) (string, error) {
+	path := strings.TrimSpace(resource)
+	if strings.HasPrefix(path, "arn:") {
+		var ok bool
+		if path, ok = strings.CutPrefix(path, resourceARNPrefix); !ok {
+			return "", fmt.Errorf("resource %q is not an S3 ARN, expected %s%s/...", resource, resourceARNPrefix, bucketName)
+		}
+	}
+
+	bucket, _, _ := strings.Cut(path, "/")
+	if bucket != bucketName {
+		return "", fmt.Errorf("resource %q does not belong to bucket %s, expected %s or %s/...", resource, bucketName, BucketResource(bucketName), BucketResource(bucketName))
+	}
+
+	return resourceARNPrefix + path, nil
+}
@@ -0,0 +1,169 @@
+package objectstorage
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/MagaluCloud/mgc-sdk-go/client"
+)
+
+func TestPolicyBuilder(t *testing.T) {
+	t.Parallel()
+
+	policy, err := NewPolicy().
+		WithID("site").
+		AllowPublicRead("my-bucket", "").
+		Deny(PrincipalAll, []string{ActionDeleteObject, ActionPutObject}, ObjectResource("my-bucket", "private/*")).
+		Allow(map[string]any{"MGC": []string{"tenant-id"}}, []string{ActionListBucket}, BucketResource("my-bucket")).
+		Build()
+	if err != nil {
+		t.Fatalf("Build() error = %v", err)
+	}
+
+	data, err := json.Marshal(policy)
+	if err != nil {
+		t.Fatalf("json.Marshal() error = %v", err)
+	}
+	want := `{"Version":"2012-10-17","Id":"site","Statement":[` +
+		`{"Effect":"Allow","Principal":"*","Action":["s3:GetObject"],"Resource":["arn:aws:s3:::my-bucket/*"]},` +
+		`{"Effect":"Deny","Principal":"*","Action":["s3:DeleteObject","s3:PutObject"],"Resource":["arn:aws:s3:::my-bucket/private/*"]},` +
+		`{"Effect":"Allow","Principal":{"MGC":["tenant-id"]},"Action":["s3:ListBucket"],"Resource":["arn:aws:s3:::my-bucket"]}]}`
+	if string(data) != want {
+		t.Errorf("Build() =\n%s\nwant\n%s", data, want)
+	}
+}
+
+func TestPolicyBuilder_BuildIsolated(t *testing.T) {
+	t.Parallel()
+
+	builder := NewPolicy().AllowPublicRead("a", "*")
+	first, err := builder.Build()
+	if err != nil {
+		t.Fatalf("Build() error = %v", err)
+	}
+	builder.AllowPublicRead("b", "*")
+
+	if len(first.Statement) != 1 {
+		t.Errorf("first policy has %d statements after reuse, want 1", len(first.Statement))
+	}
+}
+
+func TestPolicyValidate(t *testing.T) {
+	t.Parallel()
+
+	valid := Statement{Effect: EffectAllow, Principal: PrincipalAll, Action: ActionGetObject, Resource: "my-bucket/*"}
+
+	tests := []struct {
+		name   string
+		policy Policy
+		want   string
+	}{
+		{name: "valid", policy: Policy{Version: PolicyVersion, Statement: []Statement{valid}}},
+		{name: "missing version", policy: Policy{Statement: []Statement{valid}}, want: "version"},
+		{name: "no statements", policy: Policy{Version: PolicyVersion}, want: "at least one statement"},
+		{name: "bad effect", policy: Policy{Version: PolicyVersion, Statement: []Statement{{Effect: "allow", Principal: "*", Action: ActionGetObject, Resource: "b/*"}}}, want: "effect"},
+		{name: "missing principal", policy: Policy{Version: PolicyVersion, Statement: []Statement{{Effect: EffectAllow, Action: ActionGetObject, Resource: "b/*"}}}, want: "principal"},
+		{name: "missing action", policy: Policy{Version: PolicyVersion, Statement: []Statement{{Effect: EffectAllow, Principal: "*", Action: []string{}, Resource: "b/*"}}}, want: "action cannot be empty"},
+		{name: "misspelled action", policy: Policy{Version: PolicyVersion, Statement: []Statement{{Effect: EffectAllow, Principal: "*", Action: "s3GetObject", Resource: "b/*"}}}, want: `invalid action "s3GetObject"`},
+		{name: "missing resource", policy: Policy{Version: PolicyVersion, Statement: []Statement{{Effect: EffectAllow, Principal: "*", Action: ActionGetObject}}}, want: "resource cannot be empty"},
+		{name: "malformed ARN", policy: Policy{Version: PolicyVersion, Statement: []Statement{valid, {Effect: EffectDeny, Principal: "*", Action: ActionAll, Resource: []any{"arn:aws:s3::b/*"}}}}, want: "statement 1: invalid resource"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.policy.Validate()
+			if tt.want == "" {
+				if err != nil {
+					t.Errorf("Validate() error = %v", err)
+				}
+				return
+			}
+
+			var policyErr *InvalidPolicyError
+			if !errors.As(err, &policyErr) || !strings.Contains(policyErr.Message, tt.want) {
+				t.Errorf("Validate() error = %v, want InvalidPolicyError containing %q", err, tt.want)
+			}
+		})
+	}
+}
+
+func TestPolicyBuilder_Invalid(t *testing.T) {
+	t.Parallel()
+
+	if _, err := NewPolicy().Build(); err == nil {
+		t.Error("Build() expected error for a policy without statements")
+	}
+
+	_, err := NewPolicy().Allow(PrincipalAll, nil, BucketResource("my-bucket")).Build()
+	if _, ok := err.(*InvalidPolicyError); !ok {
+		t.Errorf("Build() error = %v, want *InvalidPolicyError", err)
+	}
+}
+
+func TestBucketServiceSetPolicy_CanonicalResources(t *testing.T) {
+	t.Parallel()
+
+	mock := newMockMinioClient()
+	mock.buckets["site"] = &mockBucket{name: "site", objects: make(map[string]*mockObject)}
+	osClient, err := New(client.NewMgcClient(), "minioadmin", "minioadmin", WithMinioClientInterface(mock))
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+	svc := osClient.Buckets()
+
+	policy := &Policy{
+		Version: PolicyVersion,
+		Statement: []Statement{
+			{Effect: EffectAllow, Principal: PrincipalAll, Action: ActionGetObject, Resource: "site/*"},
+			{Effect: EffectAllow, Principal: PrincipalAll, Action: ActionListBucket, Resource: []string{"site", "arn:aws:s3:::site"}},
+		},
+	}
+	if err := svc.SetPolicy(context.Background(), "site", policy); err != nil {
+		t.Fatalf("SetPolicy() error = %v", err)
+	}
+
+	want := `{"Version":"2012-10-17","Statement":[` +
+		`{"Effect":"Allow","Principal":"*","Action":"s3:GetObject","Resource":"arn:aws:s3:::site/*"},` +
+		`{"Effect":"Allow","Principal":"*","Action":"s3:ListBucket","Resource":["arn:aws:s3:::site","arn:aws:s3:::site"]}]}`
+	if got := mock.buckets["site"].policy; got != want {
+		t.Errorf("stored policy =\n%s\nwant\n%s", got, want)
+	}
+	if policy.Statement[0].Resource != "site/*" {
+		t.Errorf("SetPolicy() modified the caller's policy: %v", policy.Statement[0].Resource)
+	}
+}
+
+func TestBucketServiceSetPolicy_InvalidResources(t *testing.T) {
+	t.Parallel()
+
+	mock := newMockMinioClient()
+	mock.setBucketPolicyFunc = func(ctx context.Context, bucketName string, policy string) error {
+		t.Error("SetBucketPolicy() should not be called")
+		return nil
+	}
+	osClient, err := New(client.NewMgcClient(), "minioadmin", "minioadmin", WithMinioClientInterface(mock))
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+	svc := osClient.Buckets()
+
+	for _, resource := range []any{
+		"other-bucket/*",
+		"arn:aws:s3::site/*",
+		[]string{"arn:aws:s3:::site/*", "sitex/*"},
+		"*",
+	} {
+		policy := &Policy{
+			Version:   PolicyVersion,
+			Statement: []Statement{{Effect: EffectAllow, Principal: PrincipalAll, Action: ActionGetObject, Resource: resource}},
+		}
+		err := svc.SetPolicy(context.Background(), "site", policy)
+		var policyErr *InvalidPolicyError
+		if !errors.As(err, &policyErr) || !strings.Contains(policyErr.Message, "statement 0") {
+			t.Errorf("SetPolicy(%v) error = %v, want InvalidPolicyError", resource, err)
+		}
+	}
+}
@@ -0,0 +1,101 @@
+package objectstorage
+
+import (
+	"io"
+	"time"
+)
+
+// ProgressInterval is the shortest time between two progress reports. The
+// final report of a transfer is always delivered.
+const ProgressInterval = 100 * time.Millisecond
+
+// Progress describes a transfer in flight.
+type Progress struct {
+	// Transferred is the number of bytes read so far.
+	Transferred int64
+	// Total is the size of the transfer, or -1 when it is unknown.
+	Total int64
+	// Elapsed is the time since the first byte was requested.
+	Elapsed time.Duration
+	// BytesPerSecond is the average speed since the transfer started.
+	BytesPerSecond float64
+	// ETA estimates the time left. It is zero when Total is unknown or no
+	// bytes have been transferred yet.
+	ETA time.Duration
+	// Done is set on the last report, once the reader returned io.EOF.
+	Done bool
+}
+
+// Percent returns the completed share of the transfer between 0 and 100, or
+// -1 when Total is unknown.
+func (p Progress) Percent() float64 {
+	if p.Total <= 0 {
+		return -1
+	}
+	return float64(p.Transferred) * 100 / float64(p.Total)
+}
+
+// ProgressFunc receives transfer progress. It is called from the goroutine
+// reading the data and should return quickly.
+type ProgressFunc func(Progress)
+
+// NewProgressReader wraps r so that fn receives the progress of reading it,
+// at most once per ProgressInterval and once more when r is exhausted. Pass
+// total as -1 when the size is unknown. Use it around the reader returned by
+// DownloadStream, with the size from Metadata, to report download progress.
+func NewProgressReader(r io.Reader, total int64, fn ProgressFunc) io.Reader {
+	return &progressReader{r: r, total: total, fn: fn, now: time.Now}
+}
+
+// progressReader counts the bytes read from r and reports them to fn.
+type progressReader struct {
+	r     io.Reader
+	total int64
+	fn    ProgressFunc
+	now   func() time.Time
+
+	transferred  int64
+	start        time.Time
+	lastReported time.Time
+	done         bool
+}
+
+func (pr *progressReader) Read(p []byte) (int, error) {
+	if pr.start.IsZero() {
+		pr.start = pr.now()
+	}
+
+	n, err := pr.r.Read(p)
+	pr.transferred += int64(n)
+
+	now := pr.now()
+	if err == io.EOF && !pr.done {
+		pr.done = true
+		pr.report(now)
+	} else if n > 0 && now.Sub(pr.lastReported) >= ProgressInterval {
+		pr.report(now)
+	}
+
+	return n, err
+}
+
+// report computes the derived metrics and calls fn.
+func (pr *progressReader) report(now time.Time) {
+	pr.lastReported = now
+
+	progress := Progress{
+		Transferred: pr.transferred,
+		Total:       pr.total,
+		Elapsed:     now.Sub(pr.start),
+		Done:        pr.done,
+	}
+	if progress.Elapsed > 0 {
+		progress.BytesPerSecond = float64(pr.transferred) / progress.Elapsed.Seconds()
+	}
+	if !pr.done && pr.total > 0 && progress.BytesPerSecond > 0 {
+		remaining := float64(max(pr.total-pr.transferred, 0))
+		progress.ETA = time.Duration(remaining / progress.BytesPerSecond * float64(time.Second))
+	}
+
+	pr.fn(progress)
+}
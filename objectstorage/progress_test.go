@@ -0,0 +1,100 @@
+package objectstorage
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/minio/minio-go/v7"
+)
+
+// fakeClockReader returns one byte per second of fake time on every read.
+type fakeClockReader struct {
+	r   io.Reader
+	now *time.Time
+}
+
+func (f *fakeClockReader) Read(p []byte) (int, error) {
+	*f.now = f.now.Add(time.Second)
+	return f.r.Read(p[:min(len(p), 10)])
+}
+
+func TestProgressReader(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	var reports []Progress
+	r := NewProgressReader(&fakeClockReader{r: strings.NewReader(strings.Repeat("x", 40)), now: &now}, 40, func(p Progress) {
+		reports = append(reports, p)
+	})
+	r.(*progressReader).now = func() time.Time { return now }
+
+	data, err := io.ReadAll(r)
+	if err != nil || len(data) != 40 {
+		t.Fatalf("ReadAll() = %d bytes, %v", len(data), err)
+	}
+
+	if len(reports) != 5 {
+		t.Fatalf("got %d reports, want 4 chunks and a final one: %+v", len(reports), reports)
+	}
+
+	first := reports[0]
+	if first.Transferred != 10 || first.Elapsed != time.Second || first.BytesPerSecond != 10 || first.ETA != 3*time.Second || first.Percent() != 25 {
+		t.Errorf("first report = %+v", first)
+	}
+
+	last := reports[len(reports)-1]
+	if !last.Done || last.Transferred != 40 || last.ETA != 0 || last.Percent() != 100 {
+		t.Errorf("last report = %+v", last)
+	}
+}
+
+func TestProgressReader_Throttled(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	calls := 0
+	r := NewProgressReader(strings.NewReader(strings.Repeat("x", 100)), -1, func(p Progress) {
+		calls++
+		if p.ETA != 0 || p.Percent() != -1 {
+			t.Errorf("report with unknown total = %+v", p)
+		}
+	})
+	r.(*progressReader).now = func() time.Time { return now }
+
+	buf := make([]byte, 10)
+	for {
+		if _, err := r.Read(buf); err != nil {
+			break
+		}
+	}
+
+	// The clock never advances: only the first chunk and the final report go out.
+	if calls != 2 {
+		t.Errorf("reports = %d, want 2", calls)
+	}
+}
+
+func TestObjectServiceUploadProgress(t *testing.T) {
+	t.Parallel()
+	mock, svc := newMultipartTestClient(t)
+
+	mock.putObjectFunc = func(ctx context.Context, bucketName, objectName string, reader io.Reader, objectSize int64, opts minio.PutObjectOptions) (minio.UploadInfo, error) {
+		_, err := io.Copy(io.Discard, reader)
+		return minio.UploadInfo{}, err
+	}
+
+	var last Progress
+	err := svc.UploadStreamWithOptions(context.Background(), "test-bucket", "big.bin", bytes.NewReader(make([]byte, 4096)), 4096, UploadOptions{
+		Progress: func(p Progress) { last = p },
+	})
+	if err != nil {
+		t.Fatalf("UploadStreamWithOptions() error = %v", err)
+	}
+	if !last.Done || last.Transferred != 4096 || last.Total != 4096 {
+		t.Errorf("last progress = %+v, want 4096 of 4096 bytes done", last)
+	}
+}
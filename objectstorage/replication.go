@@ -0,0 +1,192 @@
+package objectstorage
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/minio/minio-go/v7/pkg/replication"
+)
+
+// replicationARNPrefix prefixes the ARN of an S3 destination bucket.
+const replicationARNPrefix = "arn:aws:s3:::"
+
+// ReplicationStatus reports whether a replication rule is active.
+type ReplicationStatus string
+
+const (
+	// ReplicationStatusEnabled means objects matching the rule are replicated.
+	ReplicationStatusEnabled ReplicationStatus = "Enabled"
+	// ReplicationStatusDisabled means the rule is kept but not applied.
+	ReplicationStatusDisabled ReplicationStatus = "Disabled"
+)
+
+// ReplicationRule replicates the objects under a prefix to another bucket.
+type ReplicationRule struct {
+	ID string `json:"ID,omitempty"`
+	// Status defaults to ReplicationStatusEnabled when empty.
+	Status ReplicationStatus `json:"Status,omitempty"`
+	// Priority orders rules that match the same object; higher wins.
+	Priority int `json:"Priority,omitempty"`
+	// Prefix limits the rule to keys starting with it. Empty matches every object.
+	Prefix string `json:"Prefix,omitempty"`
+	// DestinationBucket is the name or ARN of the bucket receiving the copies.
+	DestinationBucket string `json:"DestinationBucket"`
+	// StorageClass of the replicas. Empty keeps the storage class of the source.
+	StorageClass StorageClass `json:"StorageClass,omitempty"`
+	// ReplicateDeleteMarkers also replicates delete markers.
+	ReplicateDeleteMarkers bool `json:"ReplicateDeleteMarkers,omitempty"`
+}
+
+// ReplicationConfig represents the replication configuration of a bucket.
+type ReplicationConfig struct {
+	// Role is the identity assumed to write to the destination, when required.
+	Role  string            `json:"Role,omitempty"`
+	Rules []ReplicationRule `json:"Rules"`
+}
+
+// SetReplication replaces the replication configuration of a bucket. Versioning
+// must be enabled on the bucket, and every destination given as a bucket name
+// or S3 ARN must exist and be reachable with the client credentials.
+func (s *bucketService) SetReplication(ctx context.Context, bucketName string, config ReplicationConfig) error {
+	if bucketName == "" {
+		return &InvalidBucketNameError{Name: bucketName}
+	}
+
+	cfg, err := config.toMinio()
+	if err != nil {
+		return err
+	}
+
+	versioning, err := s.GetVersioningStatus(ctx, bucketName)
+	if err != nil {
+		return err
+	}
+	if versioning.Status != VersioningStatusEnabled {
+		return &BucketError{Operation: "SetReplication", Bucket: bucketName, Message: "versioning must be enabled before replication is configured"}
+	}
+
+	for _, rule := range config.Rules {
+		destination, ok := replicationDestinationName(rule.DestinationBucket)
+		if !ok {
+			continue
+		}
+		exists, err := s.client.minioClient.BucketExists(ctx, destination)
+		if err != nil {
+			return &BucketError{Operation: "SetReplication", Bucket: bucketName, Message: fmt.Sprintf("destination %s is not reachable: %v", destination, err)}
+		}
+		if !exists {
+			return &BucketError{Operation: "SetReplication", Bucket: bucketName, Message: fmt.Sprintf("destination %s does not exist", destination)}
+		}
+	}
+
+	return s.client.minioClient.SetBucketReplication(ctx, bucketName, cfg)
+}
+
+// GetReplication retrieves the replication configuration of a bucket, with
+// the status of each rule. A bucket without replication returns nil.
+func (s *bucketService) GetReplication(ctx context.Context, bucketName string) (*ReplicationConfig, error) {
+	if bucketName == "" {
+		return nil, &InvalidBucketNameError{Name: bucketName}
+	}
+
+	cfg, err := s.client.minioClient.GetBucketReplication(ctx, bucketName)
+	if err != nil {
+		return nil, err
+	}
+
+	if cfg.Empty() {
+		return nil, nil
+	}
+
+	config := &ReplicationConfig{
+		Role:  cfg.Role,
+		Rules: make([]ReplicationRule, len(cfg.Rules)),
+	}
+	for i, rule := range cfg.Rules {
+		prefix := rule.Filter.Prefix
+		if prefix == "" {
+			prefix = rule.Filter.And.Prefix
+		}
+		config.Rules[i] = ReplicationRule{
+			ID:                     rule.ID,
+			Status:                 ReplicationStatus(rule.Status),
+			Priority:               rule.Priority,
+			Prefix:                 prefix,
+			DestinationBucket:      rule.Destination.Bucket,
+			StorageClass:           StorageClass(rule.Destination.StorageClass),
+			ReplicateDeleteMarkers: rule.DeleteMarkerReplication.Status == replication.Enabled,
+		}
+	}
+
+	return config, nil
+}
+
+// DeleteReplication removes the replication configuration from a bucket.
+// Objects already replicated are kept in the destination.
+func (s *bucketService) DeleteReplication(ctx context.Context, bucketName string) error {
+	if bucketName == "" {
+		return &InvalidBucketNameError{Name: bucketName}
+	}
+
+	return s.client.minioClient.RemoveBucketReplication(ctx, bucketName)
+}
+
+// toMinio validates the configuration and converts it to its minio
+// representation. Destinations given as bucket names are turned into ARNs.
+func (c ReplicationConfig) toMinio() (replication.Config, error) {
+	if len(c.Rules) == 0 {
+		return replication.Config{}, &InvalidPolicyError{Message: "replication configuration must have at least one rule"}
+	}
+
+	cfg := replication.Config{Role: c.Role}
+	for i, rule := range c.Rules {
+		if rule.DestinationBucket == "" {
+			return replication.Config{}, &InvalidPolicyError{Message: fmt.Sprintf("replication rule %d must have a destination bucket", i)}
+		}
+
+		if err := rule.StorageClass.validate(); err != nil {
+			return replication.Config{}, &InvalidPolicyError{Message: fmt.Sprintf("replication rule %d: %v", i, err)}
+		}
+
+		status := replication.Enabled
+		switch rule.Status {
+		case "", ReplicationStatusEnabled:
+		case ReplicationStatusDisabled:
+			status = replication.Disabled
+		default:
+			return replication.Config{}, &InvalidPolicyError{Message: fmt.Sprintf("replication rule %d has invalid status %q", i, rule.Status)}
+		}
+
+		deleteMarkers := replication.Disabled
+		if rule.ReplicateDeleteMarkers {
+			deleteMarkers = replication.Enabled
+		}
+
+		destination := rule.DestinationBucket
+		if !strings.HasPrefix(destination, "arn:") {
+			destination = replicationARNPrefix + destination
+		}
+
+		cfg.Rules = append(cfg.Rules, replication.Rule{
+			ID:                      rule.ID,
+			Status:                  status,
+			Priority:                rule.Priority,
+			DeleteMarkerReplication: replication.DeleteMarkerReplication{Status: deleteMarkers},
+			DeleteReplication:       replication.DeleteReplication{Status: replication.Disabled},
+			Destination:             replication.Destination{Bucket: destination, StorageClass: string(rule.StorageClass)},
+			Filter:                  replication.Filter{Prefix: rule.Prefix},
+		})
+	}
+
+	return cfg, nil
+}
+
+// replicationDestinationName returns the bucket name of a destination given
+// as a name or S3 ARN. Other ARNs, such as remote targets, return false.
+func replicationDestinationName(destination string) (string, bool) {
+	if name, ok := strings.CutPrefix(destination, replicationARNPrefix); ok {
+		return name, name != ""
+	}
+	return destination, !strings.HasPrefix(destination, "arn:")
+}
@@ -0,0 +1,160 @@
+package objectstorage
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/MagaluCloud/mgc-sdk-go/client"
+	"github.com/minio/minio-go/v7"
+	"github.com/minio/minio-go/v7/pkg/replication"
+)
+
+func newReplicationTestClient(t *testing.T, versioning string) (*mockMinioClient, BucketService) {
+	t.Helper()
+
+	mock := newMockMinioClient()
+	for _, name := range []string{"source", "replica"} {
+		mock.buckets[name] = &mockBucket{
+			name:         name,
+			creationDate: time.Now(),
+			objects:      make(map[string]*mockObject),
+		}
+	}
+	mock.buckets["source"].versioning = minio.BucketVersioningConfiguration{Status: versioning}
+
+	osClient, err := New(client.NewMgcClient(), "minioadmin", "minioadmin", WithMinioClientInterface(mock))
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+	return mock, osClient.Buckets()
+}
+
+func TestBucketServiceReplication(t *testing.T) {
+	t.Parallel()
+	mock, svc := newReplicationTestClient(t, "Enabled")
+	ctx := context.Background()
+
+	err := svc.SetReplication(ctx, "source", ReplicationConfig{
+		Rules: []ReplicationRule{
+			{ID: "logs", Priority: 2, Prefix: "logs/", DestinationBucket: "replica", ReplicateDeleteMarkers: true},
+			{ID: "paused", Priority: 1, Status: ReplicationStatusDisabled, DestinationBucket: "arn:aws:s3:::replica", StorageClass: StorageClassColdInstant},
+		},
+	})
+	if err != nil {
+		t.Fatalf("SetReplication() error = %v", err)
+	}
+
+	stored := mock.buckets["source"].replication
+	if got := stored.Rules[0].Destination.Bucket; got != "arn:aws:s3:::replica" {
+		t.Errorf("destination = %q, want ARN of replica", got)
+	}
+	if got := stored.Rules[0].DeleteReplication.Status; got != replication.Disabled {
+		t.Errorf("DeleteReplication = %q, want Disabled", got)
+	}
+
+	config, err := svc.GetReplication(ctx, "source")
+	if err != nil {
+		t.Fatalf("GetReplication() error = %v", err)
+	}
+	if len(config.Rules) != 2 {
+		t.Fatalf("GetReplication() returned %d rules, want 2", len(config.Rules))
+	}
+	logs, paused := config.Rules[0], config.Rules[1]
+	if logs.Status != ReplicationStatusEnabled || logs.Prefix != "logs/" || !logs.ReplicateDeleteMarkers {
+		t.Errorf("GetReplication() rule 0 = %+v", logs)
+	}
+	if paused.Status != ReplicationStatusDisabled || paused.StorageClass != StorageClassColdInstant || paused.ReplicateDeleteMarkers {
+		t.Errorf("GetReplication() rule 1 = %+v", paused)
+	}
+
+	if err := svc.DeleteReplication(ctx, "source"); err != nil {
+		t.Fatalf("DeleteReplication() error = %v", err)
+	}
+	config, err = svc.GetReplication(ctx, "source")
+	if err != nil || config != nil {
+		t.Errorf("GetReplication() after delete = %+v, %v, want nil", config, err)
+	}
+}
+
+func TestBucketServiceSetReplication_Prerequisites(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	tests := []struct {
+		name        string
+		versioning  string
+		destination string
+		want        string
+	}{
+		{name: "versioning off", versioning: "", destination: "replica", want: "versioning must be enabled"},
+		{name: "versioning suspended", versioning: "Suspended", destination: "replica", want: "versioning must be enabled"},
+		{name: "missing destination", versioning: "Enabled", destination: "arn:aws:s3:::missing", want: "destination missing does not exist"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mock, svc := newReplicationTestClient(t, tt.versioning)
+			mock.setReplicationFunc = func(ctx context.Context, bucketName string, cfg replication.Config) error {
+				t.Error("SetBucketReplication() should not be called")
+				return nil
+			}
+
+			err := svc.SetReplication(ctx, "source", ReplicationConfig{Rules: []ReplicationRule{{DestinationBucket: tt.destination}}})
+			var bucketErr *BucketError
+			if !errors.As(err, &bucketErr) || !strings.Contains(bucketErr.Message, tt.want) {
+				t.Errorf("SetReplication() error = %v, want BucketError containing %q", err, tt.want)
+			}
+		})
+	}
+
+	t.Run("unreachable destination", func(t *testing.T) {
+		mock, svc := newReplicationTestClient(t, "Enabled")
+		mock.bucketExistsFunc = func(ctx context.Context, bucketName string) (bool, error) {
+			return false, errors.New("access denied")
+		}
+
+		err := svc.SetReplication(ctx, "source", ReplicationConfig{Rules: []ReplicationRule{{DestinationBucket: "replica"}}})
+		if err == nil || !strings.Contains(err.Error(), "not reachable") {
+			t.Errorf("SetReplication() error = %v, want unreachable destination", err)
+		}
+	})
+
+	t.Run("remote target is not checked", func(t *testing.T) {
+		_, svc := newReplicationTestClient(t, "Enabled")
+		err := svc.SetReplication(ctx, "source", ReplicationConfig{Rules: []ReplicationRule{{DestinationBucket: "arn:minio:replication::id:remote"}}})
+		if err != nil {
+			t.Errorf("SetReplication() error = %v", err)
+		}
+	})
+}
+
+func TestBucketServiceReplication_Validation(t *testing.T) {
+	t.Parallel()
+	_, svc := newReplicationTestClient(t, "Enabled")
+	ctx := context.Background()
+
+	for _, config := range []ReplicationConfig{
+		{},
+		{Rules: []ReplicationRule{{ID: "no-destination"}}},
+		{Rules: []ReplicationRule{{DestinationBucket: "replica", Status: "Paused"}}},
+		{Rules: []ReplicationRule{{DestinationBucket: "replica", StorageClass: "glacier"}}},
+	} {
+		err := svc.SetReplication(ctx, "source", config)
+		if _, ok := err.(*InvalidPolicyError); !ok {
+			t.Errorf("SetReplication(%+v) error = %v, want *InvalidPolicyError", config, err)
+		}
+	}
+
+	if err := svc.SetReplication(ctx, "", ReplicationConfig{}); err == nil {
+		t.Error("SetReplication() expected error for empty bucket name")
+	}
+	if _, err := svc.GetReplication(ctx, ""); err == nil {
+		t.Error("GetReplication() expected error for empty bucket name")
+	}
+	if err := svc.DeleteReplication(ctx, ""); err == nil {
+		t.Error("DeleteReplication() expected error for empty bucket name")
+	}
+}
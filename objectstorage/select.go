@@ -0,0 +1,217 @@
+package objectstorage
+
+import (
+	"context"
+	"io"
+
+	"github.com/minio/minio-go/v7"
+)
+
+// SelectFormat is the serialization of the data read or returned by Select.
+type SelectFormat string
+
+const (
+	SelectFormatCSV     SelectFormat = "CSV"
+	SelectFormatJSON    SelectFormat = "JSON"
+	SelectFormatParquet SelectFormat = "Parquet"
+)
+
+// SelectCompression is the compression of the object queried by Select.
+type SelectCompression string
+
+const (
+	SelectCompressionNone  SelectCompression = "NONE"
+	SelectCompressionGZIP  SelectCompression = "GZIP"
+	SelectCompressionBZIP2 SelectCompression = "BZIP2"
+)
+
+// CSVHeaderInfo tells Select how to treat the first line of a CSV object.
+type CSVHeaderInfo string
+
+const (
+	// CSVHeaderNone treats the first line as data.
+	CSVHeaderNone CSVHeaderInfo = "NONE"
+	// CSVHeaderIgnore skips the first line.
+	CSVHeaderIgnore CSVHeaderInfo = "IGNORE"
+	// CSVHeaderUse reads column names from the first line, so the expression
+	// can refer to columns by name.
+	CSVHeaderUse CSVHeaderInfo = "USE"
+)
+
+// JSONInputType is the layout of a JSON object queried by Select.
+type JSONInputType string
+
+const (
+	// JSONInputDocument reads the object as a single JSON document.
+	JSONInputDocument JSONInputType = "DOCUMENT"
+	// JSONInputLines reads the object as one JSON value per line.
+	JSONInputLines JSONInputType = "LINES"
+)
+
+// SelectInput describes the object queried by Select.
+type SelectInput struct {
+	Format SelectFormat `json:"format"`
+	// Compression defaults to SelectCompressionNone. Parquet objects cannot
+	// be compressed.
+	Compression SelectCompression `json:"compression,omitempty"`
+	// CSVHeader and CSVFieldDelimiter apply only to CSV input. The delimiter
+	// defaults to a comma.
+	CSVHeader         CSVHeaderInfo `json:"csv_header,omitempty"`
+	CSVFieldDelimiter string        `json:"csv_field_delimiter,omitempty"`
+	// JSONType applies only to JSON input and defaults to JSONInputDocument.
+	JSONType JSONInputType `json:"json_type,omitempty"`
+}
+
+// SelectOutput describes the rows returned by Select.
+type SelectOutput struct {
+	// Format is SelectFormatCSV or SelectFormatJSON, defaulting to CSV.
+	Format SelectFormat `json:"format,omitempty"`
+	// CSVFieldDelimiter applies only to CSV output and defaults to a comma.
+	CSVFieldDelimiter string `json:"csv_field_delimiter,omitempty"`
+}
+
+// SelectRequest is an SQL query run by Select over a single object.
+type SelectRequest struct {
+	// Expression is the SQL statement, such as
+	// "SELECT s.name FROM S3Object s WHERE s.status = 'active'".
+	Expression string       `json:"expression"`
+	Input      SelectInput  `json:"input"`
+	Output     SelectOutput `json:"output"`
+	// CustomerKey is the SSE-C key of an object encrypted with EncryptionSSEC.
+	CustomerKey []byte `json:"-"`
+}
+
+// Select runs req.Expression over a CSV, JSON or Parquet object on the server
+// and returns a reader for the matching rows, so large objects can be
+// filtered without downloading them. Close the reader when done.
+func (s *objectService) Select(ctx context.Context, bucketName string, objectKey string, req SelectRequest) (io.ReadCloser, error) {
+	if bucketName == "" {
+		return nil, &InvalidBucketNameError{Name: bucketName}
+	}
+
+	if objectKey == "" {
+		return nil, &InvalidObjectKeyError{Key: objectKey}
+	}
+
+	opts, err := req.selectOptions()
+	if err != nil {
+		return nil, err
+	}
+
+	results, err := s.client.minioClient.SelectObjectContent(ctx, bucketName, objectKey, opts)
+	if err != nil {
+		return nil, downloadError(bucketName, objectKey, err)
+	}
+
+	return results, nil
+}
+
+// selectOptions validates the request and converts it to its minio representation.
+func (r SelectRequest) selectOptions() (minio.SelectObjectOptions, error) {
+	opts := minio.SelectObjectOptions{
+		Expression:     r.Expression,
+		ExpressionType: minio.QueryExpressionTypeSQL,
+	}
+
+	if r.Expression == "" {
+		return opts, &InvalidObjectDataError{Message: "select expression cannot be empty"}
+	}
+
+	input, err := r.Input.serialization()
+	if err != nil {
+		return opts, err
+	}
+	opts.InputSerialization = input
+
+	output, err := r.Output.serialization()
+	if err != nil {
+		return opts, err
+	}
+	opts.OutputSerialization = output
+
+	sse, err := customerKeyEncryption(r.CustomerKey)
+	if err != nil {
+		return opts, err
+	}
+	opts.ServerSideEncryption = sse
+
+	return opts, nil
+}
+
+func (in SelectInput) serialization() (minio.SelectObjectInputSerialization, error) {
+	var s minio.SelectObjectInputSerialization
+
+	switch in.Compression {
+	case "", SelectCompressionNone:
+		s.CompressionType = minio.SelectCompressionNONE
+	case SelectCompressionGZIP, SelectCompressionBZIP2:
+		if in.Format == SelectFormatParquet {
+			return s, &InvalidObjectDataError{Message: "parquet select input cannot be compressed"}
+		}
+		s.CompressionType = minio.SelectCompressionType(in.Compression)
+	default:
+		return s, &InvalidObjectDataError{Message: "unsupported select compression " + string(in.Compression)}
+	}
+
+	if in.Format != SelectFormatCSV && (in.CSVHeader != "" || in.CSVFieldDelimiter != "") {
+		return s, &InvalidObjectDataError{Message: "CSV options require CSV select input"}
+	}
+	if in.Format != SelectFormatJSON && in.JSONType != "" {
+		return s, &InvalidObjectDataError{Message: "JSON type requires JSON select input"}
+	}
+
+	switch in.Format {
+	case SelectFormatCSV:
+		csv := &minio.CSVInputOptions{}
+		switch in.CSVHeader {
+		case "":
+		case CSVHeaderNone, CSVHeaderIgnore, CSVHeaderUse:
+			csv.SetFileHeaderInfo(minio.CSVFileHeaderInfo(in.CSVHeader))
+		default:
+			return s, &InvalidObjectDataError{Message: "unsupported CSV header info " + string(in.CSVHeader)}
+		}
+		if in.CSVFieldDelimiter != "" {
+			csv.SetFieldDelimiter(in.CSVFieldDelimiter)
+		}
+		s.CSV = csv
+	case SelectFormatJSON:
+		json := &minio.JSONInputOptions{}
+		switch in.JSONType {
+		case "", JSONInputDocument:
+			json.SetType(minio.JSONDocumentType)
+		case JSONInputLines:
+			json.SetType(minio.JSONLinesType)
+		default:
+			return s, &InvalidObjectDataError{Message: "unsupported JSON input type " + string(in.JSONType)}
+		}
+		s.JSON = json
+	case SelectFormatParquet:
+		s.Parquet = &minio.ParquetInputOptions{}
+	default:
+		return s, &InvalidObjectDataError{Message: "unsupported select input format " + string(in.Format)}
+	}
+
+	return s, nil
+}
+
+func (out SelectOutput) serialization() (minio.SelectObjectOutputSerialization, error) {
+	var s minio.SelectObjectOutputSerialization
+
+	switch out.Format {
+	case "", SelectFormatCSV:
+		csv := &minio.CSVOutputOptions{}
+		if out.CSVFieldDelimiter != "" {
+			csv.SetFieldDelimiter(out.CSVFieldDelimiter)
+		}
+		s.CSV = csv
+	case SelectFormatJSON:
+		if out.CSVFieldDelimiter != "" {
+			return s, &InvalidObjectDataError{Message: "CSV options require CSV select output"}
+		}
+		s.JSON = &minio.JSONOutputOptions{}
+	default:
+		return s, &InvalidObjectDataError{Message: "unsupported select output format " + string(out.Format)}
+	}
+
+	return s, nil
+}
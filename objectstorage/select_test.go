@@ -0,0 +1,163 @@
+package objectstorage
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"encoding/xml"
+	"errors"
+	"hash/crc32"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/minio/minio-go/v7"
+)
+
+// selectEvent encodes one message of the S3 Select event stream.
+func selectEvent(headers map[string]string, payload []byte) []byte {
+	var h bytes.Buffer
+	for name, value := range headers {
+		h.WriteByte(byte(len(name)))
+		h.WriteString(name)
+		h.WriteByte(7)
+		binary.Write(&h, binary.BigEndian, uint16(len(value)))
+		h.WriteString(value)
+	}
+
+	var msg bytes.Buffer
+	binary.Write(&msg, binary.BigEndian, uint32(16+h.Len()+len(payload)))
+	binary.Write(&msg, binary.BigEndian, uint32(h.Len()))
+	binary.Write(&msg, binary.BigEndian, crc32.ChecksumIEEE(msg.Bytes()))
+	msg.Write(h.Bytes())
+	msg.Write(payload)
+	binary.Write(&msg, binary.BigEndian, crc32.ChecksumIEEE(msg.Bytes()))
+	return msg.Bytes()
+}
+
+func selectResults(t *testing.T, rows string) *minio.SelectResults {
+	t.Helper()
+	// minio decodes the body in its own goroutine while Close may drain it,
+	// so the body must be safe for concurrent use: a pipe, like a real
+	// response body, rather than a bytes.Buffer.
+	body, w := io.Pipe()
+	go func() {
+		w.Write(selectEvent(map[string]string{":message-type": "event", ":event-type": "Records", ":content-type": "application/octet-stream"}, []byte(rows)))
+		w.Write(selectEvent(map[string]string{":message-type": "event", ":event-type": "End"}, nil))
+		w.Close()
+	}()
+
+	results, err := minio.NewSelectResults(&http.Response{StatusCode: http.StatusOK, Body: body}, "test-bucket")
+	if err != nil {
+		t.Fatalf("NewSelectResults() error = %v", err)
+	}
+	return results
+}
+
+func TestObjectServiceSelect(t *testing.T) {
+	t.Parallel()
+	mock, svc := newMultipartTestClient(t)
+
+	var got minio.SelectObjectOptions
+	mock.selectFunc = func(ctx context.Context, bucketName, objectName string, opts minio.SelectObjectOptions) (*minio.SelectResults, error) {
+		got = opts
+		return selectResults(t, "alice,42\n"), nil
+	}
+
+	reader, err := svc.Select(context.Background(), "test-bucket", "people.csv.gz", SelectRequest{
+		Expression: "SELECT s.name, s.age FROM S3Object s WHERE s.active = 'true'",
+		Input:      SelectInput{Format: SelectFormatCSV, Compression: SelectCompressionGZIP, CSVHeader: CSVHeaderUse},
+	})
+	if err != nil {
+		t.Fatalf("Select() error = %v", err)
+	}
+	defer reader.Close()
+
+	rows, err := io.ReadAll(reader)
+	if err != nil {
+		t.Fatalf("ReadAll() error = %v", err)
+	}
+	if string(rows) != "alice,42\n" {
+		t.Errorf("Select() rows = %q, want %q", rows, "alice,42\n")
+	}
+
+	if got.ExpressionType != minio.QueryExpressionTypeSQL || got.InputSerialization.CompressionType != minio.SelectCompressionGZIP {
+		t.Errorf("unexpected select options %+v", got)
+	}
+	body, err := xml.Marshal(got)
+	if err != nil {
+		t.Fatalf("xml.Marshal() error = %v", err)
+	}
+	for _, want := range []string{"<FileHeaderInfo>USE</FileHeaderInfo>", "<OutputSerialization><CSV>"} {
+		if !strings.Contains(string(body), want) {
+			t.Errorf("select request %s does not contain %s", body, want)
+		}
+	}
+}
+
+func TestObjectServiceSelect_JSON(t *testing.T) {
+	t.Parallel()
+	mock, svc := newMultipartTestClient(t)
+
+	var got minio.SelectObjectOptions
+	mock.selectFunc = func(ctx context.Context, bucketName, objectName string, opts minio.SelectObjectOptions) (*minio.SelectResults, error) {
+		got = opts
+		return nil, errors.New("stop")
+	}
+
+	_, err := svc.Select(context.Background(), "test-bucket", "events.ndjson", SelectRequest{
+		Expression: "SELECT * FROM S3Object s",
+		Input:      SelectInput{Format: SelectFormatJSON, JSONType: JSONInputLines},
+		Output:     SelectOutput{Format: SelectFormatJSON},
+	})
+	if err == nil || err.Error() != "stop" {
+		t.Fatalf("Select() error = %v, want the client error", err)
+	}
+	if got.InputSerialization.JSON == nil || got.InputSerialization.JSON.Type != minio.JSONLinesType {
+		t.Errorf("InputSerialization.JSON = %+v, want LINES", got.InputSerialization.JSON)
+	}
+	if got.OutputSerialization.JSON == nil || got.OutputSerialization.CSV != nil {
+		t.Errorf("OutputSerialization = %+v, want JSON", got.OutputSerialization)
+	}
+}
+
+func TestObjectServiceSelect_Invalid(t *testing.T) {
+	t.Parallel()
+	_, svc := newMultipartTestClient(t)
+	ctx := context.Background()
+	csv := SelectInput{Format: SelectFormatCSV}
+
+	if _, err := svc.Select(ctx, "", "key", SelectRequest{}); err == nil {
+		t.Error("Select() expected error for empty bucket name")
+	}
+	if _, err := svc.Select(ctx, "test-bucket", "", SelectRequest{}); err == nil {
+		t.Error("Select() expected error for empty object key")
+	}
+
+	tests := []struct {
+		name string
+		req  SelectRequest
+	}{
+		{name: "empty expression", req: SelectRequest{Input: csv}},
+		{name: "missing input format", req: SelectRequest{Expression: "SELECT * FROM S3Object"}},
+		{name: "compressed parquet", req: SelectRequest{Expression: "SELECT * FROM S3Object", Input: SelectInput{Format: SelectFormatParquet, Compression: SelectCompressionGZIP}}},
+		{name: "unknown compression", req: SelectRequest{Expression: "SELECT * FROM S3Object", Input: SelectInput{Format: SelectFormatCSV, Compression: "ZIP"}}},
+		{name: "CSV options on JSON input", req: SelectRequest{Expression: "SELECT * FROM S3Object", Input: SelectInput{Format: SelectFormatJSON, CSVHeader: CSVHeaderUse}}},
+		{name: "JSON type on CSV input", req: SelectRequest{Expression: "SELECT * FROM S3Object", Input: SelectInput{Format: SelectFormatCSV, JSONType: JSONInputLines}}},
+		{name: "unknown CSV header", req: SelectRequest{Expression: "SELECT * FROM S3Object", Input: SelectInput{Format: SelectFormatCSV, CSVHeader: "FIRST"}}},
+		{name: "parquet output", req: SelectRequest{Expression: "SELECT * FROM S3Object", Input: csv, Output: SelectOutput{Format: SelectFormatParquet}}},
+		{name: "CSV options on JSON output", req: SelectRequest{Expression: "SELECT * FROM S3Object", Input: csv, Output: SelectOutput{Format: SelectFormatJSON, CSVFieldDelimiter: ";"}}},
+		{name: "short customer key", req: SelectRequest{Expression: "SELECT * FROM S3Object", Input: csv, CustomerKey: []byte("short")}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := svc.Select(ctx, "test-bucket", "key", tt.req)
+			var dataErr *InvalidObjectDataError
+			if !errors.As(err, &dataErr) {
+				t.Errorf("Select() error = %v, want *InvalidObjectDataError", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,44 @@
+package objectstorage
+
+import (
+	"fmt"
+	"strings"
+)
+
+// StorageClass selects the storage tier of an object.
+type StorageClass string
+
+const (
+	// StorageClassStandard stores the object for frequent access.
+	StorageClassStandard StorageClass = "standard"
+	// StorageClassColdInstant stores the object at a lower cost for data that
+	// is rarely read but must remain immediately available.
+	StorageClassColdInstant StorageClass = "cold_instant"
+)
+
+// StorageClasses lists the storage classes accepted by uploads.
+var StorageClasses = []StorageClass{StorageClassStandard, StorageClassColdInstant}
+
+// IsValid reports whether c is one of StorageClasses.
+func (c StorageClass) IsValid() bool {
+	for _, class := range StorageClasses {
+		if c == class {
+			return true
+		}
+	}
+	return false
+}
+
+// validate returns an InvalidObjectDataError naming the valid classes when
+// c is set but unknown. An empty class keeps the bucket default.
+func (c StorageClass) validate() error {
+	if c == "" || c.IsValid() {
+		return nil
+	}
+
+	valid := make([]string, len(StorageClasses))
+	for i, class := range StorageClasses {
+		valid[i] = string(class)
+	}
+	return &InvalidObjectDataError{Message: fmt.Sprintf("invalid storage class %q, must be one of: %s", c, strings.Join(valid, ", "))}
+}
@@ -0,0 +1,55 @@
+package objectstorage
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestStorageClassIsValid(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		class StorageClass
+		want  bool
+	}{
+		{class: StorageClassStandard, want: true},
+		{class: StorageClassColdInstant, want: true},
+		{class: "cold_instant", want: true},
+		{class: "COLD_INSTANT", want: false},
+		{class: "glacier", want: false},
+		{class: "", want: false},
+	}
+
+	for _, tt := range tests {
+		if got := tt.class.IsValid(); got != tt.want {
+			t.Errorf("StorageClass(%q).IsValid() = %v, want %v", tt.class, got, tt.want)
+		}
+	}
+}
+
+func TestObjectServiceUploadStorageClass(t *testing.T) {
+	t.Parallel()
+	_, svc := newMultipartTestClient(t)
+	ctx := context.Background()
+
+	err := svc.UploadWithOptions(ctx, "test-bucket", "archive.tar", []byte("data"), UploadOptions{StorageClass: StorageClassColdInstant})
+	if err != nil {
+		t.Fatalf("UploadWithOptions() error = %v", err)
+	}
+
+	obj, err := svc.Metadata(ctx, "test-bucket", "archive.tar")
+	if err != nil {
+		t.Fatalf("Metadata() error = %v", err)
+	}
+	if obj.StorageClass != StorageClassColdInstant {
+		t.Errorf("Metadata().StorageClass = %q, want %q", obj.StorageClass, StorageClassColdInstant)
+	}
+
+	err = svc.UploadWithOptions(ctx, "test-bucket", "archive.tar", []byte("data"), UploadOptions{StorageClass: "glacier"})
+	var dataErr *InvalidObjectDataError
+	if !errors.As(err, &dataErr) || !strings.Contains(dataErr.Message, "standard, cold_instant") {
+		t.Errorf("UploadWithOptions() error = %v, want InvalidObjectDataError listing the valid classes", err)
+	}
+}
@@ -0,0 +1,124 @@
+package objectstorage
+
+import (
+	"context"
+
+	"github.com/MagaluCloud/mgc-sdk-go/internal/utils"
+	"github.com/minio/minio-go/v7"
+	"github.com/minio/minio-go/v7/pkg/tags"
+)
+
+// DefaultTagConcurrency is the number of objects tagged at the same time by
+// SetTagsByPrefix when TagByPrefixOptions.Concurrency is not set.
+const DefaultTagConcurrency = utils.DefaultGetManyConcurrency
+
+// TagByPrefixOptions configures SetTagsByPrefix.
+type TagByPrefixOptions struct {
+	// Include, Exclude and MatchMode select the objects under the prefix to
+	// tag, with the same rules as ObjectFilterOptions.
+	Include   []string
+	Exclude   []string
+	MatchMode MatchMode
+	// Concurrency is the number of objects tagged at the same time. Zero uses
+	// DefaultTagConcurrency.
+	Concurrency int
+}
+
+// TagByPrefixResult reports the outcome of SetTagsByPrefix.
+type TagByPrefixResult struct {
+	// Tagged is the number of objects whose tags were replaced.
+	Tagged int
+	// Errors holds one error per object that could not be tagged, prefixed
+	// with its key.
+	Errors []error
+}
+
+// GetTags returns the tags of an object. An object without tags returns an
+// empty map.
+func (s *objectService) GetTags(ctx context.Context, bucketName string, objectKey string) (map[string]string, error) {
+	if bucketName == "" {
+		return nil, &InvalidBucketNameError{Name: bucketName}
+	}
+
+	if objectKey == "" {
+		return nil, &InvalidObjectKeyError{Key: objectKey}
+	}
+
+	objectTags, err := s.client.minioClient.GetObjectTagging(ctx, bucketName, objectKey, minio.GetObjectTaggingOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	return objectTags.ToMap(), nil
+}
+
+// SetTags replaces the tags of an object. An empty map removes all tags.
+func (s *objectService) SetTags(ctx context.Context, bucketName string, objectKey string, objectTags map[string]string) error {
+	if bucketName == "" {
+		return &InvalidBucketNameError{Name: bucketName}
+	}
+
+	if objectKey == "" {
+		return &InvalidObjectKeyError{Key: objectKey}
+	}
+
+	t, err := newObjectTags(objectTags)
+	if err != nil {
+		return err
+	}
+
+	return s.client.minioClient.PutObjectTagging(ctx, bucketName, objectKey, t, minio.PutObjectTaggingOptions{})
+}
+
+// SetTagsByPrefix replaces the tags of every object under prefix that passes
+// the Include and Exclude patterns of opts. Objects are tagged concurrently;
+// a failure on one object does not stop the others and is reported in the
+// result. The returned error is set only when the objects cannot be listed
+// or the arguments are invalid.
+func (s *objectService) SetTagsByPrefix(ctx context.Context, bucketName string, prefix string, objectTags map[string]string, opts *TagByPrefixOptions) (*TagByPrefixResult, error) {
+	if opts == nil {
+		opts = &TagByPrefixOptions{}
+	}
+
+	if _, err := newObjectTags(objectTags); err != nil {
+		return nil, err
+	}
+
+	objects, err := s.ListAll(ctx, bucketName, ObjectFilterOptions{
+		Prefix:    prefix,
+		Include:   opts.Include,
+		Exclude:   opts.Exclude,
+		MatchMode: opts.MatchMode,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	keys := make([]string, len(objects))
+	for i, object := range objects {
+		keys[i] = object.Key
+	}
+
+	concurrency := opts.Concurrency
+	if concurrency <= 0 {
+		concurrency = DefaultTagConcurrency
+	}
+
+	tagged, errs := utils.GetMany(ctx, keys, concurrency, func(ctx context.Context, key string) (*struct{}, error) {
+		if err := s.SetTags(ctx, bucketName, key, objectTags); err != nil {
+			return nil, err
+		}
+		return &struct{}{}, nil
+	})
+
+	return &TagByPrefixResult{Tagged: len(tagged), Errors: errs}, nil
+}
+
+// newObjectTags validates objectTags against the S3 object tagging limits.
+func newObjectTags(objectTags map[string]string) (*tags.Tags, error) {
+	t, err := tags.NewTags(objectTags, true)
+	if err != nil {
+		return nil, &InvalidObjectDataError{Message: "invalid tags: " + err.Error()}
+	}
+	return t, nil
+}
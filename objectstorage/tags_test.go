@@ -0,0 +1,157 @@
+package objectstorage
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/minio/minio-go/v7"
+	"github.com/minio/minio-go/v7/pkg/tags"
+)
+
+func TestObjectServiceTags(t *testing.T) {
+	t.Parallel()
+	_, svc := newMultipartTestClient(t)
+	ctx := context.Background()
+
+	if err := svc.Upload(ctx, "test-bucket", "report.csv", []byte("data"), "text/csv"); err != nil {
+		t.Fatalf("Upload() error = %v", err)
+	}
+
+	got, err := svc.GetTags(ctx, "test-bucket", "report.csv")
+	if err != nil {
+		t.Fatalf("GetTags() error = %v", err)
+	}
+	if len(got) != 0 {
+		t.Errorf("GetTags() = %v, want no tags", got)
+	}
+
+	want := map[string]string{"cost-center": "billing", "env": "prod"}
+	if err := svc.SetTags(ctx, "test-bucket", "report.csv", want); err != nil {
+		t.Fatalf("SetTags() error = %v", err)
+	}
+
+	got, err = svc.GetTags(ctx, "test-bucket", "report.csv")
+	if err != nil {
+		t.Fatalf("GetTags() error = %v", err)
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("GetTags() = %v, want %v", got, want)
+	}
+}
+
+func TestObjectServiceTags_Validation(t *testing.T) {
+	t.Parallel()
+	_, svc := newMultipartTestClient(t)
+	ctx := context.Background()
+
+	if err := svc.SetTags(ctx, "", "key", nil); err == nil {
+		t.Error("SetTags() expected error for empty bucket name")
+	}
+	if err := svc.SetTags(ctx, "test-bucket", "", nil); err == nil {
+		t.Error("SetTags() expected error for empty object key")
+	}
+	if _, err := svc.GetTags(ctx, "", "key"); err == nil {
+		t.Error("GetTags() expected error for empty bucket name")
+	}
+
+	err := svc.SetTags(ctx, "test-bucket", "key", map[string]string{"": "value"})
+	var dataErr *InvalidObjectDataError
+	if !errors.As(err, &dataErr) || !strings.Contains(dataErr.Message, "invalid tags") {
+		t.Errorf("SetTags() error = %v, want InvalidObjectDataError", err)
+	}
+}
+
+func TestObjectServiceSetTagsByPrefix(t *testing.T) {
+	t.Parallel()
+	mock, svc := newMultipartTestClient(t)
+	ctx := context.Background()
+
+	for _, key := range []string{"logs/a.log", "logs/b.log", "logs/tmp/c.log", "logs/d.txt", "data/e.log"} {
+		if err := svc.Upload(ctx, "test-bucket", key, []byte("x"), "text/plain"); err != nil {
+			t.Fatalf("Upload(%s) error = %v", key, err)
+		}
+	}
+
+	objectTags := map[string]string{"team": "platform"}
+	result, err := svc.SetTagsByPrefix(ctx, "test-bucket", "logs/", objectTags, &TagByPrefixOptions{
+		Include:     []string{"*.log"},
+		Exclude:     []string{"logs/tmp/**"},
+		Concurrency: 2,
+	})
+	if err != nil {
+		t.Fatalf("SetTagsByPrefix() error = %v", err)
+	}
+	if result.Tagged != 2 || len(result.Errors) != 0 {
+		t.Errorf("SetTagsByPrefix() = %+v, want 2 tagged and no errors", result)
+	}
+
+	objects := mock.buckets["test-bucket"].objects
+	for key, want := range map[string]bool{
+		"logs/a.log":     true,
+		"logs/b.log":     true,
+		"logs/tmp/c.log": false,
+		"logs/d.txt":     false,
+		"data/e.log":     false,
+	} {
+		if got := objects[key].tags["team"] == "platform"; got != want {
+			t.Errorf("%s tagged = %v, want %v", key, got, want)
+		}
+	}
+}
+
+func TestObjectServiceSetTagsByPrefix_PartialFailure(t *testing.T) {
+	t.Parallel()
+	mock, svc := newMultipartTestClient(t)
+	ctx := context.Background()
+
+	for _, key := range []string{"a", "b", "c"} {
+		if err := svc.Upload(ctx, "test-bucket", key, []byte("x"), "text/plain"); err != nil {
+			t.Fatalf("Upload(%s) error = %v", key, err)
+		}
+	}
+
+	mock.putObjectTaggingFunc = func(ctx context.Context, bucketName, objectName string, otags *tags.Tags, opts minio.PutObjectTaggingOptions) error {
+		if objectName == "b" {
+			return errors.New("access denied")
+		}
+		return nil
+	}
+
+	result, err := svc.SetTagsByPrefix(ctx, "test-bucket", "", map[string]string{"k": "v"}, nil)
+	if err != nil {
+		t.Fatalf("SetTagsByPrefix() error = %v", err)
+	}
+	if result.Tagged != 2 {
+		t.Errorf("Tagged = %d, want 2", result.Tagged)
+	}
+	if len(result.Errors) != 1 || !strings.HasPrefix(result.Errors[0].Error(), "b: ") {
+		t.Errorf("Errors = %v, want one error for b", result.Errors)
+	}
+}
+
+func TestObjectServiceSetTagsByPrefix_InvalidArguments(t *testing.T) {
+	t.Parallel()
+	_, svc := newMultipartTestClient(t)
+	ctx := context.Background()
+
+	if _, err := svc.SetTagsByPrefix(ctx, "", "logs/", map[string]string{"k": "v"}, nil); err == nil {
+		t.Error("SetTagsByPrefix() expected error for empty bucket name")
+	}
+
+	_, err := svc.SetTagsByPrefix(ctx, "test-bucket", "logs/", map[string]string{"": "v"}, nil)
+	if _, ok := err.(*InvalidObjectDataError); !ok {
+		t.Errorf("SetTagsByPrefix() error = %T, want *InvalidObjectDataError", err)
+	}
+
+	_, err = svc.SetTagsByPrefix(ctx, "test-bucket", "logs/", map[string]string{"k": "v"}, &TagByPrefixOptions{
+		Include:   []string{"("},
+		MatchMode: MatchModeRegex,
+	})
+	var filterErr *InvalidFilterError
+	if !errors.As(err, &filterErr) {
+		t.Errorf("SetTagsByPrefix() error = %v, want InvalidFilterError", err)
+	}
+}
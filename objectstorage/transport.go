@@ -4,6 +4,8 @@ import (
 	"net/http"
 )
 
+// forceDeleteTransport asks the server to delete a bucket along with its
+// contents when the request context was marked with WithForceDelete.
 type forceDeleteTransport struct {
 	base http.RoundTripper
 }
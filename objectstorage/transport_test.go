@@ -0,0 +1,57 @@
+package objectstorage
+
+import (
+	"context"
+	"net/http"
+	"testing"
+)
+
+type recordingRoundTripper struct {
+	header http.Header
+}
+
+func (r *recordingRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	r.header = req.Header.Clone()
+	return &http.Response{StatusCode: http.StatusNoContent, Body: http.NoBody, Request: req}, nil
+}
+
+func TestForceDeleteTransport(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		method     string
+		force      bool
+		wantHeader string
+	}{
+		{name: "forced delete", method: http.MethodDelete, force: true, wantHeader: "true"},
+		{name: "plain delete", method: http.MethodDelete, force: false, wantHeader: ""},
+		{name: "forced context on other methods", method: http.MethodGet, force: true, wantHeader: ""},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+			if tt.force {
+				ctx = WithForceDelete(ctx)
+			}
+			req, err := http.NewRequestWithContext(ctx, tt.method, "https://br-se1.magaluobjects.com/bucket", nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			base := &recordingRoundTripper{}
+			transport := &forceDeleteTransport{base: base}
+			if _, err := transport.RoundTrip(req); err != nil {
+				t.Fatalf("RoundTrip() error = %v", err)
+			}
+
+			if got := base.header.Get("X-Force-Container-Delete"); got != tt.wantHeader {
+				t.Errorf("X-Force-Container-Delete = %q, want %q", got, tt.wantHeader)
+			}
+		})
+	}
+}
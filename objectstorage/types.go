@@ -8,6 +8,12 @@ type Bucket struct {
 	CreationDate time.Time `json:"creation_date"`
 }
 
+// BucketUsage represents the storage consumed by a bucket.
+type BucketUsage struct {
+	ObjectCount int64 `json:"object_count"`
+	TotalBytes  int64 `json:"total_bytes"`
+}
+
 // Object represents an object stored in a bucket.
 type Object struct {
 	Key          string    `json:"key"`
@@ -15,6 +21,18 @@ type Object struct {
 	LastModified time.Time `json:"last_modified"`
 	ETag         string    `json:"etag,omitempty"`
 	ContentType  string    `json:"content_type,omitempty"`
+	// ContentDisposition and CacheControl are only filled by Metadata.
+	ContentDisposition string `json:"content_disposition,omitempty"`
+	CacheControl       string `json:"cache_control,omitempty"`
+	// UserMetadata holds the custom x-amz-meta-* metadata with lower case keys.
+	// It is only filled by Metadata.
+	UserMetadata map[string]string `json:"user_metadata,omitempty"`
+	// Encryption is the server-side encryption of the object, empty when it is
+	// not encrypted. It is only filled by Metadata.
+	Encryption EncryptionType `json:"encryption,omitempty"`
+	// StorageClass is the storage tier reported by the service. It is only
+	// filled by Metadata.
+	StorageClass StorageClass `json:"storage_class,omitempty"`
 }
 
 // BucketListOptions defines parameters for filtering and pagination of bucket lists.
@@ -24,19 +42,56 @@ type BucketListOptions struct {
 }
 
 // ObjectListOptions defines parameters for filtering and pagination of object lists.
+//
+// Objects are listed in lexicographic order of their keys. To walk a large bucket page by page, pass
+// the key of the last object of a page as StartAfter for the next one instead
+// of increasing Offset: the listing then resumes on the server rather than
+// skipping Offset objects on every call.
 type ObjectListOptions struct {
-	Limit     *int   `json:"_limit,omitempty"`
-	Offset    *int   `json:"_offset,omitempty"`
-	Prefix    string `json:"prefix,omitempty"`
-	Delimiter string `json:"delimiter,omitempty"`
+	Limit      *int   `json:"_limit,omitempty"`
+	Offset     *int   `json:"_offset,omitempty"`
+	Prefix     string `json:"prefix,omitempty"`
+	Delimiter  string `json:"delimiter,omitempty"`
+	StartAfter string `json:"start_after,omitempty"`
 }
 
 // ObjectFilterOptions defines filtering options for ListAll (without pagination).
+//
+// Include and Exclude are applied locally to each object key after listing.
+// An object is returned when it matches at least one Include pattern (or
+// Include is empty) and matches no Exclude pattern; Exclude always wins.
+// MatchMode selects how patterns are interpreted and defaults to MatchModeGlob.
+//
+// Objects are returned in lexicographic order of their keys (byte order of
+// UTF-8). StartAfter skips every key up to and including it, so a job that
+// stopped part way through can resume from the last key it processed.
 type ObjectFilterOptions struct {
-	Prefix    string `json:"prefix,omitempty"`
-	Delimiter string `json:"delimiter,omitempty"`
+	Prefix     string    `json:"prefix,omitempty"`
+	Delimiter  string    `json:"delimiter,omitempty"`
+	Include    []string  `json:"include,omitempty"`
+	Exclude    []string  `json:"exclude,omitempty"`
+	MatchMode  MatchMode `json:"match_mode,omitempty"`
+	StartAfter string    `json:"start_after,omitempty"`
+	// IncludeDeleteMarkers returns delete markers from ListAllVersions. It is
+	// ignored by ListAll, which only returns current objects.
+	IncludeDeleteMarkers bool `json:"include_delete_markers,omitempty"`
 }
 
+// MatchMode controls how ObjectFilterOptions Include and Exclude patterns are matched.
+type MatchMode string
+
+const (
+	// MatchModeGlob matches keys with path.Match semantics: "*" and "?" do not
+	// cross "/", and "**" matches any sequence including "/". Patterns without
+	// a "/" are matched against the last path element, so "*.log" matches
+	// "logs/app.log".
+	MatchModeGlob MatchMode = "glob"
+	// MatchModeSubstring matches keys that contain the pattern.
+	MatchModeSubstring MatchMode = "substring"
+	// MatchModeRegex matches keys with a regular expression (regexp syntax).
+	MatchModeRegex MatchMode = "regex"
+)
+
 // Statement represents a single statement in an S3 bucket policy.
 type Statement struct {
 	Sid       string `json:"Sid,omitempty"`
@@ -91,17 +146,56 @@ type ObjectVersion struct {
 	Size           int64     `json:"size"`
 	LastModified   time.Time `json:"last_modified"`
 	IsDeleteMarker bool      `json:"is_delete_marker"`
-	ETag           string    `json:"etag,omitempty"`
+	// IsLatest marks the current version of the key. It is only filled by
+	// ListAllVersions.
+	IsLatest bool   `json:"is_latest,omitempty"`
+	ETag     string `json:"etag,omitempty"`
+}
+
+// UploadOptions defines optional attributes stored with an uploaded object.
+type UploadOptions struct {
+	ContentType string `json:"content_type,omitempty"`
+	// ContentDisposition is returned when the object is downloaded, for example
+	// `attachment; filename="report.pdf"` to set the browser download name.
+	ContentDisposition string `json:"content_disposition,omitempty"`
+	// CacheControl is returned when the object is downloaded, for example
+	// "public, max-age=86400" to let CDNs and browsers cache it.
+	CacheControl string `json:"cache_control,omitempty"`
+	// UserMetadata is stored as x-amz-meta-* headers. Keys may contain letters,
+	// digits, hyphens and underscores, values must be printable ASCII, and keys
+	// and values together may not exceed MaxUserMetadataSize bytes.
+	UserMetadata map[string]string `json:"user_metadata,omitempty"`
+	// Encryption requests server-side encryption of the object. When nil, the
+	// bucket default applies.
+	Encryption *Encryption `json:"encryption,omitempty"`
+	// StorageClass selects the storage tier. When empty, the bucket default
+	// applies.
+	StorageClass StorageClass `json:"storage_class,omitempty"`
+	// Progress, when set, receives the upload progress with its speed and
+	// estimated time left. The data is then read through a wrapper that
+	// cannot seek, so minio does not retry failed requests of the upload.
+	Progress ProgressFunc `json:"-"`
 }
 
 // DownloadOptions defines optional parameters for downloading objects.
 type DownloadOptions struct {
 	VersionID string `json:"version_id,omitempty"`
+	// CustomerKey is the key the object was uploaded with using EncryptionSSEC.
+	CustomerKey []byte `json:"-"`
 }
 
 // DownloadStreamOptions defines optional parameters for streaming object downloads.
 type DownloadStreamOptions struct {
 	VersionID string `json:"version_id,omitempty"`
+	// CustomerKey is the key the object was uploaded with using EncryptionSSEC.
+	CustomerKey []byte `json:"-"`
+}
+
+// MetadataOptions defines optional parameters for reading object metadata.
+type MetadataOptions struct {
+	VersionID string `json:"version_id,omitempty"`
+	// CustomerKey is the key the object was uploaded with using EncryptionSSEC.
+	CustomerKey []byte `json:"-"`
 }
 
 // DeleteOptions defines optional parameters for deleting objects.
@@ -114,3 +208,35 @@ type ListVersionsOptions struct {
 	Limit  *int `json:"_limit,omitempty"`
 	Offset *int `json:"_offset,omitempty"`
 }
+
+const (
+	// DefaultPresignedURLExpiry is the validity of a presigned URL when none is given.
+	DefaultPresignedURLExpiry = 15 * time.Minute
+	// MaxPresignedURLExpiry is the longest validity accepted for a presigned URL.
+	MaxPresignedURLExpiry = 7 * 24 * time.Hour
+)
+
+// PresignedURLOptions defines parameters for generating presigned URLs.
+type PresignedURLOptions struct {
+	Method    string        `json:"method,omitempty"`
+	ExpiresIn time.Duration `json:"expires_in,omitempty"`
+}
+
+// MaxMultipartParts is the highest part number accepted in a multipart upload.
+const MaxMultipartParts = 10000
+
+// UploadedPart represents a part of a multipart upload.
+type UploadedPart struct {
+	PartNumber   int       `json:"part_number"`
+	ETag         string    `json:"etag"`
+	Size         int64     `json:"size"`
+	LastModified time.Time `json:"last_modified"`
+}
+
+// IncompleteUpload represents a multipart upload that was started but not completed or aborted.
+type IncompleteUpload struct {
+	Key       string    `json:"key"`
+	UploadID  string    `json:"upload_id"`
+	Initiated time.Time `json:"initiated"`
+	Size      int64     `json:"size"`
+}
@@ -0,0 +1,26 @@
+module github.com/MagaluCloud/mgc-sdk-go/prommetrics
+
+go 1.25.3
+
+require (
+	github.com/MagaluCloud/mgc-sdk-go v0.3.46
+	github.com/prometheus/client_golang v1.20.5
+)
+
+require (
+	github.com/beorn7/perks v1.0.1 // indirect
+	github.com/cespare/xxhash/v2 v2.3.0 // indirect
+	github.com/kr/text v0.2.0 // indirect
+	github.com/kylelemons/godebug v1.1.0 // indirect
+	github.com/munnerz/goautoneg v0.0.0-20191010083416-a7dc8b61c822 // indirect
+	github.com/prometheus/client_model v0.6.1 // indirect
+	github.com/prometheus/common v0.55.0 // indirect
+	github.com/prometheus/procfs v0.15.1 // indirect
+	golang.org/x/sys v0.33.0 // indirect
+	google.golang.org/protobuf v1.34.2 // indirect
+	gopkg.in/yaml.v3 v3.0.1 // indirect
+)
+
+// The replace only applies when prommetrics is the main module, so tests here
+// run against the SDK in this repository. Consumers get the version above.
+replace github.com/MagaluCloud/mgc-sdk-go => ../
@@ -0,0 +1,79 @@
+// Package prommetrics records MagaluCloud SDK requests as Prometheus metrics.
+// It is a separate module so that users who don't need Prometheus don't get
+// it in their module graph.
+package prommetrics
+
+import (
+	"strconv"
+
+	"github.com/MagaluCloud/mgc-sdk-go/client"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// Labels attached to every metric.
+var labels = []string{"service", "operation", "code"}
+
+// Recorder implements client.MetricsRecorder with Prometheus collectors.
+type Recorder struct {
+	requests *prometheus.CounterVec
+	errors   *prometheus.CounterVec
+	duration *prometheus.HistogramVec
+}
+
+var _ client.MetricsRecorder = (*Recorder)(nil)
+
+// New creates a Recorder and registers its collectors with reg:
+//
+//   - mgc_sdk_requests_total counts HTTP attempts.
+//   - mgc_sdk_request_errors_total counts attempts that failed with a
+//     transport error or a non-2xx response.
+//   - mgc_sdk_request_duration_seconds observes the time to receive a response.
+//
+// All of them are labeled by service, operation and status code; code is "0"
+// when no response was received.
+func New(reg prometheus.Registerer) (*Recorder, error) {
+	r := &Recorder{
+		requests: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "mgc_sdk_requests_total",
+			Help: "Total number of HTTP requests made to the MagaluCloud API.",
+		}, labels),
+		errors: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "mgc_sdk_request_errors_total",
+			Help: "Total number of failed HTTP requests made to the MagaluCloud API.",
+		}, labels),
+		duration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Name:    "mgc_sdk_request_duration_seconds",
+			Help:    "Duration of HTTP requests made to the MagaluCloud API.",
+			Buckets: prometheus.DefBuckets,
+		}, labels),
+	}
+
+	for _, c := range []prometheus.Collector{r.requests, r.errors, r.duration} {
+		if err := reg.Register(c); err != nil {
+			return nil, err
+		}
+	}
+	return r, nil
+}
+
+// WithMetrics returns a client option that records requests with a new
+// Recorder registered with reg. It panics if registration fails, as
+// prometheus.MustRegister does.
+func WithMetrics(reg prometheus.Registerer) client.Option {
+	r, err := New(reg)
+	if err != nil {
+		panic(err)
+	}
+	return client.WithMetrics(r)
+}
+
+// ObserveRequest records a single HTTP attempt.
+func (r *Recorder) ObserveRequest(m client.RequestMetrics) {
+	values := []string{m.Service, m.Operation, strconv.Itoa(m.StatusCode)}
+
+	r.requests.WithLabelValues(values...).Inc()
+	r.duration.WithLabelValues(values...).Observe(m.Duration.Seconds())
+	if m.Err != nil {
+		r.errors.WithLabelValues(values...).Inc()
+	}
+}
@@ -0,0 +1,73 @@
+package prommetrics
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/MagaluCloud/mgc-sdk-go/client"
+	"github.com/MagaluCloud/mgc-sdk-go/compute"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+)
+
+func TestRecorder_ObserveRequest(t *testing.T) {
+	reg := prometheus.NewRegistry()
+	r, err := New(reg)
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+
+	r.ObserveRequest(client.RequestMetrics{Service: "compute", Operation: "GET", StatusCode: 200, Duration: time.Second})
+	r.ObserveRequest(client.RequestMetrics{Service: "compute", Operation: "GET", StatusCode: 404, Err: errors.New("not found")})
+	r.ObserveRequest(client.RequestMetrics{Service: "network", Operation: "DELETE", Err: errors.New("connection refused")})
+
+	if got := testutil.ToFloat64(r.requests.WithLabelValues("compute", "GET", "200")); got != 1 {
+		t.Errorf("requests{compute,GET,200} = %v, want 1", got)
+	}
+	if got := testutil.ToFloat64(r.errors.WithLabelValues("compute", "GET", "404")); got != 1 {
+		t.Errorf("errors{compute,GET,404} = %v, want 1", got)
+	}
+	if got := testutil.ToFloat64(r.errors.WithLabelValues("network", "DELETE", "0")); got != 1 {
+		t.Errorf("errors{network,DELETE,0} = %v, want 1", got)
+	}
+	if got := testutil.CollectAndCount(r.duration); got != 3 {
+		t.Errorf("duration series = %d, want 3", got)
+	}
+}
+
+func TestNew_DuplicateRegistration(t *testing.T) {
+	reg := prometheus.NewRegistry()
+	if _, err := New(reg); err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+	if _, err := New(reg); err == nil {
+		t.Error("expected error registering the collectors twice")
+	}
+}
+
+func TestWithMetrics(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"instances": []}`))
+	}))
+	defer server.Close()
+
+	reg := prometheus.NewRegistry()
+	core := client.NewMgcClient(
+		client.WithBaseURL(client.MgcUrl(server.URL)),
+		WithMetrics(reg),
+	)
+
+	if _, err := compute.New(core).Instances().List(context.Background(), compute.ListOptions{}); err != nil {
+		t.Fatalf("List() error = %v", err)
+	}
+
+	r := core.GetConfig().Metrics.(*Recorder)
+	if got := testutil.ToFloat64(r.requests.WithLabelValues("compute", "GET", "200")); got != 1 {
+		t.Errorf("requests{compute,GET,200} = %v, want 1", got)
+	}
+}
@@ -0,0 +1,175 @@
+// Package schema generates JSON Schema documents for the SDK's request and
+// response types, for use by code generators and documentation tooling.
+// It lives in its own package so that importing a service package does not
+// pull in the type registry.
+package schema
+
+import (
+	"encoding/json"
+	"path"
+	"reflect"
+	"sort"
+	"strings"
+	"time"
+)
+
+// Draft is the JSON Schema dialect produced by this package.
+const Draft = "https://json-schema.org/draft/2020-12/schema"
+
+// Schema is a JSON Schema document or subschema.
+type Schema struct {
+	SchemaURI            string             `json:"$schema,omitempty"`
+	Ref                  string             `json:"$ref,omitempty"`
+	Type                 string             `json:"type,omitempty"`
+	Format               string             `json:"format,omitempty"`
+	Properties           map[string]*Schema `json:"properties,omitempty"`
+	Required             []string           `json:"required,omitempty"`
+	Items                *Schema            `json:"items,omitempty"`
+	AdditionalProperties *Schema            `json:"additionalProperties,omitempty"`
+	Defs                 map[string]*Schema `json:"$defs,omitempty"`
+}
+
+var (
+	timeType       = reflect.TypeFor[time.Time]()
+	durationType   = reflect.TypeFor[time.Duration]()
+	rawMessageType = reflect.TypeFor[json.RawMessage]()
+)
+
+// Generate returns the JSON Schema of the type of v. Named struct types are
+// placed in $defs and referenced by name, as "package.Type".
+func Generate(v any) *Schema {
+	g := &generator{defs: make(map[string]*Schema)}
+	s := g.schemaFor(reflect.TypeOf(v))
+	s.SchemaURI = Draft
+	if len(g.defs) > 0 {
+		s.Defs = g.defs
+	}
+	return s
+}
+
+// Dump returns a JSON Schema document with a definition for every type in Types.
+func Dump() ([]byte, error) {
+	g := &generator{defs: make(map[string]*Schema)}
+	for name, v := range Types {
+		s := g.schemaFor(reflect.TypeOf(v))
+		// Aliases of generic types, such as paginated responses, are not
+		// referenced by name and need their own definition
+		if s.Ref != "#/$defs/"+name {
+			g.defs[name] = s
+		}
+	}
+	return json.MarshalIndent(&Schema{SchemaURI: Draft, Defs: g.defs}, "", "  ")
+}
+
+// generator collects the definitions of named structs while walking a type
+type generator struct {
+	defs map[string]*Schema
+}
+
+func (g *generator) schemaFor(t reflect.Type) *Schema {
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+
+	switch t {
+	case timeType:
+		return &Schema{Type: "string", Format: "date-time"}
+	case durationType:
+		return &Schema{Type: "integer"}
+	case rawMessageType:
+		return &Schema{}
+	}
+
+	switch t.Kind() {
+	case reflect.Bool:
+		return &Schema{Type: "boolean"}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return &Schema{Type: "integer"}
+	case reflect.Float32, reflect.Float64:
+		return &Schema{Type: "number"}
+	case reflect.String:
+		return &Schema{Type: "string"}
+	case reflect.Slice, reflect.Array:
+		if t.Elem().Kind() == reflect.Uint8 {
+			// encoding/json writes byte slices as base64 strings
+			return &Schema{Type: "string", Format: "byte"}
+		}
+		return &Schema{Type: "array", Items: g.schemaFor(t.Elem())}
+	case reflect.Map:
+		return &Schema{Type: "object", AdditionalProperties: g.schemaFor(t.Elem())}
+	case reflect.Struct:
+		return g.structSchema(t)
+	default:
+		return &Schema{}
+	}
+}
+
+// structSchema returns a reference to a named struct, or the inline schema of an anonymous one
+func (g *generator) structSchema(t reflect.Type) *Schema {
+	if !isNamed(t) {
+		return g.objectSchema(t)
+	}
+
+	name := defName(t)
+	if _, ok := g.defs[name]; !ok {
+		// Reserve the name first so recursive types terminate
+		g.defs[name] = &Schema{}
+		*g.defs[name] = *g.objectSchema(t)
+	}
+	return &Schema{Ref: "#/$defs/" + name}
+}
+
+func (g *generator) objectSchema(t reflect.Type) *Schema {
+	s := &Schema{Type: "object", Properties: make(map[string]*Schema)}
+	g.addFields(s, t)
+	sort.Strings(s.Required)
+	return s
+}
+
+// addFields adds the JSON fields of t to s, flattening embedded structs as encoding/json does
+func (g *generator) addFields(s *Schema, t reflect.Type) {
+	for i := range t.NumField() {
+		field := t.Field(i)
+		tag := field.Tag.Get("json")
+		if tag == "-" {
+			continue
+		}
+		name, opts, _ := strings.Cut(tag, ",")
+
+		if field.Anonymous && name == "" {
+			ft := field.Type
+			if ft.Kind() == reflect.Pointer {
+				ft = ft.Elem()
+			}
+			if ft.Kind() == reflect.Struct {
+				g.addFields(s, ft)
+				continue
+			}
+		}
+		if !field.IsExported() {
+			continue
+		}
+		if name == "" {
+			name = field.Name
+		}
+
+		s.Properties[name] = g.schemaFor(field.Type)
+		if !strings.Contains(opts, "omitempty") && field.Type.Kind() != reflect.Pointer {
+			s.Required = append(s.Required, name)
+		}
+	}
+}
+
+// isNamed reports whether t is a named, non-generic type that can be referenced by name
+func isNamed(t reflect.Type) bool {
+	return t.Name() != "" && !strings.Contains(t.Name(), "[")
+}
+
+// defName returns the definition name of t, such as "compute.CreateRequest"
+func defName(t reflect.Type) string {
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	return path.Base(t.PkgPath()) + "." + t.Name()
+}
@@ -0,0 +1,117 @@
+package schema
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+	"time"
+)
+
+type testNested struct {
+	Value int `json:"value"`
+}
+
+type testRecursive struct {
+	Children []testRecursive `json:"children,omitempty"`
+}
+
+type testEmbedded struct {
+	Embedded string `json:"embedded"`
+}
+
+type testRequest struct {
+	testEmbedded
+	Name      string            `json:"name"`
+	Size      *int              `json:"size,omitempty"`
+	Tags      []string          `json:"tags,omitempty"`
+	Labels    map[string]string `json:"labels"`
+	CreatedAt time.Time         `json:"created_at"`
+	Data      []byte            `json:"data"`
+	Nested    testNested        `json:"nested"`
+	Ignored   string            `json:"-"`
+	Anonymous struct {
+		Flag bool `json:"flag"`
+	} `json:"anonymous"`
+	unexported string
+}
+
+func TestGenerate(t *testing.T) {
+	s := Generate(testRequest{})
+
+	if s.SchemaURI != Draft {
+		t.Errorf("$schema = %q, want %q", s.SchemaURI, Draft)
+	}
+	if s.Ref != "#/$defs/schema.testRequest" {
+		t.Fatalf("$ref = %q, want reference to schema.testRequest", s.Ref)
+	}
+
+	def := s.Defs["schema.testRequest"]
+	if def == nil {
+		t.Fatal("expected definition for schema.testRequest")
+	}
+
+	tests := map[string]Schema{
+		"embedded":   {Type: "string"},
+		"name":       {Type: "string"},
+		"size":       {Type: "integer"},
+		"tags":       {Type: "array", Items: &Schema{Type: "string"}},
+		"labels":     {Type: "object", AdditionalProperties: &Schema{Type: "string"}},
+		"created_at": {Type: "string", Format: "date-time"},
+		"data":       {Type: "string", Format: "byte"},
+		"nested":     {Ref: "#/$defs/schema.testNested"},
+	}
+	for name, want := range tests {
+		if got := def.Properties[name]; got == nil || !reflect.DeepEqual(*got, want) {
+			t.Errorf("property %q = %+v, want %+v", name, got, want)
+		}
+	}
+
+	if anon := def.Properties["anonymous"]; anon == nil || anon.Type != "object" || anon.Properties["flag"] == nil {
+		t.Errorf("expected anonymous struct to be inlined, got %+v", anon)
+	}
+	for _, name := range []string{"Ignored", "-", "unexported"} {
+		if _, ok := def.Properties[name]; ok {
+			t.Errorf("unexpected property %q", name)
+		}
+	}
+
+	wantRequired := []string{"anonymous", "created_at", "data", "embedded", "labels", "name", "nested"}
+	if !reflect.DeepEqual(def.Required, wantRequired) {
+		t.Errorf("required = %v, want %v", def.Required, wantRequired)
+	}
+}
+
+func TestGenerate_Recursive(t *testing.T) {
+	s := Generate(testRecursive{})
+
+	def := s.Defs["schema.testRecursive"]
+	if def == nil {
+		t.Fatal("expected definition for schema.testRecursive")
+	}
+	if items := def.Properties["children"].Items; items == nil || items.Ref != "#/$defs/schema.testRecursive" {
+		t.Errorf("expected recursive reference, got %+v", items)
+	}
+}
+
+func TestDump(t *testing.T) {
+	data, err := Dump()
+	if err != nil {
+		t.Fatalf("Dump() error = %v", err)
+	}
+
+	var doc Schema
+	if err := json.Unmarshal(data, &doc); err != nil {
+		t.Fatalf("Dump() produced invalid JSON: %v", err)
+	}
+
+	for name := range Types {
+		if doc.Defs[name] == nil {
+			t.Errorf("missing definition for %s", name)
+		}
+	}
+	for _, name := range []string{"compute.CreateRequest", "containerregistry.ImagesResponse"} {
+		if doc.Defs[name] == nil || len(doc.Defs[name].Properties) == 0 {
+			t.Errorf("expected properties for %s", name)
+		}
+	}
+}
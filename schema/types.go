@@ -0,0 +1,230 @@
+package schema
+
+import (
+	"github.com/MagaluCloud/mgc-sdk-go/audit"
+	"github.com/MagaluCloud/mgc-sdk-go/availabilityzones"
+	"github.com/MagaluCloud/mgc-sdk-go/blockstorage"
+	"github.com/MagaluCloud/mgc-sdk-go/compute"
+	"github.com/MagaluCloud/mgc-sdk-go/containerregistry"
+	"github.com/MagaluCloud/mgc-sdk-go/dbaas"
+	"github.com/MagaluCloud/mgc-sdk-go/iam"
+	"github.com/MagaluCloud/mgc-sdk-go/kubernetes"
+	"github.com/MagaluCloud/mgc-sdk-go/lbaas"
+	"github.com/MagaluCloud/mgc-sdk-go/network"
+	"github.com/MagaluCloud/mgc-sdk-go/sshkeys"
+)
+
+// Types lists the request and response types exported by Dump, keyed by
+// definition name.
+var Types = map[string]any{
+	"audit.Event":                                      audit.Event{},
+	"audit.EventFilterParams":                          audit.EventFilterParams{},
+	"audit.EventType":                                  audit.EventType{},
+	"audit.EventTypeFilterParams":                      audit.EventTypeFilterParams{},
+	"audit.ListEventTypesParams":                       audit.ListEventTypesParams{},
+	"audit.ListEventsParams":                           audit.ListEventsParams{},
+	"availabilityzones.ListResponse":                   availabilityzones.ListResponse{},
+	"blockstorage.CopySnapshotRequest":                 blockstorage.CopySnapshotRequest{},
+	"blockstorage.CreateSnapshotRequest":               blockstorage.CreateSnapshotRequest{},
+	"blockstorage.CreateVolumeRequest":                 blockstorage.CreateVolumeRequest{},
+	"blockstorage.ExtendVolumeRequest":                 blockstorage.ExtendVolumeRequest{},
+	"blockstorage.ListSnapshotsResponse":               blockstorage.ListSnapshotsResponse{},
+	"blockstorage.ListVolumeTypesResponse":             blockstorage.ListVolumeTypesResponse{},
+	"blockstorage.ListVolumesResponse":                 blockstorage.ListVolumesResponse{},
+	"blockstorage.RenameSnapshotRequest":               blockstorage.RenameSnapshotRequest{},
+	"blockstorage.RenameVolumeRequest":                 blockstorage.RenameVolumeRequest{},
+	"blockstorage.RetypeVolumeRequest":                 blockstorage.RetypeVolumeRequest{},
+	"blockstorage.SchedulerListResponse":               blockstorage.SchedulerListResponse{},
+	"blockstorage.SchedulerPayload":                    blockstorage.SchedulerPayload{},
+	"blockstorage.SchedulerResponse":                   blockstorage.SchedulerResponse{},
+	"blockstorage.SchedulerVolumeIdentifierPayload":    blockstorage.SchedulerVolumeIdentifierPayload{},
+	"compute.CopySnapshotRequest":                      compute.CopySnapshotRequest{},
+	"compute.CreateCustomImageRequest":                 compute.CreateCustomImageRequest{},
+	"compute.CreateParametersNetwork":                  compute.CreateParametersNetwork{},
+	"compute.CreateParametersNetworkInterface":         compute.CreateParametersNetworkInterface{},
+	"compute.CreateParametersNetworkInterfaceWithID":   compute.CreateParametersNetworkInterfaceWithID{},
+	"compute.CreateRequest":                            compute.CreateRequest{},
+	"compute.CreateSnapshotRequest":                    compute.CreateSnapshotRequest{},
+	"compute.InitLogResponse":                          compute.InitLogResponse{},
+	"compute.ListInstancesResponse":                    compute.ListInstancesResponse{},
+	"compute.ListSnapshotsResponse":                    compute.ListSnapshotsResponse{},
+	"compute.NICRequest":                               compute.NICRequest{},
+	"compute.RestoreSnapshotRequest":                   compute.RestoreSnapshotRequest{},
+	"compute.RetypeRequest":                            compute.RetypeRequest{},
+	"compute.UpdateNameRequest":                        compute.UpdateNameRequest{},
+	"compute.WindowsPasswordResponse":                  compute.WindowsPasswordResponse{},
+	"containerregistry.AmountRepositoryResponse":       containerregistry.AmountRepositoryResponse{},
+	"containerregistry.CreateProxyCacheRequest":        containerregistry.CreateProxyCacheRequest{},
+	"containerregistry.CreateProxyCacheResponse":       containerregistry.CreateProxyCacheResponse{},
+	"containerregistry.CreateProxyCacheStatusRequest":  containerregistry.CreateProxyCacheStatusRequest{},
+	"containerregistry.CreateProxyCacheStatusResponse": containerregistry.CreateProxyCacheStatusResponse{},
+	"containerregistry.CredentialsResponse":            containerregistry.CredentialsResponse{},
+	"containerregistry.GetProxyCacheResponse":          containerregistry.GetProxyCacheResponse{},
+	"containerregistry.ImageResponse":                  containerregistry.ImageResponse{},
+	"containerregistry.ImageTagResponse":               containerregistry.ImageTagResponse{},
+	"containerregistry.ImagesResponse":                 containerregistry.ImagesResponse{},
+	"containerregistry.ListProxyCacheStatusResponse":   containerregistry.ListProxyCacheStatusResponse{},
+	"containerregistry.ListProxyCachesResponse":        containerregistry.ListProxyCachesResponse{},
+	"containerregistry.ListRegistriesResponse":         containerregistry.ListRegistriesResponse{},
+	"containerregistry.RegistryRequest":                containerregistry.RegistryRequest{},
+	"containerregistry.RegistryResponse":               containerregistry.RegistryResponse{},
+	"containerregistry.RepositoriesResponse":           containerregistry.RepositoriesResponse{},
+	"containerregistry.RepositoryResponse":             containerregistry.RepositoryResponse{},
+	"containerregistry.UpdateProxyCacheRequest":        containerregistry.UpdateProxyCacheRequest{},
+	"dbaas.ClusterCreateRequest":                       dbaas.ClusterCreateRequest{},
+	"dbaas.ClusterDetailResponse":                      dbaas.ClusterDetailResponse{},
+	"dbaas.ClusterResizeRequest":                       dbaas.ClusterResizeRequest{},
+	"dbaas.ClusterResponse":                            dbaas.ClusterResponse{},
+	"dbaas.ClusterUpdateRequest":                       dbaas.ClusterUpdateRequest{},
+	"dbaas.ClusterVolumeRequest":                       dbaas.ClusterVolumeRequest{},
+	"dbaas.ClusterVolumeResizeRequest":                 dbaas.ClusterVolumeResizeRequest{},
+	"dbaas.ClusterVolumeResponse":                      dbaas.ClusterVolumeResponse{},
+	"dbaas.ClustersResponse":                           dbaas.ClustersResponse{},
+	"dbaas.DatabaseInstanceUpdateRequest":              dbaas.DatabaseInstanceUpdateRequest{},
+	"dbaas.EngineParametersResponse":                   dbaas.EngineParametersResponse{},
+	"dbaas.InstanceCreateRequest":                      dbaas.InstanceCreateRequest{},
+	"dbaas.InstanceParametersRequest":                  dbaas.InstanceParametersRequest{},
+	"dbaas.InstanceParametersResponse":                 dbaas.InstanceParametersResponse{},
+	"dbaas.InstanceResizeRequest":                      dbaas.InstanceResizeRequest{},
+	"dbaas.InstanceResponse":                           dbaas.InstanceResponse{},
+	"dbaas.InstanceVolumeRequest":                      dbaas.InstanceVolumeRequest{},
+	"dbaas.InstanceVolumeResizeRequest":                dbaas.InstanceVolumeResizeRequest{},
+	"dbaas.InstancesResponse":                          dbaas.InstancesResponse{},
+	"dbaas.ListEnginesResponse":                        dbaas.ListEnginesResponse{},
+	"dbaas.ListInstanceTypesResponse":                  dbaas.ListInstanceTypesResponse{},
+	"dbaas.MetaResponse":                               dbaas.MetaResponse{},
+	"dbaas.PageResponse":                               dbaas.PageResponse{},
+	"dbaas.ParameterCreateRequest":                     dbaas.ParameterCreateRequest{},
+	"dbaas.ParameterDetailResponse":                    dbaas.ParameterDetailResponse{},
+	"dbaas.ParameterGroupCreateRequest":                dbaas.ParameterGroupCreateRequest{},
+	"dbaas.ParameterGroupDetailResponse":               dbaas.ParameterGroupDetailResponse{},
+	"dbaas.ParameterGroupResponse":                     dbaas.ParameterGroupResponse{},
+	"dbaas.ParameterGroupUpdateRequest":                dbaas.ParameterGroupUpdateRequest{},
+	"dbaas.ParameterGroupsResponse":                    dbaas.ParameterGroupsResponse{},
+	"dbaas.ParameterResponse":                          dbaas.ParameterResponse{},
+	"dbaas.ParameterUpdateRequest":                     dbaas.ParameterUpdateRequest{},
+	"dbaas.ParametersResponse":                         dbaas.ParametersResponse{},
+	"dbaas.ReplicaAddressResponse":                     dbaas.ReplicaAddressResponse{},
+	"dbaas.ReplicaCreateRequest":                       dbaas.ReplicaCreateRequest{},
+	"dbaas.ReplicaDetailResponse":                      dbaas.ReplicaDetailResponse{},
+	"dbaas.ReplicaResizeRequest":                       dbaas.ReplicaResizeRequest{},
+	"dbaas.ReplicaResponse":                            dbaas.ReplicaResponse{},
+	"dbaas.ReplicasResponse":                           dbaas.ReplicasResponse{},
+	"dbaas.RestoreSnapshotRequest":                     dbaas.RestoreSnapshotRequest{},
+	"dbaas.SnapshotCreateRequest":                      dbaas.SnapshotCreateRequest{},
+	"dbaas.SnapshotDetailResponse":                     dbaas.SnapshotDetailResponse{},
+	"dbaas.SnapshotInstanceDetailResponse":             dbaas.SnapshotInstanceDetailResponse{},
+	"dbaas.SnapshotResponse":                           dbaas.SnapshotResponse{},
+	"dbaas.SnapshotUpdateRequest":                      dbaas.SnapshotUpdateRequest{},
+	"dbaas.SnapshotsResponse":                          dbaas.SnapshotsResponse{},
+	"iam.APIKeyServiceAccountCreate":                   iam.APIKeyServiceAccountCreate{},
+	"iam.APIKeyServiceAccountDetail":                   iam.APIKeyServiceAccountDetail{},
+	"iam.APIKeyServiceAccountEditInput":                iam.APIKeyServiceAccountEditInput{},
+	"iam.AccessControl":                                iam.AccessControl{},
+	"iam.AccessControlCreate":                          iam.AccessControlCreate{},
+	"iam.AccessControlStatus":                          iam.AccessControlStatus{},
+	"iam.ApiProducts":                                  iam.ApiProducts{},
+	"iam.BatchUpdateMembers":                           iam.BatchUpdateMembers{},
+	"iam.CreateMember":                                 iam.CreateMember{},
+	"iam.CreateRole":                                   iam.CreateRole{},
+	"iam.EditGrant":                                    iam.EditGrant{},
+	"iam.EditPermissions":                              iam.EditPermissions{},
+	"iam.Member":                                       iam.Member{},
+	"iam.Permission":                                   iam.Permission{},
+	"iam.Privileges":                                   iam.Privileges{},
+	"iam.Product":                                      iam.Product{},
+	"iam.Role":                                         iam.Role{},
+	"iam.RolePermissions":                              iam.RolePermissions{},
+	"iam.RolesMember":                                  iam.RolesMember{},
+	"iam.Scope":                                        iam.Scope{},
+	"iam.ScopeGroup":                                   iam.ScopeGroup{},
+	"iam.ServiceAccountCreate":                         iam.ServiceAccountCreate{},
+	"iam.ServiceAccountDetail":                         iam.ServiceAccountDetail{},
+	"iam.ServiceAccountEdit":                           iam.ServiceAccountEdit{},
+	"iam.Tenant":                                       iam.Tenant{},
+	"kubernetes.ClusterListResponse":                   kubernetes.ClusterListResponse{},
+	"kubernetes.ClusterRequest":                        kubernetes.ClusterRequest{},
+	"kubernetes.CreateClusterResponse":                 kubernetes.CreateClusterResponse{},
+	"kubernetes.CreateNodePoolRequest":                 kubernetes.CreateNodePoolRequest{},
+	"kubernetes.KubernetesNetworkRequest":              kubernetes.KubernetesNetworkRequest{},
+	"kubernetes.NodeResponse":                          kubernetes.NodeResponse{},
+	"kubernetes.NodesResponse":                         kubernetes.NodesResponse{},
+	"kubernetes.PatchClusterRequest":                   kubernetes.PatchClusterRequest{},
+	"kubernetes.PatchClusterResponse":                  kubernetes.PatchClusterResponse{},
+	"kubernetes.PatchNodePoolRequest":                  kubernetes.PatchNodePoolRequest{},
+	"lbaas.CreateBackendRequest":                       lbaas.CreateBackendRequest{},
+	"lbaas.CreateNetworkACLRequest":                    lbaas.CreateNetworkACLRequest{},
+	"lbaas.CreateNetworkBackendRequest":                lbaas.CreateNetworkBackendRequest{},
+	"lbaas.CreateNetworkBackendTargetRequest":          lbaas.CreateNetworkBackendTargetRequest{},
+	"lbaas.CreateNetworkCertificateRequest":            lbaas.CreateNetworkCertificateRequest{},
+	"lbaas.CreateNetworkHealthCheckRequest":            lbaas.CreateNetworkHealthCheckRequest{},
+	"lbaas.CreateNetworkListenerRequest":               lbaas.CreateNetworkListenerRequest{},
+	"lbaas.CreateNetworkLoadBalancerRequest":           lbaas.CreateNetworkLoadBalancerRequest{},
+	"lbaas.DeleteNetworkLoadBalancerRequest":           lbaas.DeleteNetworkLoadBalancerRequest{},
+	"lbaas.ListNetworkLoadBalancerRequest":             lbaas.ListNetworkLoadBalancerRequest{},
+	"lbaas.NetworkAclResponse":                         lbaas.NetworkAclResponse{},
+	"lbaas.NetworkBackendInstanceTargetRequest":        lbaas.NetworkBackendInstanceTargetRequest{},
+	"lbaas.NetworkBackendResponse":                     lbaas.NetworkBackendResponse{},
+	"lbaas.NetworkGenericCreationResponse":             lbaas.NetworkGenericCreationResponse{},
+	"lbaas.NetworkHealthCheckResponse":                 lbaas.NetworkHealthCheckResponse{},
+	"lbaas.NetworkLBPaginatedResponse":                 lbaas.NetworkLBPaginatedResponse{},
+	"lbaas.NetworkListenerRequest":                     lbaas.NetworkListenerRequest{},
+	"lbaas.NetworkListenerResponse":                    lbaas.NetworkListenerResponse{},
+	"lbaas.NetworkLoadBalancerResponse":                lbaas.NetworkLoadBalancerResponse{},
+	"lbaas.NetworkPaginatedBackendResponse":            lbaas.NetworkPaginatedBackendResponse{},
+	"lbaas.NetworkPaginatedHealthCheckResponse":        lbaas.NetworkPaginatedHealthCheckResponse{},
+	"lbaas.NetworkPaginatedListenerResponse":           lbaas.NetworkPaginatedListenerResponse{},
+	"lbaas.NetworkPaginatedTLSCertificateResponse":     lbaas.NetworkPaginatedTLSCertificateResponse{},
+	"lbaas.NetworkPublicIPResponse":                    lbaas.NetworkPublicIPResponse{},
+	"lbaas.NetworkTLSCertificateResponse":              lbaas.NetworkTLSCertificateResponse{},
+	"lbaas.UpdateNetworkACLRequest":                    lbaas.UpdateNetworkACLRequest{},
+	"lbaas.UpdateNetworkBackendRequest":                lbaas.UpdateNetworkBackendRequest{},
+	"lbaas.UpdateNetworkCertificateRequest":            lbaas.UpdateNetworkCertificateRequest{},
+	"lbaas.UpdateNetworkHealthCheckRequest":            lbaas.UpdateNetworkHealthCheckRequest{},
+	"lbaas.UpdateNetworkListenerRequest":               lbaas.UpdateNetworkListenerRequest{},
+	"lbaas.UpdateNetworkLoadBalancerRequest":           lbaas.UpdateNetworkLoadBalancerRequest{},
+	"network.BookCIDRRequest":                          network.BookCIDRRequest{},
+	"network.BookCIDRResponse":                         network.BookCIDRResponse{},
+	"network.CreateNatGatewayRequest":                  network.CreateNatGatewayRequest{},
+	"network.CreateSubnetPoolRequest":                  network.CreateSubnetPoolRequest{},
+	"network.CreateSubnetPoolResponse":                 network.CreateSubnetPoolResponse{},
+	"network.CreateVPCRequest":                         network.CreateVPCRequest{},
+	"network.CreateVPCResponse":                        network.CreateVPCResponse{},
+	"network.FixedIPRequest":                           network.FixedIPRequest{},
+	"network.ListSubnetPoolsResponse":                  network.ListSubnetPoolsResponse{},
+	"network.ListSubnetsResponse":                      network.ListSubnetsResponse{},
+	"network.ListVPCsResponse":                         network.ListVPCsResponse{},
+	"network.NatGatewayCreateResponse":                 network.NatGatewayCreateResponse{},
+	"network.NatGatewayDetailsResponse":                network.NatGatewayDetailsResponse{},
+	"network.NatGatewayListResponse":                   network.NatGatewayListResponse{},
+	"network.NatGatewayResponse":                       network.NatGatewayResponse{},
+	"network.PortCreateRequest":                        network.PortCreateRequest{},
+	"network.PortCreateResponse":                       network.PortCreateResponse{},
+	"network.PortListResponse":                         network.PortListResponse{},
+	"network.PortNetworkResponse":                      network.PortNetworkResponse{},
+	"network.PortResponse":                             network.PortResponse{},
+	"network.PortSimpleResponse":                       network.PortSimpleResponse{},
+	"network.PortUpdateRequest":                        network.PortUpdateRequest{},
+	"network.PublicIPCreateRequest":                    network.PublicIPCreateRequest{},
+	"network.PublicIPCreateResponse":                   network.PublicIPCreateResponse{},
+	"network.PublicIPListResponse":                     network.PublicIPListResponse{},
+	"network.PublicIPResponse":                         network.PublicIPResponse{},
+	"network.RenameVPCRequest":                         network.RenameVPCRequest{},
+	"network.RuleCreateRequest":                        network.RuleCreateRequest{},
+	"network.RuleCreateResponse":                       network.RuleCreateResponse{},
+	"network.RuleResponse":                             network.RuleResponse{},
+	"network.SecurityGroupCreateRequest":               network.SecurityGroupCreateRequest{},
+	"network.SecurityGroupCreateResponse":              network.SecurityGroupCreateResponse{},
+	"network.SecurityGroupDetailResponse":              network.SecurityGroupDetailResponse{},
+	"network.SecurityGroupListResponse":                network.SecurityGroupListResponse{},
+	"network.SecurityGroupResponse":                    network.SecurityGroupResponse{},
+	"network.SubnetCreateRequest":                      network.SubnetCreateRequest{},
+	"network.SubnetCreateResponse":                     network.SubnetCreateResponse{},
+	"network.SubnetPatchRequest":                       network.SubnetPatchRequest{},
+	"network.SubnetPoolDetailsResponse":                network.SubnetPoolDetailsResponse{},
+	"network.SubnetPoolResponse":                       network.SubnetPoolResponse{},
+	"network.SubnetResponse":                           network.SubnetResponse{},
+	"network.UnbookCIDRRequest":                        network.UnbookCIDRRequest{},
+	"sshkeys.CreateSSHKeyRequest":                      sshkeys.CreateSSHKeyRequest{},
+	"sshkeys.ListSSHKeysResponse":                      sshkeys.ListSSHKeysResponse{},
+}
@@ -0,0 +1,86 @@
+package schema
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// skippedDirs are the top-level directories whose types are not request or
+// response bodies of the JSON APIs. objectstorage goes through the S3 API.
+var skippedDirs = map[string]bool{
+	"client":        true,
+	"clienttest":    true,
+	"cmd":           true,
+	"docs":          true,
+	"helpers":       true,
+	"internal":      true,
+	"objectstorage": true,
+	"prommetrics":   true,
+	"schema":        true,
+}
+
+// isPayloadName reports whether name looks like a request, response or query
+// parameter type, which Types is expected to list.
+func isPayloadName(name string) bool {
+	return strings.HasSuffix(name, "Request") ||
+		strings.HasSuffix(name, "Response") ||
+		strings.HasSuffix(name, "Params") ||
+		strings.HasPrefix(name, "Create") ||
+		strings.HasSuffix(name, "Create")
+}
+
+func TestTypes_CoverServicePayloads(t *testing.T) {
+	entries, err := os.ReadDir("..")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, entry := range entries {
+		if !entry.IsDir() || skippedDirs[entry.Name()] || strings.HasPrefix(entry.Name(), ".") {
+			continue
+		}
+
+		pkg := entry.Name()
+		fset := token.NewFileSet()
+		files, err := filepath.Glob(filepath.Join("..", pkg, "*.go"))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		for _, file := range files {
+			if strings.HasSuffix(file, "_test.go") {
+				continue
+			}
+			f, err := parser.ParseFile(fset, file, nil, parser.SkipObjectResolution)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			for _, decl := range f.Decls {
+				gen, ok := decl.(*ast.GenDecl)
+				if !ok || gen.Tok != token.TYPE {
+					continue
+				}
+				for _, spec := range gen.Specs {
+					ts := spec.(*ast.TypeSpec)
+					// Generic types cannot be listed without type arguments.
+					if !ts.Name.IsExported() || ts.TypeParams != nil || !isPayloadName(ts.Name.Name) {
+						continue
+					}
+					if _, ok := ts.Type.(*ast.StructType); !ok {
+						continue
+					}
+					name := pkg + "." + ts.Name.Name
+					if _, ok := Types[name]; !ok {
+						t.Errorf("Types is missing %s (%s)", name, fset.Position(ts.Pos()))
+					}
+				}
+			}
+		}
+	}
+}
@@ -57,6 +57,12 @@ func New(core *client.CoreClient, opts ...ClientOption) *SSHKeyClient {
 	return sshClient
 }
 
+// NewDefault creates a new instance of SSHKeyClient using the client installed
+// with client.SetDefault. If no default client is set, returns nil.
+func NewDefault(opts ...ClientOption) *SSHKeyClient {
+	return New(client.Default(), opts...)
+}
+
 // newRequest creates a new HTTP request with the SSH keys API base path
 func (c *SSHKeyClient) newRequest(ctx context.Context, method, path string, body any) (*http.Request, error) {
 	return mgc_http.NewRequest(c.GetConfig(), ctx, method, DefaultBasePath+path, &body)
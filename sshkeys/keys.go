@@ -32,9 +32,9 @@ type (
 
 	// ListOptions defines parameters for filtering and paginating SSH key lists
 	ListOptions struct {
-		Limit  *int
-		Offset *int
-		Sort   *string
+		Limit  *int    `json:"_limit,omitempty"`
+		Offset *int    `json:"_offset,omitempty"`
+		Sort   *string `json:"_sort,omitempty"`
 	}
 )
 
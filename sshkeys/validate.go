@@ -0,0 +1,69 @@
+package sshkeys
+
+import (
+	"encoding/base64"
+	"encoding/binary"
+	"fmt"
+	"strings"
+
+	"github.com/MagaluCloud/mgc-sdk-go/client"
+)
+
+// SupportedKeyTypes lists the public key algorithms accepted by ValidatePublicKey.
+var SupportedKeyTypes = []string{
+	"ssh-rsa",
+	"ssh-ed25519",
+	"ecdsa-sha2-nistp256",
+	"ecdsa-sha2-nistp384",
+	"ecdsa-sha2-nistp521",
+	"sk-ssh-ed25519@openssh.com",
+	"sk-ecdsa-sha2-nistp256@openssh.com",
+}
+
+// ValidatePublicKey checks that key is a single-line OpenSSH public key in the
+// "<type> <base64-data> [comment]" format, that the type is supported, and that
+// the encoded data declares the same key type.
+func ValidatePublicKey(key string) error {
+	key = strings.TrimSpace(key)
+	if key == "" {
+		return &client.ValidationError{Field: "key", Message: "cannot be empty"}
+	}
+	if strings.ContainsAny(key, "\r\n") {
+		return &client.ValidationError{Field: "key", Message: "must be a single line"}
+	}
+
+	fields := strings.Fields(key)
+	if len(fields) < 2 {
+		return &client.ValidationError{Field: "key", Message: "must be in the format \"<type> <base64-data> [comment]\""}
+	}
+
+	keyType := fields[0]
+	if !isSupportedKeyType(keyType) {
+		return &client.ValidationError{Field: "key", Message: fmt.Sprintf("unsupported key type %q", keyType)}
+	}
+
+	data, err := base64.StdEncoding.DecodeString(fields[1])
+	if err != nil {
+		return &client.ValidationError{Field: "key", Message: "key data is not valid base64"}
+	}
+
+	// The wire format starts with the key type as a length-prefixed string.
+	if len(data) < 4 {
+		return &client.ValidationError{Field: "key", Message: "key data is too short"}
+	}
+	n := binary.BigEndian.Uint32(data[:4])
+	if uint64(n) > uint64(len(data)-4) || string(data[4:4+n]) != keyType {
+		return &client.ValidationError{Field: "key", Message: fmt.Sprintf("key data does not match key type %q", keyType)}
+	}
+
+	return nil
+}
+
+func isSupportedKeyType(keyType string) bool {
+	for _, t := range SupportedKeyTypes {
+		if t == keyType {
+			return true
+		}
+	}
+	return false
+}
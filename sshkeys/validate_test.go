@@ -0,0 +1,59 @@
+package sshkeys
+
+import (
+	"encoding/base64"
+	"encoding/binary"
+	"errors"
+	"testing"
+
+	"github.com/MagaluCloud/mgc-sdk-go/client"
+)
+
+func encodeKeyBlob(keyType string, payload []byte) string {
+	blob := make([]byte, 4, 4+len(keyType)+len(payload))
+	binary.BigEndian.PutUint32(blob, uint32(len(keyType)))
+	blob = append(blob, keyType...)
+	blob = append(blob, payload...)
+	return base64.StdEncoding.EncodeToString(blob)
+}
+
+func TestValidatePublicKey(t *testing.T) {
+	t.Parallel()
+
+	ed25519Data := encodeKeyBlob("ssh-ed25519", make([]byte, 36))
+	rsaData := encodeKeyBlob("ssh-rsa", make([]byte, 64))
+
+	tests := []struct {
+		name    string
+		key     string
+		wantErr bool
+	}{
+		{name: "ed25519 with comment", key: "ssh-ed25519 " + ed25519Data + " user@host"},
+		{name: "rsa without comment", key: "ssh-rsa " + rsaData},
+		{name: "surrounding whitespace", key: "  ssh-rsa " + rsaData + "\n"},
+		{name: "empty", key: "", wantErr: true},
+		{name: "missing data", key: "ssh-rsa", wantErr: true},
+		{name: "unsupported type", key: "ssh-foo " + rsaData, wantErr: true},
+		{name: "invalid base64", key: "ssh-rsa not*base64", wantErr: true},
+		{name: "type mismatch", key: "ssh-ed25519 " + rsaData, wantErr: true},
+		{name: "truncated data", key: "ssh-rsa " + base64.StdEncoding.EncodeToString([]byte{0, 0}), wantErr: true},
+		{name: "multiple lines", key: "ssh-rsa " + rsaData + "\nssh-rsa " + rsaData, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			err := ValidatePublicKey(tt.key)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ValidatePublicKey() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err != nil {
+				var validationErr *client.ValidationError
+				if !errors.As(err, &validationErr) || validationErr.Field != "key" {
+					t.Errorf("expected ValidationError on key, got %v", err)
+				}
+			}
+		})
+	}
+}